@@ -34,6 +34,11 @@ func (mbv *MockBridgeView) DepositsByAddress(address common.Address) ([]*databas
 	}, nil
 }
 
+// DepositBySentMessageHash mocks returning a deposit by its cross domain message hash
+func (mbv *MockBridgeView) DepositBySentMessageHash(msgHash common.Hash) (*database.Deposit, error) {
+	return nil, nil
+}
+
 // WithdrawalsByAddress mocks returning withdrawals by an address
 func (mbv *MockBridgeView) WithdrawalsByAddress(address common.Address) ([]*database.WithdrawalWithTransactionHashes, error) {
 	return []*database.WithdrawalWithTransactionHashes{
@@ -50,6 +55,11 @@ func (mbv *MockBridgeView) WithdrawalsByAddress(address common.Address) ([]*data
 	}, nil
 }
 
+// WithdrawalByHash mocks returning a withdrawal by its withdrawal hash
+func (mbv *MockBridgeView) WithdrawalByHash(withdrawalHash common.Hash) (*database.Withdrawal, error) {
+	return nil, nil
+}
+
 func TestHealthz(t *testing.T) {
 	api := NewApi(&MockBridgeView{})
 	request, err := http.NewRequest("GET", "/healthz", nil)
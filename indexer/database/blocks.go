@@ -19,6 +19,9 @@ type BlockHeader struct {
 	ParentHash common.Hash `gorm:"serializer:json"`
 	Number     U256
 	Timestamp  uint64
+
+	// Unfinalized headers are subject to reorgs
+	Finalized bool
 }
 
 type L1BlockHeader struct {
@@ -46,7 +49,10 @@ type LegacyStateBatch struct {
 }
 
 type BlocksView interface {
+	LatestL1BlockHeader() (*L1BlockHeader, error)
 	FinalizedL1BlockHeader() (*L1BlockHeader, error)
+
+	LatestL2BlockHeader() (*L2BlockHeader, error)
 	FinalizedL2BlockHeader() (*L2BlockHeader, error)
 }
 
@@ -55,9 +61,11 @@ type BlocksDB interface {
 
 	StoreL1BlockHeaders([]*L1BlockHeader) error
 	StoreLegacyStateBatch(*LegacyStateBatch) error
+	MarkFinalizedL1BlockHeaders(*big.Int) error
 
 	StoreL2BlockHeaders([]*L2BlockHeader) error
 	MarkFinalizedL1RootForL2Block(common.Hash, common.Hash) error
+	MarkFinalizedL2BlockHeaders(*big.Int) error
 }
 
 /**
@@ -108,8 +116,9 @@ func (db *blocksDB) StoreLegacyStateBatch(stateBatch *LegacyStateBatch) error {
 	return result.Error
 }
 
-// FinalizedL1BlockHeader returns the latest L1 block header stored in the database, nil otherwise
-func (db *blocksDB) FinalizedL1BlockHeader() (*L1BlockHeader, error) {
+// LatestL1BlockHeader returns the latest L1 block header stored in the database, which may
+// not be finalized, nil otherwise
+func (db *blocksDB) LatestL1BlockHeader() (*L1BlockHeader, error) {
 	var l1Header L1BlockHeader
 	result := db.gorm.Order("number DESC").Take(&l1Header)
 	if result.Error != nil {
@@ -123,6 +132,28 @@ func (db *blocksDB) FinalizedL1BlockHeader() (*L1BlockHeader, error) {
 	return &l1Header, nil
 }
 
+// FinalizedL1BlockHeader returns the latest finalized L1 block header stored in the database, nil otherwise
+func (db *blocksDB) FinalizedL1BlockHeader() (*L1BlockHeader, error) {
+	var l1Header L1BlockHeader
+	result := db.gorm.Where("finalized = ?", true).Order("number DESC").Take(&l1Header)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return &l1Header, nil
+}
+
+// MarkFinalizedL1BlockHeaders marks all stored L1 block headers up to and including the
+// supplied height as finalized
+func (db *blocksDB) MarkFinalizedL1BlockHeaders(height *big.Int) error {
+	result := db.gorm.Model(&L1BlockHeader{}).Where("finalized = ? AND number <= ?", false, &U256{Int: height}).Update("finalized", true)
+	return result.Error
+}
+
 // L2
 
 func (db *blocksDB) StoreL2BlockHeaders(headers []*L2BlockHeader) error {
@@ -130,8 +161,9 @@ func (db *blocksDB) StoreL2BlockHeaders(headers []*L2BlockHeader) error {
 	return result.Error
 }
 
-// FinalizedL2BlockHeader returns the latest L2 block header stored in the database, nil otherwise
-func (db *blocksDB) FinalizedL2BlockHeader() (*L2BlockHeader, error) {
+// LatestL2BlockHeader returns the latest L2 block header stored in the database, which may
+// not be finalized, nil otherwise
+func (db *blocksDB) LatestL2BlockHeader() (*L2BlockHeader, error) {
 	var l2Header L2BlockHeader
 	result := db.gorm.Order("number DESC").Take(&l2Header)
 	if result.Error != nil {
@@ -142,6 +174,21 @@ func (db *blocksDB) FinalizedL2BlockHeader() (*L2BlockHeader, error) {
 		return nil, result.Error
 	}
 
+	return &l2Header, nil
+}
+
+// FinalizedL2BlockHeader returns the latest finalized L2 block header stored in the database, nil otherwise
+func (db *blocksDB) FinalizedL2BlockHeader() (*L2BlockHeader, error) {
+	var l2Header L2BlockHeader
+	result := db.gorm.Where("finalized = ?", true).Order("number DESC").Take(&l2Header)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
 	result.Logger.Info(context.Background(), "number ", l2Header.Number)
 	return &l2Header, nil
 }
@@ -161,3 +208,10 @@ func (db *blocksDB) MarkFinalizedL1RootForL2Block(l2Root, l1Root common.Hash) er
 	result = db.gorm.Save(&l2Header)
 	return result.Error
 }
+
+// MarkFinalizedL2BlockHeaders marks all stored L2 block headers up to and including the
+// supplied height as finalized
+func (db *blocksDB) MarkFinalizedL2BlockHeaders(height *big.Int) error {
+	result := db.gorm.Model(&L2BlockHeader{}).Where("finalized = ? AND number <= ?", false, &U256{Int: height}).Update("finalized", true)
+	return result.Error
+}
@@ -32,6 +32,9 @@ type Deposit struct {
 	GUID                 uuid.UUID `gorm:"primaryKey"`
 	InitiatedL1EventGUID string
 
+	SentMessageHash      common.Hash `gorm:"serializer:json"`
+	FinalizedL2EventGUID *string
+
 	Tx        Transaction `gorm:"embedded"`
 	TokenPair TokenPair   `gorm:"embedded"`
 }
@@ -39,6 +42,11 @@ type Deposit struct {
 type DepositWithTransactionHash struct {
 	Deposit           Deposit     `gorm:"embedded"`
 	L1TransactionHash common.Hash `gorm:"serializer:json"`
+
+	// Unfinalized deposits are rolled back if the L1 block is reorged
+	L1BlockFinalized bool
+
+	FinalizedL2TransactionHash *common.Hash `gorm:"serializer:json"`
 }
 
 type Withdrawal struct {
@@ -57,22 +65,49 @@ type WithdrawalWithTransactionHashes struct {
 	Withdrawal        Withdrawal  `gorm:"embedded"`
 	L2TransactionHash common.Hash `gorm:"serializer:json"`
 
+	// Unfinalized withdrawals are rolled back if the L2 block is reorged
+	L2BlockFinalized bool
+
 	ProvenL1TransactionHash    *common.Hash `gorm:"serializer:json"`
 	FinalizedL1TransactionHash *common.Hash `gorm:"serializer:json"`
 }
 
+// PendingWithdrawalEvent is a proven or finalized withdrawal event of a withdrawal
+// that is not indexed (yet). It is marked on the withdrawal once indexed.
+type PendingWithdrawalEvent struct {
+	L1EventGUID    string      `gorm:"primaryKey"`
+	WithdrawalHash common.Hash `gorm:"serializer:json"`
+	Finalized      bool
+}
+
+// PendingDepositEvent is a relayed message event of a deposit that is not indexed (yet).
+// It is marked on the deposit once indexed.
+type PendingDepositEvent struct {
+	L2EventGUID     string      `gorm:"primaryKey"`
+	SentMessageHash common.Hash `gorm:"serializer:json"`
+}
+
 type BridgeView interface {
 	DepositsByAddress(address common.Address) ([]*DepositWithTransactionHash, error)
+	DepositBySentMessageHash(common.Hash) (*Deposit, error)
+
 	WithdrawalsByAddress(address common.Address) ([]*WithdrawalWithTransactionHashes, error)
+	WithdrawalByHash(common.Hash) (*Withdrawal, error)
 }
 
 type BridgeDB interface {
 	BridgeView
 
 	StoreDeposits([]*Deposit) error
+	MarkFinalizedDepositEvent(string, string) error
+	StorePendingDepositEvents([]*PendingDepositEvent) error
+	MarkPendingDepositEvents() error
+
 	StoreWithdrawals([]*Withdrawal) error
 	MarkProvenWithdrawalEvent(string, string) error
 	MarkFinalizedWithdrawalEvent(string, string) error
+	StorePendingWithdrawalEvents([]*PendingWithdrawalEvent) error
+	MarkPendingWithdrawalEvents() error
 }
 
 /**
@@ -94,12 +129,61 @@ func (db *bridgeDB) StoreDeposits(deposits []*Deposit) error {
 	return result.Error
 }
 
+func (db *bridgeDB) MarkFinalizedDepositEvent(guid, finalizedL2EventGuid string) error {
+	var deposit Deposit
+	result := db.gorm.First(&deposit, "guid = ?", guid)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	deposit.FinalizedL2EventGUID = &finalizedL2EventGuid
+	result = db.gorm.Save(&deposit)
+	return result.Error
+}
+
+func (db *bridgeDB) StorePendingDepositEvents(events []*PendingDepositEvent) error {
+	result := db.gorm.Create(&events)
+	return result.Error
+}
+
+// MarkPendingDepositEvents marks the pending relayed message events on the deposits that
+// have been indexed since, removing them from the pending events
+func (db *bridgeDB) MarkPendingDepositEvents() error {
+	result := db.gorm.Exec(`UPDATE deposits SET finalized_l2_event_guid = pending_deposit_events.l2_event_guid
+		FROM pending_deposit_events WHERE deposits.sent_message_hash = pending_deposit_events.sent_message_hash`)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Exec(`DELETE FROM pending_deposit_events USING deposits
+		WHERE deposits.sent_message_hash = pending_deposit_events.sent_message_hash`)
+	return result.Error
+}
+
+// DepositBySentMessageHash returns the deposit relayed by the cross domain message with
+// the supplied hash, nil otherwise
+func (db *bridgeDB) DepositBySentMessageHash(msgHash common.Hash) (*Deposit, error) {
+	var deposit Deposit
+	result := db.gorm.Where(&Deposit{SentMessageHash: msgHash}).Take(&deposit)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return &deposit, nil
+}
+
 func (db *bridgeDB) DepositsByAddress(address common.Address) ([]*DepositWithTransactionHash, error) {
-	depositsQuery := db.gorm.Table("deposits").Select("deposits.*, l1_contract_events.transaction_hash AS l1_transaction_hash")
+	depositsQuery := db.gorm.Table("deposits").Select("deposits.*, l1_contract_events.transaction_hash AS l1_transaction_hash, l1_block_headers.finalized AS l1_block_finalized, finalized_l2_contract_events.transaction_hash AS finalized_l2_transaction_hash")
 	eventsJoinQuery := depositsQuery.Joins("LEFT JOIN l1_contract_events ON deposits.initiated_l1_event_guid = l1_contract_events.guid")
+	blocksJoinQuery := eventsJoinQuery.Joins("LEFT JOIN l1_block_headers ON l1_contract_events.block_hash = l1_block_headers.hash")
+	finalizedJoinQuery := blocksJoinQuery.Joins("LEFT JOIN l2_contract_events AS finalized_l2_contract_events ON deposits.finalized_l2_event_guid = finalized_l2_contract_events.guid")
 
 	// add in cursoring options
-	filteredQuery := eventsJoinQuery.Where(&Transaction{FromAddress: address}).Order("deposits.timestamp DESC").Limit(100)
+	filteredQuery := finalizedJoinQuery.Where(&Transaction{FromAddress: address}).Order("deposits.timestamp DESC").Limit(100)
 
 	deposits := make([]*DepositWithTransactionHash, 100)
 	result := filteredQuery.Scan(&deposits)
@@ -121,6 +205,21 @@ func (db *bridgeDB) StoreWithdrawals(withdrawals []*Withdrawal) error {
 	return result.Error
 }
 
+// WithdrawalByHash returns the withdrawal with the supplied withdrawal hash, nil otherwise
+func (db *bridgeDB) WithdrawalByHash(withdrawalHash common.Hash) (*Withdrawal, error) {
+	var withdrawal Withdrawal
+	result := db.gorm.Where(&Withdrawal{WithdrawalHash: withdrawalHash}).Take(&withdrawal)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, result.Error
+	}
+
+	return &withdrawal, nil
+}
+
 func (db *bridgeDB) MarkProvenWithdrawalEvent(guid, provenL1EventGuid string) error {
 	var withdrawal Withdrawal
 	result := db.gorm.First(&withdrawal, "guid = ?", guid)
@@ -145,11 +244,37 @@ func (db *bridgeDB) MarkFinalizedWithdrawalEvent(guid, finalizedL1EventGuid stri
 	return result.Error
 }
 
+func (db *bridgeDB) StorePendingWithdrawalEvents(events []*PendingWithdrawalEvent) error {
+	result := db.gorm.Create(&events)
+	return result.Error
+}
+
+// MarkPendingWithdrawalEvents marks the pending proven & finalized events on the withdrawals
+// that have been indexed since, removing them from the pending events
+func (db *bridgeDB) MarkPendingWithdrawalEvents() error {
+	result := db.gorm.Exec(`UPDATE withdrawals SET proven_l1_event_guid = pending_withdrawal_events.l1_event_guid
+		FROM pending_withdrawal_events WHERE withdrawals.withdrawal_hash = pending_withdrawal_events.withdrawal_hash AND NOT pending_withdrawal_events.finalized`)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Exec(`UPDATE withdrawals SET finalized_l1_event_guid = pending_withdrawal_events.l1_event_guid
+		FROM pending_withdrawal_events WHERE withdrawals.withdrawal_hash = pending_withdrawal_events.withdrawal_hash AND pending_withdrawal_events.finalized`)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Exec(`DELETE FROM pending_withdrawal_events USING withdrawals
+		WHERE withdrawals.withdrawal_hash = pending_withdrawal_events.withdrawal_hash`)
+	return result.Error
+}
+
 func (db *bridgeDB) WithdrawalsByAddress(address common.Address) ([]*WithdrawalWithTransactionHashes, error) {
-	withdrawalsQuery := db.gorm.Table("withdrawals").Select("withdrawals.*, l2_contract_events.transaction_hash AS l2_transaction_hash, proven_l1_contract_events.transaction_hash AS proven_l1_transaction_hash, finalized_l1_contract_events.transaction_hash AS finalized_l1_transaction_hash")
+	withdrawalsQuery := db.gorm.Table("withdrawals").Select("withdrawals.*, l2_contract_events.transaction_hash AS l2_transaction_hash, l2_block_headers.finalized AS l2_block_finalized, proven_l1_contract_events.transaction_hash AS proven_l1_transaction_hash, finalized_l1_contract_events.transaction_hash AS finalized_l1_transaction_hash")
 
 	eventsJoinQuery := withdrawalsQuery.Joins("LEFT JOIN l2_contract_events ON withdrawals.initiated_l2_event_guid = l2_contract_events.guid")
-	provenJoinQuery := eventsJoinQuery.Joins("LEFT JOIN l1_contract_events AS proven_l1_contract_events ON withdrawals.proven_l1_event_guid = proven_l1_contract_events.guid")
+	blocksJoinQuery := eventsJoinQuery.Joins("LEFT JOIN l2_block_headers ON l2_contract_events.block_hash = l2_block_headers.hash")
+	provenJoinQuery := blocksJoinQuery.Joins("LEFT JOIN l1_contract_events AS proven_l1_contract_events ON withdrawals.proven_l1_event_guid = proven_l1_contract_events.guid")
 	finalizedJoinQuery := provenJoinQuery.Joins("LEFT JOIN l1_contract_events AS finalized_l1_contract_events ON withdrawals.finalized_l1_event_guid = finalized_l1_contract_events.guid")
 
 	// add in cursoring options
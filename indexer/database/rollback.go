@@ -0,0 +1,104 @@
+package database
+
+import (
+	"math/big"
+)
+
+// The rollbacks remove the indexed state built on top of reorged block headers. Since this
+// state spans across tables, the rollbacks are conducted on the DB rather than a single view.
+
+// RollbackL1BlockHeaders deletes all L1 block headers above the supplied height, along with
+// the contract events & deposits within them. Withdrawals proven or finalized within the
+// deleted headers are unmarked. The relays of the deleted deposits are kept as pending events,
+// so that they are marked again once the deposits are re-indexed.
+func (db *DB) RollbackL1BlockHeaders(height *big.Int) error {
+	headers := db.gorm.Model(&L1BlockHeader{}).Select("hash").Where("number > ?", &U256{Int: height})
+	events := db.gorm.Model(&L1ContractEvent{}).Select("guid").Where("block_hash IN (?)", headers)
+
+	result := db.gorm.Model(&Withdrawal{}).Where("proven_l1_event_guid IN (?)", events).Update("proven_l1_event_guid", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Model(&Withdrawal{}).Where("finalized_l1_event_guid IN (?)", events).Update("finalized_l1_event_guid", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("l1_event_guid IN (?)", events).Delete(&PendingWithdrawalEvent{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Exec(`INSERT INTO pending_deposit_events (l2_event_guid, sent_message_hash)
+		SELECT finalized_l2_event_guid, sent_message_hash FROM deposits
+		WHERE finalized_l2_event_guid IS NOT NULL AND initiated_l1_event_guid IN (?)`, events)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("initiated_l1_event_guid IN (?)", events).Delete(&Deposit{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Model(&L2BlockHeader{}).Where("l1_block_hash IN (?)", headers).Updates(map[string]interface{}{"l1_block_hash": nil, "legacy_state_batch_index": nil})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("l1_block_hash IN (?)", headers).Delete(&LegacyStateBatch{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("block_hash IN (?)", headers).Delete(&L1ContractEvent{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("number > ?", &U256{Int: height}).Delete(&L1BlockHeader{})
+	return result.Error
+}
+
+// RollbackL2BlockHeaders deletes all L2 block headers above the supplied height, along with
+// the contract events & withdrawals within them. Deposits relayed within the deleted headers
+// are unmarked. The proofs & finalizations of the deleted withdrawals are kept as pending events,
+// so that they are marked again once the withdrawals are re-indexed.
+func (db *DB) RollbackL2BlockHeaders(height *big.Int) error {
+	headers := db.gorm.Model(&L2BlockHeader{}).Select("hash").Where("number > ?", &U256{Int: height})
+	events := db.gorm.Model(&L2ContractEvent{}).Select("guid").Where("block_hash IN (?)", headers)
+
+	result := db.gorm.Model(&Deposit{}).Where("finalized_l2_event_guid IN (?)", events).Update("finalized_l2_event_guid", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("l2_event_guid IN (?)", events).Delete(&PendingDepositEvent{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Exec(`INSERT INTO pending_withdrawal_events (l1_event_guid, withdrawal_hash, finalized)
+		SELECT proven_l1_event_guid, withdrawal_hash, FALSE FROM withdrawals
+		WHERE proven_l1_event_guid IS NOT NULL AND initiated_l2_event_guid IN (?)
+		UNION ALL
+		SELECT finalized_l1_event_guid, withdrawal_hash, TRUE FROM withdrawals
+		WHERE finalized_l1_event_guid IS NOT NULL AND initiated_l2_event_guid IN (?)`, events, events)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("initiated_l2_event_guid IN (?)", events).Delete(&Withdrawal{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("block_hash IN (?)", headers).Delete(&L2ContractEvent{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = db.gorm.Where("number > ?", &U256{Int: height}).Delete(&L2BlockHeader{})
+	return result.Error
+}
@@ -0,0 +1,340 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/lib/pq"
+)
+
+// setupTestDB creates a database with the schema of the migrations. The test is skipped if
+// there is no postgres server, running on the default port, to create the database on.
+func setupTestDB(t *testing.T) *DB {
+	return setupUpgradedTestDB(t, "", nil)
+}
+
+// setupUpgradedTestDB is setupTestDB, with the existing state of a database written by the
+// supplied function right before the named migration is applied.
+func setupUpgradedTestDB(t *testing.T, migration string, writeExisting func(*sql.DB)) *DB {
+	dsn := "postgres://"
+	if user := os.Getenv("DB_USER"); user != "" {
+		dsn += user + "@"
+	}
+	dsn += "localhost:5432"
+
+	pg, err := sql.Open("postgres", dsn+"?sslmode=disable")
+	require.NoError(t, err)
+	if err := pg.Ping(); err != nil {
+		pg.Close()
+		t.Skipf("postgres is not available: %v", err)
+	}
+
+	name := fmt.Sprintf("indexer_database_test_%d", time.Now().UnixNano())
+	_, err = pg.Exec("CREATE DATABASE " + name)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := pg.Exec("DROP DATABASE " + name)
+		require.NoError(t, err)
+		pg.Close()
+	})
+
+	migrations, err := filepath.Glob(filepath.Join("..", "migrations", "*.sql"))
+	require.NoError(t, err)
+	sort.Strings(migrations)
+
+	testPg, err := sql.Open("postgres", dsn+"/"+name+"?sslmode=disable")
+	require.NoError(t, err)
+	defer testPg.Close()
+	for _, file := range migrations {
+		if filepath.Base(file) == migration {
+			writeExisting(testPg)
+		}
+		schema, err := os.ReadFile(file)
+		require.NoError(t, err)
+		_, err = testPg.Exec(string(schema))
+		require.NoError(t, err, "migration %s", file)
+	}
+
+	db, err := NewDB(dsn + "/" + name + "?sslmode=disable")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		// the connections must be closed for the database to be dropped
+		sqlDB, err := db.gorm.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+	})
+	return db
+}
+
+// makeBlockHeaders makes headers [1..n], with distinct hashes per layer
+func makeBlockHeaders(n int, layer byte) []BlockHeader {
+	headers := make([]BlockHeader, n)
+	for i := range headers {
+		headers[i] = BlockHeader{
+			Hash:      common.BytesToHash([]byte{layer, byte(i + 1)}),
+			Number:    U256{Int: big.NewInt(int64(i + 1))},
+			Timestamp: uint64(i + 1),
+		}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash
+		}
+	}
+	return headers
+}
+
+// rollbackTestState is a deposit & withdrawal per block in both layers, on top of headers [1..3].
+// The deposit initiated in L1 block i is relayed in L2 block i, and the withdrawal initiated in
+// L2 block i is proven & finalized in L1 block i.
+type rollbackTestState struct {
+	l1Headers, l2Headers []BlockHeader
+
+	deposits    []*Deposit
+	withdrawals []*Withdrawal
+}
+
+func storeRollbackTestState(t *testing.T, db *DB) *rollbackTestState {
+	s := &rollbackTestState{l1Headers: makeBlockHeaders(3, 1), l2Headers: makeBlockHeaders(3, 2)}
+
+	var l1Headers []*L1BlockHeader
+	var l2Headers []*L2BlockHeader
+	for i := range s.l1Headers {
+		l1Headers = append(l1Headers, &L1BlockHeader{BlockHeader: s.l1Headers[i]})
+		l2Headers = append(l2Headers, &L2BlockHeader{BlockHeader: s.l2Headers[i], L1BlockHash: &s.l1Headers[i].Hash})
+	}
+	require.NoError(t, db.Blocks.StoreL1BlockHeaders(l1Headers))
+	require.NoError(t, db.Blocks.StoreL2BlockHeaders(l2Headers))
+
+	for i := range s.l1Headers {
+		// initiated deposit, proven & finalized withdrawal
+		var l1Events []*L1ContractEvent
+		for j := 0; j < 3; j++ {
+			l1Events = append(l1Events, &L1ContractEvent{ContractEvent{GUID: uuid.New(), BlockHash: s.l1Headers[i].Hash, LogIndex: uint64(j)}})
+		}
+		require.NoError(t, db.ContractEvents.StoreL1ContractEvents(l1Events))
+
+		// relayed deposit, initiated withdrawal
+		var l2Events []*L2ContractEvent
+		for j := 0; j < 2; j++ {
+			l2Events = append(l2Events, &L2ContractEvent{ContractEvent{GUID: uuid.New(), BlockHash: s.l2Headers[i].Hash, LogIndex: uint64(j)}})
+		}
+		require.NoError(t, db.ContractEvents.StoreL2ContractEvents(l2Events))
+
+		relayedGUID, provenGUID, finalizedGUID := l2Events[0].GUID.String(), l1Events[1].GUID.String(), l1Events[2].GUID.String()
+		deposit := &Deposit{
+			GUID:                 uuid.New(),
+			InitiatedL1EventGUID: l1Events[0].GUID.String(),
+			SentMessageHash:      common.BytesToHash([]byte{3, byte(i)}),
+			FinalizedL2EventGUID: &relayedGUID,
+			Tx:                   Transaction{Amount: U256{Int: big.NewInt(1)}},
+		}
+		withdrawal := &Withdrawal{
+			GUID:                 uuid.New(),
+			InitiatedL2EventGUID: l2Events[1].GUID.String(),
+			WithdrawalHash:       common.BytesToHash([]byte{4, byte(i)}),
+			ProvenL1EventGUID:    &provenGUID,
+			FinalizedL1EventGUID: &finalizedGUID,
+			Tx:                   Transaction{Amount: U256{Int: big.NewInt(1)}},
+		}
+		require.NoError(t, db.Bridge.StoreDeposits([]*Deposit{deposit}))
+		require.NoError(t, db.Bridge.StoreWithdrawals([]*Withdrawal{withdrawal}))
+		s.deposits = append(s.deposits, deposit)
+		s.withdrawals = append(s.withdrawals, withdrawal)
+	}
+
+	// finalize the first block of each layer
+	require.NoError(t, db.Blocks.MarkFinalizedL1BlockHeaders(big.NewInt(1)))
+	require.NoError(t, db.Blocks.MarkFinalizedL2BlockHeaders(big.NewInt(1)))
+	return s
+}
+
+func TestMigrateFinalizedBlockHeaders(t *testing.T) {
+	l1Headers, l2Headers := makeBlockHeaders(3, 1), makeBlockHeaders(3, 2)
+	db := setupUpgradedTestDB(t, "20230613_add_block_headers_finalized.sql", func(pg *sql.DB) {
+		for table, headers := range map[string][]BlockHeader{"l1_block_headers": l1Headers, "l2_block_headers": l2Headers} {
+			for _, header := range headers {
+				hash, err := json.Marshal(header.Hash)
+				require.NoError(t, err)
+				parentHash, err := json.Marshal(header.ParentHash)
+				require.NoError(t, err)
+				_, err = pg.Exec(
+					"INSERT INTO "+table+" (hash, parent_hash, number, timestamp) VALUES ($1, $2, $3, $4)",
+					string(hash), string(parentHash), header.Number.Int.Int64(), header.Timestamp,
+				)
+				require.NoError(t, err)
+			}
+		}
+	})
+
+	// the headers indexed before the upgrade are finalized
+	l1Finalized, err := db.Blocks.FinalizedL1BlockHeader()
+	require.NoError(t, err)
+	require.NotNil(t, l1Finalized)
+	require.Equal(t, l1Headers[2].Hash, l1Finalized.Hash)
+	l2Finalized, err := db.Blocks.FinalizedL2BlockHeader()
+	require.NoError(t, err)
+	require.NotNil(t, l2Finalized)
+	require.Equal(t, l2Headers[2].Hash, l2Finalized.Hash)
+
+	// the rollback of the processors on startup keeps them
+	require.NoError(t, db.RollbackL1BlockHeaders(l1Finalized.Number.Int))
+	require.NoError(t, db.RollbackL2BlockHeaders(l2Finalized.Number.Int))
+	latest, err := db.Blocks.LatestL1BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, l1Headers[2].Hash, latest.Hash)
+	latestL2, err := db.Blocks.LatestL2BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, l2Headers[2].Hash, latestL2.Hash)
+
+	// headers indexed after the upgrade are unfinalized
+	next := makeBlockHeaders(4, 1)[3]
+	require.NoError(t, db.Blocks.StoreL1BlockHeaders([]*L1BlockHeader{{BlockHeader: next}}))
+	l1Finalized, err = db.Blocks.FinalizedL1BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, l1Headers[2].Hash, l1Finalized.Hash)
+
+	var stored L1BlockHeader
+	require.NoError(t, db.gorm.Where("number = ?", &next.Number).Take(&stored).Error)
+	require.False(t, stored.Finalized)
+}
+
+func TestRollbackL1BlockHeaders(t *testing.T) {
+	db := setupTestDB(t)
+	s := storeRollbackTestState(t, db)
+
+	require.NoError(t, db.RollbackL1BlockHeaders(big.NewInt(1)))
+
+	latest, err := db.Blocks.LatestL1BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, s.l1Headers[0].Hash, latest.Hash)
+	finalized, err := db.Blocks.FinalizedL1BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, s.l1Headers[0].Hash, finalized.Hash)
+
+	var events []L1ContractEvent
+	require.NoError(t, db.gorm.Find(&events).Error)
+	require.Len(t, events, 3)
+
+	// the deposits initiated in the rolled back blocks are removed, their relays become pending
+	var deposits []Deposit
+	require.NoError(t, db.gorm.Find(&deposits).Error)
+	require.Len(t, deposits, 1)
+	require.Equal(t, s.deposits[0].GUID, deposits[0].GUID)
+
+	var pendingDeposits []PendingDepositEvent
+	require.NoError(t, db.gorm.Find(&pendingDeposits).Error)
+	require.ElementsMatch(t, []PendingDepositEvent{
+		{L2EventGUID: *s.deposits[1].FinalizedL2EventGUID, SentMessageHash: s.deposits[1].SentMessageHash},
+		{L2EventGUID: *s.deposits[2].FinalizedL2EventGUID, SentMessageHash: s.deposits[2].SentMessageHash},
+	}, pendingDeposits)
+
+	// the withdrawals proven & finalized in the rolled back blocks are unmarked
+	for i, withdrawal := range s.withdrawals {
+		indexed, err := db.Bridge.WithdrawalByHash(withdrawal.WithdrawalHash)
+		require.NoError(t, err)
+		if i == 0 {
+			require.Equal(t, withdrawal.ProvenL1EventGUID, indexed.ProvenL1EventGUID)
+			require.Equal(t, withdrawal.FinalizedL1EventGUID, indexed.FinalizedL1EventGUID)
+		} else {
+			require.Nil(t, indexed.ProvenL1EventGUID)
+			require.Nil(t, indexed.FinalizedL1EventGUID)
+		}
+	}
+
+	// the L2 blocks lose the L1 block of their outputs
+	var l2Headers []L2BlockHeader
+	require.NoError(t, db.gorm.Order("timestamp").Find(&l2Headers).Error)
+	require.Len(t, l2Headers, 3)
+	require.Equal(t, s.l1Headers[0].Hash, *l2Headers[0].L1BlockHash)
+	require.Nil(t, l2Headers[1].L1BlockHash)
+	require.Nil(t, l2Headers[2].L1BlockHash)
+
+	// re-indexed deposits are marked with their pending relays
+	require.NoError(t, db.Bridge.StoreDeposits([]*Deposit{{
+		GUID:                 uuid.New(),
+		InitiatedL1EventGUID: events[0].GUID.String(),
+		SentMessageHash:      s.deposits[1].SentMessageHash,
+		Tx:                   Transaction{Amount: U256{Int: big.NewInt(1)}},
+	}}))
+	require.NoError(t, db.Bridge.MarkPendingDepositEvents())
+	deposit, err := db.Bridge.DepositBySentMessageHash(s.deposits[1].SentMessageHash)
+	require.NoError(t, err)
+	require.Equal(t, s.deposits[1].FinalizedL2EventGUID, deposit.FinalizedL2EventGUID)
+
+	pendingDeposits = nil
+	require.NoError(t, db.gorm.Find(&pendingDeposits).Error)
+	require.Len(t, pendingDeposits, 1)
+}
+
+func TestRollbackL2BlockHeaders(t *testing.T) {
+	db := setupTestDB(t)
+	s := storeRollbackTestState(t, db)
+
+	require.NoError(t, db.RollbackL2BlockHeaders(big.NewInt(1)))
+
+	latest, err := db.Blocks.LatestL2BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, s.l2Headers[0].Hash, latest.Hash)
+	finalized, err := db.Blocks.FinalizedL2BlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, s.l2Headers[0].Hash, finalized.Hash)
+
+	var events []L2ContractEvent
+	require.NoError(t, db.gorm.Find(&events).Error)
+	require.Len(t, events, 2)
+
+	// the withdrawals initiated in the rolled back blocks are removed, their proofs & finalizations become pending
+	var withdrawals []Withdrawal
+	require.NoError(t, db.gorm.Find(&withdrawals).Error)
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, s.withdrawals[0].GUID, withdrawals[0].GUID)
+
+	var pendingWithdrawals []PendingWithdrawalEvent
+	require.NoError(t, db.gorm.Find(&pendingWithdrawals).Error)
+	var expected []PendingWithdrawalEvent
+	for _, withdrawal := range s.withdrawals[1:] {
+		expected = append(expected,
+			PendingWithdrawalEvent{L1EventGUID: *withdrawal.ProvenL1EventGUID, WithdrawalHash: withdrawal.WithdrawalHash},
+			PendingWithdrawalEvent{L1EventGUID: *withdrawal.FinalizedL1EventGUID, WithdrawalHash: withdrawal.WithdrawalHash, Finalized: true},
+		)
+	}
+	require.ElementsMatch(t, expected, pendingWithdrawals)
+
+	// the deposits relayed in the rolled back blocks are unmarked
+	for i, deposit := range s.deposits {
+		indexed, err := db.Bridge.DepositBySentMessageHash(deposit.SentMessageHash)
+		require.NoError(t, err)
+		if i == 0 {
+			require.Equal(t, deposit.FinalizedL2EventGUID, indexed.FinalizedL2EventGUID)
+		} else {
+			require.Nil(t, indexed.FinalizedL2EventGUID)
+		}
+	}
+
+	// re-indexed withdrawals are marked with their pending events
+	require.NoError(t, db.Bridge.StoreWithdrawals([]*Withdrawal{{
+		GUID:                 uuid.New(),
+		InitiatedL2EventGUID: events[0].GUID.String(),
+		WithdrawalHash:       s.withdrawals[1].WithdrawalHash,
+		Tx:                   Transaction{Amount: U256{Int: big.NewInt(1)}},
+	}}))
+	require.NoError(t, db.Bridge.MarkPendingWithdrawalEvents())
+	withdrawal, err := db.Bridge.WithdrawalByHash(s.withdrawals[1].WithdrawalHash)
+	require.NoError(t, err)
+	require.Equal(t, s.withdrawals[1].ProvenL1EventGUID, withdrawal.ProvenL1EventGUID)
+	require.Equal(t, s.withdrawals[1].FinalizedL1EventGUID, withdrawal.FinalizedL1EventGUID)
+
+	pendingWithdrawals = nil
+	require.NoError(t, db.gorm.Find(&pendingWithdrawals).Error)
+	require.Len(t, pendingWithdrawals, 2)
+}
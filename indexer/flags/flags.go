@@ -155,6 +155,19 @@ var (
 		Value:  2000,
 		EnvVar: prefixEnvVar("MAX_HEADER_BATCH_SIZE"),
 	}
+	IndexUnfinalizedFlag = cli.BoolFlag{
+		Name: "index-unfinalized",
+		Usage: "If true, the processors index blocks up to the latest head rather than " +
+			"the finalized head. Indexed state is rolled back when reorged",
+		EnvVar: prefixEnvVar("INDEX_UNFINALIZED"),
+	}
+	FinalizedBlockTagFlag = cli.StringFlag{
+		Name: "finalized-block-tag",
+		Usage: "The block tag of the finalized head: finalized, safe or latest. Local devnets " +
+			"that don't mark finalized blocks can use latest",
+		Value:  "finalized",
+		EnvVar: prefixEnvVar("FINALIZED_BLOCK_TAG"),
+	}
 	RESTHostnameFlag = cli.StringFlag{
 		Name:   "rest-hostname",
 		Usage:  "The hostname of the REST server",
@@ -213,6 +226,8 @@ var optionalFlags = []cli.Flag{
 	L2ConfDepthFlag,
 	MaxHeaderBatchSizeFlag,
 	L1StartBlockNumberFlag,
+	IndexUnfinalizedFlag,
+	FinalizedBlockTagFlag,
 	RESTHostnameFlag,
 	RESTPortFlag,
 	MetricsServerEnableFlag,
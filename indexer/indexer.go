@@ -81,22 +81,22 @@ func NewIndexer(ctx *cli.Context) (*Indexer, error) {
 		L1StandardBridge:       common.HexToAddress("0x6900000000000000000000000000000000000003"),
 		L1ERC721Bridge:         common.HexToAddress("0x6900000000000000000000000000000000000004"),
 	}
-	l1EthClient, err := node.NewEthClient(ctx.GlobalString(flags.L1EthRPCFlag.Name))
+	l1EthClient, err := node.NewEthClient(ctx.GlobalString(flags.L1EthRPCFlag.Name), ctx.GlobalString(flags.FinalizedBlockTagFlag.Name))
 	if err != nil {
 		return nil, err
 	}
-	l1Processor, err := processor.NewL1Processor(l1EthClient, db, l1Contracts)
+	l1Processor, err := processor.NewL1Processor(l1EthClient, db, l1Contracts, ctx.GlobalBool(flags.IndexUnfinalizedFlag.Name))
 	if err != nil {
 		return nil, err
 	}
 
 	// L2Processor
 	l2Contracts := processor.L2ContractPredeploys() // Make this configurable
-	l2EthClient, err := node.NewEthClient(ctx.GlobalString(flags.L2EthRPCFlag.Name))
+	l2EthClient, err := node.NewEthClient(ctx.GlobalString(flags.L2EthRPCFlag.Name), ctx.GlobalString(flags.FinalizedBlockTagFlag.Name))
 	if err != nil {
 		return nil, err
 	}
-	l2Processor, err := processor.NewL2Processor(l2EthClient, db, l2Contracts)
+	l2Processor, err := processor.NewL2Processor(l2EthClient, db, l2Contracts, ctx.GlobalBool(flags.IndexUnfinalizedFlag.Name))
 	if err != nil {
 		return nil, err
 	}
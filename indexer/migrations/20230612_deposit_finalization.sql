@@ -0,0 +1,35 @@
+/**
+ * DEPOSIT FINALIZATION
+ *
+ * The l2 relay of a deposit is linked by the cross domain message hash. Deposits indexed
+ * before this migration have no message hash, and are never marked as finalized
+ */
+
+ALTER TABLE deposits ADD COLUMN IF NOT EXISTS sent_message_hash VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deposits ALTER COLUMN sent_message_hash DROP DEFAULT;
+ALTER TABLE deposits ADD COLUMN IF NOT EXISTS finalized_l2_event_guid VARCHAR REFERENCES l2_contract_events(guid);
+
+/**
+ * PENDING BRIDGING DATA
+ *
+ * Finalization events that could not be linked to a deposit or withdrawal when indexed, since
+ * the other layer has not been indexed up to the initiating event yet, or the message is not
+ * a bridge deposit or withdrawal. They are linked once the deposit or withdrawal is indexed.
+ */
+
+CREATE TABLE IF NOT EXISTS pending_withdrawal_events (
+    l1_event_guid   VARCHAR NOT NULL PRIMARY KEY REFERENCES l1_contract_events(guid),
+    withdrawal_hash VARCHAR NOT NULL,
+
+    -- Proven event otherwise
+    finalized       BOOLEAN NOT NULL
+);
+CREATE INDEX IF NOT EXISTS pending_withdrawal_events_withdrawal_hash ON pending_withdrawal_events(withdrawal_hash);
+CREATE INDEX IF NOT EXISTS withdrawals_withdrawal_hash ON withdrawals(withdrawal_hash);
+
+CREATE TABLE IF NOT EXISTS pending_deposit_events (
+    l2_event_guid     VARCHAR NOT NULL PRIMARY KEY REFERENCES l2_contract_events(guid),
+    sent_message_hash VARCHAR NOT NULL
+);
+CREATE INDEX IF NOT EXISTS pending_deposit_events_sent_message_hash ON pending_deposit_events(sent_message_hash);
+CREATE INDEX IF NOT EXISTS deposits_sent_message_hash ON deposits(sent_message_hash);
@@ -0,0 +1,14 @@
+/**
+ * BLOCK FINALITY
+ *
+ * Unfinalized headers are rolled back on reorgs. Headers indexed before this migration
+ * were indexed from the finalized head, or from the latest head of a devnet, so they are
+ * marked as finalized. Otherwise the processors would roll back all indexed state on startup.
+ * Headers indexed afterwards are unfinalized until marked by the processors.
+ */
+
+ALTER TABLE l1_block_headers ADD COLUMN IF NOT EXISTS finalized BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE l1_block_headers ALTER COLUMN finalized SET DEFAULT FALSE;
+
+ALTER TABLE l2_block_headers ADD COLUMN IF NOT EXISTS finalized BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE l2_block_headers ALTER COLUMN finalized SET DEFAULT FALSE;
@@ -3,9 +3,11 @@ package node
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -21,12 +23,21 @@ const (
 	// defaultRequestTimeout is the default duration the processor will
 	// wait for a request to be fulfilled
 	defaultRequestTimeout = 10 * time.Second
+
+	// DefaultFinalizedBlockTag is the block tag of the finalized head
+	DefaultFinalizedBlockTag = "finalized"
 )
 
+// FinalizedBlockTags are the block tags that can be used as the finalized head. Local
+// devnets may not mark blocks with the "finalized" tag, and can use "safe" or "latest" instead
+var FinalizedBlockTags = []string{DefaultFinalizedBlockTag, "safe", "latest"}
+
 type EthClient interface {
 	FinalizedBlockHeight() (*big.Int, error)
+	LatestBlockHeight() (*big.Int, error)
 
 	BlockHeadersByRange(*big.Int, *big.Int) ([]*types.Header, error)
+	BlockHeaderByNumber(*big.Int) (*types.Header, error)
 	BlockHeaderByHash(common.Hash) (*types.Header, error)
 
 	RawRpcClient() *rpc.Client
@@ -34,9 +45,18 @@ type EthClient interface {
 
 type client struct {
 	rpcClient *rpc.Client
+
+	// block tag of the head returned by FinalizedBlockHeight
+	finalizedTag string
 }
 
-func NewEthClient(rpcUrl string) (EthClient, error) {
+// NewEthClient dials the RPC. The supplied block tag is used as the finalized head,
+// which must be one of the FinalizedBlockTags
+func NewEthClient(rpcUrl string, finalizedTag string) (EthClient, error) {
+	if !isFinalizedBlockTag(finalizedTag) {
+		return nil, fmt.Errorf("invalid finalized block tag %q, expected one of %v", finalizedTag, FinalizedBlockTags)
+	}
+
 	ctxwt, cancel := context.WithTimeout(context.Background(), defaultDialTimeout)
 	defer cancel()
 
@@ -45,21 +65,43 @@ func NewEthClient(rpcUrl string) (EthClient, error) {
 		return nil, err
 	}
 
-	client := &client{rpcClient: rpcClient}
+	client := &client{rpcClient: rpcClient, finalizedTag: finalizedTag}
 	return client, nil
 }
 
+func isFinalizedBlockTag(tag string) bool {
+	for _, t := range FinalizedBlockTags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *client) RawRpcClient() *rpc.Client {
 	return c.rpcClient
 }
 
-// FinalizedBlockHeight retrieves the latest block height in a finalized state
+// FinalizedBlockHeight retrieves the latest block height in a finalized state, as
+// marked by the configured finalized block tag
 func (c *client) FinalizedBlockHeight() (*big.Int, error) {
 	ctxwt, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
 	defer cancel()
 
-	// Local devnet is having issues with the "finalized" block tag. Switch to "latest"
-	// to iterate faster locally but this needs to be updated
+	header := new(types.Header)
+	err := c.rpcClient.CallContext(ctxwt, header, "eth_getBlockByNumber", c.finalizedTag, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Number, nil
+}
+
+// LatestBlockHeight retrieves the height of the latest block, which is subject to reorgs
+func (c *client) LatestBlockHeight() (*big.Int, error) {
+	ctxwt, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
 	header := new(types.Header)
 	err := c.rpcClient.CallContext(ctxwt, header, "eth_getBlockByNumber", "latest", false)
 	if err != nil {
@@ -69,6 +111,24 @@ func (c *client) FinalizedBlockHeight() (*big.Int, error) {
 	return header.Number, nil
 }
 
+// BlockHeaderByNumber retrieves the canonical block header at the supplied height, nil
+// if the chain has not reached the height
+func (c *client) BlockHeaderByNumber(number *big.Int) (*types.Header, error) {
+	ctxwt, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	header, err := ethclient.NewClient(c.rpcClient).HeaderByNumber(ctxwt, number)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return header, nil
+}
+
 // BlockHeaderByHash retrieves the block header attributed to the supplied hash
 func (c *client) BlockHeaderByHash(hash common.Hash) (*types.Header, error) {
 	ctxwt, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
@@ -87,11 +147,11 @@ func (c *client) BlockHeaderByHash(hash common.Hash) (*types.Header, error) {
 	return header, nil
 }
 
-// BlockHeadersByRange will retrieve block headers within the specified range -- includsive. No restrictions
+// BlockHeadersByRange will retrieve block headers within the specified range -- inclusive. No restrictions
 // are placed on the range such as blocks in the "latest", "safe" or "finalized" states. If the specified
 // range is too large, `endHeight > latest`, the resulting list is truncated to the available headers
 func (c *client) BlockHeadersByRange(startHeight, endHeight *big.Int) ([]*types.Header, error) {
-	count := new(big.Int).Sub(endHeight, startHeight).Uint64()
+	count := new(big.Int).Sub(endHeight, startHeight).Uint64() + 1
 	batchElems := make([]rpc.BatchElem, count)
 	for i := uint64(0); i < count; i++ {
 		height := new(big.Int).Add(startHeight, new(big.Int).SetUint64(i))
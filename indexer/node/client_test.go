@@ -2,10 +2,13 @@ package node
 
 import (
 	"math/big"
+	"testing"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -21,11 +24,21 @@ func (m *MockEthClient) FinalizedBlockHeight() (*big.Int, error) {
 	return args.Get(0).(*big.Int), args.Error(1)
 }
 
+func (m *MockEthClient) LatestBlockHeight() (*big.Int, error) {
+	args := m.Called()
+	return args.Get(0).(*big.Int), args.Error(1)
+}
+
 func (m *MockEthClient) BlockHeadersByRange(from, to *big.Int) ([]*types.Header, error) {
 	args := m.Called(from, to)
 	return args.Get(0).([]*types.Header), args.Error(1)
 }
 
+func (m *MockEthClient) BlockHeaderByNumber(number *big.Int) (*types.Header, error) {
+	args := m.Called(number)
+	return args.Get(0).(*types.Header), args.Error(1)
+}
+
 func (m *MockEthClient) BlockHeaderByHash(hash common.Hash) (*types.Header, error) {
 	args := m.Called(hash)
 	return args.Get(0).(*types.Header), args.Error(1)
@@ -35,3 +48,44 @@ func (m *MockEthClient) RawRpcClient() *rpc.Client {
 	args := m.Called()
 	return args.Get(0).(*rpc.Client)
 }
+
+// headersAPI serves eth_getBlockByNumber for a chain of headers
+type headersAPI struct {
+	headers []*types.Header
+}
+
+func (api *headersAPI) GetBlockByNumber(number hexutil.Uint64, _ bool) (*types.Header, error) {
+	if number >= hexutil.Uint64(len(api.headers)) {
+		return nil, nil
+	}
+	return api.headers[number], nil
+}
+
+func TestBlockHeadersByRange(t *testing.T) {
+	headers := make([]*types.Header, 10)
+	for i := range headers {
+		headers[i] = &types.Header{Number: big.NewInt(int64(i)), Difficulty: common.Big0}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash()
+		}
+	}
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &headersAPI{headers}))
+	defer server.Stop()
+	c := &client{rpcClient: rpc.DialInProc(server), finalizedTag: DefaultFinalizedBlockTag}
+
+	// the end height is included in the range
+	rangeHeaders, err := c.BlockHeadersByRange(big.NewInt(2), big.NewInt(5))
+	require.NoError(t, err)
+	require.Len(t, rangeHeaders, 4)
+	for i, header := range rangeHeaders {
+		require.Equal(t, headers[i+2].Hash(), header.Hash())
+	}
+
+	// a range of a single block
+	rangeHeaders, err = c.BlockHeadersByRange(big.NewInt(9), big.NewInt(9))
+	require.NoError(t, err)
+	require.Len(t, rangeHeaders, 1)
+	require.Equal(t, headers[9].Hash(), rangeHeaders[0].Hash())
+}
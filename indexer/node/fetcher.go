@@ -10,7 +10,10 @@ import (
 // Max number of headers that's bee returned by the Fetcher at once.
 const maxHeaderBatchSize = 50
 
-var ErrFetcherAndProviderMismatchedState = errors.New("the fetcher and provider have diverged in finalized state")
+// ErrFetcherAndProviderMismatchedState is returned when the next headers of the provider
+// do not build on the last header of the fetcher. This should never happen for finalized
+// headers, however it indicates a reorg when fetching unfinalized headers.
+var ErrFetcherAndProviderMismatchedState = errors.New("the fetcher and provider have diverged in state")
 
 type Fetcher struct {
 	ethClient  EthClient
@@ -24,7 +27,17 @@ func NewFetcher(ethClient EthClient, fromHeader *types.Header) *Fetcher {
 	return &Fetcher{ethClient: ethClient, lastHeader: fromHeader}
 }
 
-// NextConfirmedHeaders retrives the next set of headers that have been
+// LastHeader returns the last header returned by the fetcher, nil if starting from genesis
+func (f *Fetcher) LastHeader() *types.Header {
+	return f.lastHeader
+}
+
+// Reset rewinds the fetcher to resume fetching from the supplied header, nil indicating genesis
+func (f *Fetcher) Reset(header *types.Header) {
+	f.lastHeader = header
+}
+
+// NextFinalizedHeaders retrives the next set of headers that have been
 // marked as finalized by the connected client
 func (f *Fetcher) NextFinalizedHeaders() ([]*types.Header, error) {
 	finalizedBlockHeight, err := f.ethClient.FinalizedBlockHeight()
@@ -32,7 +45,37 @@ func (f *Fetcher) NextFinalizedHeaders() ([]*types.Header, error) {
 		return nil, err
 	}
 
-	if f.lastHeader != nil && f.lastHeader.Number.Cmp(finalizedBlockHeight) >= 0 {
+	return f.nextHeaders(finalizedBlockHeight)
+}
+
+// NextUnsafeHeaders retrieves the next set of headers up to the latest head of the
+// connected client. These headers are subject to reorgs, in which case the fetcher
+// returns ErrFetcherAndProviderMismatchedState.
+func (f *Fetcher) NextUnsafeHeaders() ([]*types.Header, error) {
+	latestBlockHeight, err := f.ethClient.LatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := f.nextHeaders(latestBlockHeight)
+	if err != nil || len(headers) > 0 || f.lastHeader == nil {
+		return headers, err
+	}
+
+	// At head. The new headers would otherwise detect a reorg so make sure the last
+	// header has not been replaced by a chain of the same or lower height
+	header, err := f.ethClient.BlockHeaderByNumber(f.lastHeader.Number)
+	if err != nil {
+		return nil, err
+	} else if header == nil || header.Hash() != f.lastHeader.Hash() {
+		return nil, ErrFetcherAndProviderMismatchedState
+	}
+
+	return nil, nil
+}
+
+func (f *Fetcher) nextHeaders(headHeight *big.Int) ([]*types.Header, error) {
+	if f.lastHeader != nil && f.lastHeader.Number.Cmp(headHeight) >= 0 {
 		// Warn if our fetcher is ahead of the provider. The fetcher should always
 		// be behind or at head with the provider.
 		return nil, nil
@@ -43,7 +86,7 @@ func (f *Fetcher) NextFinalizedHeaders() ([]*types.Header, error) {
 		nextHeight = new(big.Int).Add(f.lastHeader.Number, bigOne)
 	}
 
-	endHeight := clampBigInt(nextHeight, finalizedBlockHeight, maxHeaderBatchSize)
+	endHeight := clampBigInt(nextHeight, headHeight, maxHeaderBatchSize)
 	headers, err := f.ethClient.BlockHeadersByRange(nextHeight, endHeight)
 	if err != nil {
 		return nil, err
@@ -53,8 +96,6 @@ func (f *Fetcher) NextFinalizedHeaders() ([]*types.Header, error) {
 	if numHeaders == 0 {
 		return nil, nil
 	} else if f.lastHeader != nil && headers[0].ParentHash != f.lastHeader.Hash() {
-		// The indexer's state is in an irrecoverable state relative to the provider. This
-		// should never happen since the indexer is dealing with only finalized blocks.
 		return nil, ErrFetcherAndProviderMismatchedState
 	}
 
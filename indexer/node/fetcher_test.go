@@ -116,3 +116,61 @@ func TestFetcherMismatchedProviderStateError(t *testing.T) {
 	assert.Nil(t, headers)
 	assert.Equal(t, ErrFetcherAndProviderMismatchedState, err)
 }
+
+func TestFetcherNextUnsafeHeaders(t *testing.T) {
+	client := new(MockEthClient)
+
+	// start from genesis
+	fetcher := NewFetcher(client, nil)
+
+	// blocks [0..4]
+	headers := makeHeaders(5, nil)
+	client.On("LatestBlockHeight").Return(big.NewInt(4), nil)
+	client.On("BlockHeadersByRange", mock.MatchedBy(bigIntMatcher(0)), mock.MatchedBy(bigIntMatcher(4))).Return(headers, nil)
+	fetchedHeaders, err := fetcher.NextUnsafeHeaders()
+	assert.NoError(t, err)
+	assert.Len(t, fetchedHeaders, 5)
+
+	// at head, the last header is checked against the canonical header
+	client.On("BlockHeaderByNumber", mock.MatchedBy(bigIntMatcher(4))).Return(headers[4], nil)
+	fetchedHeaders, err = fetcher.NextUnsafeHeaders()
+	assert.NoError(t, err)
+	assert.Empty(t, fetchedHeaders)
+}
+
+func TestFetcherNextUnsafeHeadersReorgAtHead(t *testing.T) {
+	client := new(MockEthClient)
+
+	// start from block 4 as the latest fetched block
+	headers := makeHeaders(5, nil)
+	fetcher := NewFetcher(client, headers[4])
+
+	// block 4 replaced by a different block at the same height
+	reorgedHeader := &types.Header{Number: big.NewInt(4), ParentHash: headers[3].Hash(), Extra: []byte{0x01}}
+	client.On("LatestBlockHeight").Return(big.NewInt(4), nil)
+	client.On("BlockHeaderByNumber", mock.MatchedBy(bigIntMatcher(4))).Return(reorgedHeader, nil)
+	fetchedHeaders, err := fetcher.NextUnsafeHeaders()
+	assert.Nil(t, fetchedHeaders)
+	assert.Equal(t, ErrFetcherAndProviderMismatchedState, err)
+
+	// the fetcher resumes from the supplied header once reset
+	fetcher.Reset(headers[3])
+	assert.Equal(t, headers[3], fetcher.LastHeader())
+}
+
+func TestFetcherNextUnsafeHeadersReorg(t *testing.T) {
+	client := new(MockEthClient)
+
+	// start from block 4 as the latest fetched block
+	headers := makeHeaders(5, nil)
+	fetcher := NewFetcher(client, headers[4])
+
+	// blocks [5..9] building on a different block 4
+	reorgedHeader := &types.Header{Number: big.NewInt(4), ParentHash: headers[3].Hash(), Extra: []byte{0x01}}
+	client.On("LatestBlockHeight").Return(big.NewInt(9), nil)
+	client.On("BlockHeadersByRange", mock.MatchedBy(bigIntMatcher(5)), mock.MatchedBy(bigIntMatcher(9))).Return(makeHeaders(5, reorgedHeader), nil)
+	fetchedHeaders, err := fetcher.NextUnsafeHeaders()
+	assert.Nil(t, fetchedHeaders)
+	assert.Equal(t, ErrFetcherAndProviderMismatchedState, err)
+	assert.Equal(t, headers[4], fetcher.LastHeader())
+}
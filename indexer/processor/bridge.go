@@ -0,0 +1,369 @@
+package processor
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/google/uuid"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// crossDomainEvent links an event on one layer to the bridge
+// transfer it completes on the other layer
+type crossDomainEvent struct {
+	// message hash for deposits, withdrawal hash for withdrawals
+	hash      common.Hash
+	eventGUID string
+}
+
+type l1BridgeEvents struct {
+	deposits             []*database.Deposit
+	provenWithdrawals    []crossDomainEvent
+	finalizedWithdrawals []crossDomainEvent
+}
+
+type l2BridgeEvents struct {
+	withdrawals       []*database.Withdrawal
+	finalizedDeposits []crossDomainEvent
+}
+
+// TODO decode the bridge events with the generated event decoders of op-bindings (bindings.DecodeContractLog),
+// once the indexer depends on an optimism release that includes them. It is pinned to v1.0.9 until then.
+type l1BridgeDecoder struct {
+	log       log.Logger
+	contracts L1Contracts
+
+	standardBridgeAbi *abi.ABI
+	messengerAbi      *abi.ABI
+	portalAbi         *abi.ABI
+
+	standardBridge *bindings.L1StandardBridgeFilterer
+	messenger      *bindings.L1CrossDomainMessengerFilterer
+	portal         *bindings.OptimismPortalFilterer
+}
+
+func newL1BridgeDecoder(log log.Logger, contracts L1Contracts) (*l1BridgeDecoder, error) {
+	standardBridgeAbi, err := bindings.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	messengerAbi, err := bindings.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	portalAbi, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// the filterers are only used to parse logs, a contract backend is not needed
+	standardBridge, err := bindings.NewL1StandardBridgeFilterer(contracts.L1StandardBridge, nil)
+	if err != nil {
+		return nil, err
+	}
+	messenger, err := bindings.NewL1CrossDomainMessengerFilterer(contracts.L1CrossDomainMessenger, nil)
+	if err != nil {
+		return nil, err
+	}
+	portal, err := bindings.NewOptimismPortalFilterer(contracts.OptimismPortal, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l1BridgeDecoder{
+		log:               log,
+		contracts:         contracts,
+		standardBridgeAbi: standardBridgeAbi,
+		messengerAbi:      messengerAbi,
+		portalAbi:         portalAbi,
+		standardBridge:    standardBridge,
+		messenger:         messenger,
+		portal:            portal,
+	}, nil
+}
+
+// decode extracts the bridge deposits and withdrawal proofs & finalizations from the
+// logs of a batch. `events` must contain the contract event indexed for each log.
+//
+// The StandardBridge emits a deposit event prior to sending the cross domain message
+// relaying it. The deposit is linked to the hash of the next message sent by the bridge
+// within the same transaction, which is the hash relayed by the L2CrossDomainMessenger.
+func (d *l1BridgeDecoder) decode(logs []types.Log, events []*database.L1ContractEvent) (*l1BridgeEvents, error) {
+	bridgeEvents := &l1BridgeEvents{}
+
+	var txHash common.Hash
+	var pendingDeposits []*database.Deposit
+	var sentMessage *bindings.L1CrossDomainMessengerSentMessage
+	for i, log := range logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		if log.TxHash != txHash {
+			bridgeEvents.deposits = d.skipUnsentDeposits(txHash, bridgeEvents.deposits, pendingDeposits)
+			pendingDeposits = nil
+
+			txHash = log.TxHash
+			sentMessage = nil
+		}
+
+		event := events[i]
+		eventSig := log.Topics[0]
+		switch {
+		case log.Address == d.contracts.L1StandardBridge && eventSig == d.standardBridgeAbi.Events["ETHDepositInitiated"].ID:
+			ethDeposit, err := d.standardBridge.ParseETHDepositInitiated(log)
+			if err != nil {
+				return nil, err
+			}
+
+			deposit := &database.Deposit{
+				GUID:                 uuid.New(),
+				InitiatedL1EventGUID: event.GUID.String(),
+				Tx: database.Transaction{
+					FromAddress: ethDeposit.From,
+					ToAddress:   ethDeposit.To,
+					Amount:      database.U256{Int: ethDeposit.Amount},
+					Data:        ethDeposit.ExtraData,
+					Timestamp:   event.Timestamp,
+				},
+				TokenPair: database.TokenPair{L1TokenAddress: common.Address{}, L2TokenAddress: predeploys.LegacyERC20ETHAddr},
+			}
+
+			bridgeEvents.deposits = append(bridgeEvents.deposits, deposit)
+			pendingDeposits = append(pendingDeposits, deposit)
+
+		case log.Address == d.contracts.L1StandardBridge && eventSig == d.standardBridgeAbi.Events["ERC20DepositInitiated"].ID:
+			erc20Deposit, err := d.standardBridge.ParseERC20DepositInitiated(log)
+			if err != nil {
+				return nil, err
+			}
+
+			deposit := &database.Deposit{
+				GUID:                 uuid.New(),
+				InitiatedL1EventGUID: event.GUID.String(),
+				Tx: database.Transaction{
+					FromAddress: erc20Deposit.From,
+					ToAddress:   erc20Deposit.To,
+					Amount:      database.U256{Int: erc20Deposit.Amount},
+					Data:        erc20Deposit.ExtraData,
+					Timestamp:   event.Timestamp,
+				},
+				TokenPair: database.TokenPair{L1TokenAddress: erc20Deposit.L1Token, L2TokenAddress: erc20Deposit.L2Token},
+			}
+
+			bridgeEvents.deposits = append(bridgeEvents.deposits, deposit)
+			pendingDeposits = append(pendingDeposits, deposit)
+
+		case log.Address == d.contracts.L1CrossDomainMessenger && eventSig == d.messengerAbi.Events["SentMessage"].ID:
+			msg, err := d.messenger.ParseSentMessage(log)
+			if err != nil {
+				return nil, err
+			}
+
+			sentMessage = msg
+
+		case log.Address == d.contracts.L1CrossDomainMessenger && eventSig == d.messengerAbi.Events["SentMessageExtension1"].ID:
+			// SentMessageExtension1 is always emitted directly after SentMessage
+			if sentMessage == nil {
+				return nil, fmt.Errorf("SentMessageExtension1 in tx %s without a preceding SentMessage", log.TxHash)
+			}
+
+			msgExtension, err := d.messenger.ParseSentMessageExtension1(log)
+			if err != nil {
+				return nil, err
+			}
+
+			msg := sentMessage
+			sentMessage = nil
+			if msg.Sender != d.contracts.L1StandardBridge || len(pendingDeposits) == 0 {
+				continue
+			}
+
+			msgHash, err := crossdomain.HashCrossDomainMessageV1(msg.MessageNonce, msg.Sender, msg.Target, msgExtension.Value, msg.GasLimit, msg.Message)
+			if err != nil {
+				return nil, err
+			}
+
+			pendingDeposits[0].SentMessageHash = msgHash
+			pendingDeposits = pendingDeposits[1:]
+
+		case log.Address == d.contracts.OptimismPortal && eventSig == d.portalAbi.Events["WithdrawalProven"].ID:
+			proven, err := d.portal.ParseWithdrawalProven(log)
+			if err != nil {
+				return nil, err
+			}
+
+			bridgeEvents.provenWithdrawals = append(bridgeEvents.provenWithdrawals, crossDomainEvent{proven.WithdrawalHash, event.GUID.String()})
+
+		case log.Address == d.contracts.OptimismPortal && eventSig == d.portalAbi.Events["WithdrawalFinalized"].ID:
+			finalized, err := d.portal.ParseWithdrawalFinalized(log)
+			if err != nil {
+				return nil, err
+			}
+
+			bridgeEvents.finalizedWithdrawals = append(bridgeEvents.finalizedWithdrawals, crossDomainEvent{finalized.WithdrawalHash, event.GUID.String()})
+		}
+	}
+
+	bridgeEvents.deposits = d.skipUnsentDeposits(txHash, bridgeEvents.deposits, pendingDeposits)
+	return bridgeEvents, nil
+}
+
+// skipUnsentDeposits drops the pending deposits of the tx, which are never relayed without
+// a sent message. Retrying the batch would not link them, so they are logged and skipped
+// rather than halting the processor. Pending deposits are always the last decoded deposits.
+func (d *l1BridgeDecoder) skipUnsentDeposits(txHash common.Hash, deposits, pendingDeposits []*database.Deposit) []*database.Deposit {
+	if len(pendingDeposits) == 0 {
+		return deposits
+	}
+
+	d.log.Warn("skipping deposits without a sent message", "tx_hash", txHash, "size", len(pendingDeposits))
+	return deposits[:len(deposits)-len(pendingDeposits)]
+}
+
+type l2BridgeDecoder struct {
+	log       log.Logger
+	contracts L2Contracts
+
+	standardBridgeAbi *abi.ABI
+	messengerAbi      *abi.ABI
+	messagePasserAbi  *abi.ABI
+
+	standardBridge *bindings.L2StandardBridgeFilterer
+	messenger      *bindings.L2CrossDomainMessengerFilterer
+	messagePasser  *bindings.L2ToL1MessagePasserFilterer
+}
+
+func newL2BridgeDecoder(log log.Logger, contracts L2Contracts) (*l2BridgeDecoder, error) {
+	standardBridgeAbi, err := bindings.L2StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	messengerAbi, err := bindings.L2CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	messagePasserAbi, err := bindings.L2ToL1MessagePasserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// the filterers are only used to parse logs, a contract backend is not needed
+	standardBridge, err := bindings.NewL2StandardBridgeFilterer(contracts.L2StandardBridge, nil)
+	if err != nil {
+		return nil, err
+	}
+	messenger, err := bindings.NewL2CrossDomainMessengerFilterer(contracts.L2CrossDomainMessenger, nil)
+	if err != nil {
+		return nil, err
+	}
+	messagePasser, err := bindings.NewL2ToL1MessagePasserFilterer(contracts.L2ToL1MessagePasser, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l2BridgeDecoder{
+		log:               log,
+		contracts:         contracts,
+		standardBridgeAbi: standardBridgeAbi,
+		messengerAbi:      messengerAbi,
+		messagePasserAbi:  messagePasserAbi,
+		standardBridge:    standardBridge,
+		messenger:         messenger,
+		messagePasser:     messagePasser,
+	}, nil
+}
+
+// decode extracts the bridge withdrawals and deposit relays from the logs of a batch.
+// `events` must contain the contract event indexed for each log.
+//
+// The StandardBridge emits a withdrawal event prior to sending the cross domain message
+// through the L2ToL1MessagePasser. The withdrawal is linked to the withdrawal hash of the
+// next message passed by the L2CrossDomainMessenger within the same transaction.
+func (d *l2BridgeDecoder) decode(logs []types.Log, events []*database.L2ContractEvent) (*l2BridgeEvents, error) {
+	bridgeEvents := &l2BridgeEvents{}
+
+	var txHash common.Hash
+	var pendingWithdrawals []*database.Withdrawal
+	for i, log := range logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		if log.TxHash != txHash {
+			bridgeEvents.withdrawals = d.skipUnpassedWithdrawals(txHash, bridgeEvents.withdrawals, pendingWithdrawals)
+			pendingWithdrawals = nil
+
+			txHash = log.TxHash
+		}
+
+		event := events[i]
+		eventSig := log.Topics[0]
+		switch {
+		case log.Address == d.contracts.L2StandardBridge && eventSig == d.standardBridgeAbi.Events["WithdrawalInitiated"].ID:
+			withdrawalEvent, err := d.standardBridge.ParseWithdrawalInitiated(log)
+			if err != nil {
+				return nil, err
+			}
+
+			withdrawal := &database.Withdrawal{
+				GUID:                 uuid.New(),
+				InitiatedL2EventGUID: event.GUID.String(),
+				Tx: database.Transaction{
+					FromAddress: withdrawalEvent.From,
+					ToAddress:   withdrawalEvent.To,
+					Amount:      database.U256{Int: withdrawalEvent.Amount},
+					Data:        withdrawalEvent.ExtraData,
+					Timestamp:   event.Timestamp,
+				},
+				TokenPair: database.TokenPair{L1TokenAddress: withdrawalEvent.L1Token, L2TokenAddress: withdrawalEvent.L2Token},
+			}
+
+			bridgeEvents.withdrawals = append(bridgeEvents.withdrawals, withdrawal)
+			pendingWithdrawals = append(pendingWithdrawals, withdrawal)
+
+		case log.Address == d.contracts.L2ToL1MessagePasser && eventSig == d.messagePasserAbi.Events["MessagePassed"].ID:
+			msgPassed, err := d.messagePasser.ParseMessagePassed(log)
+			if err != nil {
+				return nil, err
+			}
+
+			if msgPassed.Sender != d.contracts.L2CrossDomainMessenger || len(pendingWithdrawals) == 0 {
+				continue
+			}
+
+			pendingWithdrawals[0].WithdrawalHash = msgPassed.WithdrawalHash
+			pendingWithdrawals = pendingWithdrawals[1:]
+
+		case log.Address == d.contracts.L2CrossDomainMessenger && eventSig == d.messengerAbi.Events["RelayedMessage"].ID:
+			relayed, err := d.messenger.ParseRelayedMessage(log)
+			if err != nil {
+				return nil, err
+			}
+
+			bridgeEvents.finalizedDeposits = append(bridgeEvents.finalizedDeposits, crossDomainEvent{relayed.MsgHash, event.GUID.String()})
+		}
+	}
+
+	bridgeEvents.withdrawals = d.skipUnpassedWithdrawals(txHash, bridgeEvents.withdrawals, pendingWithdrawals)
+	return bridgeEvents, nil
+}
+
+// skipUnpassedWithdrawals drops the pending withdrawals of the tx, which cannot be proven
+// without a passed message. Like deposits, they are logged and skipped rather than halting
+// the processor. Pending withdrawals are always the last decoded withdrawals.
+func (d *l2BridgeDecoder) skipUnpassedWithdrawals(txHash common.Hash, withdrawals, pendingWithdrawals []*database.Withdrawal) []*database.Withdrawal {
+	if len(pendingWithdrawals) == 0 {
+		return withdrawals
+	}
+
+	d.log.Warn("skipping withdrawals without a passed message", "tx_hash", txHash, "size", len(pendingWithdrawals))
+	return withdrawals[:len(withdrawals)-len(pendingWithdrawals)]
+}
@@ -0,0 +1,415 @@
+package processor
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// makeLog encodes the event with the supplied arguments, listed in the order of the event inputs
+func makeLog(t *testing.T, contractAbi *abi.ABI, name string, address common.Address, txHash common.Hash, args ...interface{}) types.Log {
+	event := contractAbi.Events[name]
+	require.Len(t, args, len(event.Inputs))
+
+	topics := []common.Hash{event.ID}
+	var nonIndexedArgs []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexedArgs = append(nonIndexedArgs, args[i])
+			continue
+		}
+
+		switch arg := args[i].(type) {
+		case common.Address:
+			topics = append(topics, common.BytesToHash(arg.Bytes()))
+		case common.Hash:
+			topics = append(topics, arg)
+		case *big.Int:
+			topics = append(topics, common.BigToHash(arg))
+		default:
+			t.Fatalf("unsupported indexed argument %T", arg)
+		}
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexedArgs...)
+	require.NoError(t, err)
+	return types.Log{Address: address, Topics: topics, Data: data, TxHash: txHash}
+}
+
+func makeL1ContractEvents(logs []types.Log) []*database.L1ContractEvent {
+	events := make([]*database.L1ContractEvent, len(logs))
+	for i, log := range logs {
+		events[i] = &database.L1ContractEvent{ContractEvent: database.ContractEvent{GUID: uuid.New(), TransactionHash: log.TxHash, Timestamp: 10}}
+	}
+	return events
+}
+
+func makeL2ContractEvents(logs []types.Log) []*database.L2ContractEvent {
+	events := make([]*database.L2ContractEvent, len(logs))
+	for i, log := range logs {
+		events[i] = &database.L2ContractEvent{ContractEvent: database.ContractEvent{GUID: uuid.New(), TransactionHash: log.TxHash, Timestamp: 10}}
+	}
+	return events
+}
+
+func TestL1BridgeDecoderDeposits(t *testing.T) {
+	contracts := L1Contracts{
+		OptimismPortal:         common.HexToAddress("0x01"),
+		L1CrossDomainMessenger: common.HexToAddress("0x02"),
+		L1StandardBridge:       common.HexToAddress("0x03"),
+	}
+	decoder, err := newL1BridgeDecoder(log.New(), contracts)
+	require.NoError(t, err)
+
+	from, to := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	l1Token, l2Token := common.HexToAddress("0xcc"), common.HexToAddress("0xdd")
+	ethTx, erc20Tx := common.HexToHash("0x11"), common.HexToHash("0x22")
+
+	nonce, gasLimit, value := big.NewInt(1), big.NewInt(200_000), big.NewInt(100)
+	l2Bridge := predeploys.L2StandardBridgeAddr
+
+	logs := []types.Log{
+		makeLog(t, decoder.standardBridgeAbi, "ETHDepositInitiated", contracts.L1StandardBridge, ethTx, from, to, value, []byte{}),
+		makeLog(t, decoder.messengerAbi, "SentMessage", contracts.L1CrossDomainMessenger, ethTx, l2Bridge, contracts.L1StandardBridge, []byte{0x01}, nonce, gasLimit),
+		makeLog(t, decoder.messengerAbi, "SentMessageExtension1", contracts.L1CrossDomainMessenger, ethTx, contracts.L1StandardBridge, value),
+
+		// messages sent directly through the messenger are not deposits
+		makeLog(t, decoder.messengerAbi, "SentMessage", contracts.L1CrossDomainMessenger, erc20Tx, to, from, []byte{0x02}, nonce, gasLimit),
+		makeLog(t, decoder.messengerAbi, "SentMessageExtension1", contracts.L1CrossDomainMessenger, erc20Tx, from, big.NewInt(0)),
+
+		makeLog(t, decoder.standardBridgeAbi, "ERC20DepositInitiated", contracts.L1StandardBridge, erc20Tx, l1Token, l2Token, from, to, value, []byte{0x03}),
+		makeLog(t, decoder.messengerAbi, "SentMessage", contracts.L1CrossDomainMessenger, erc20Tx, l2Bridge, contracts.L1StandardBridge, []byte{0x04}, big.NewInt(2), gasLimit),
+		makeLog(t, decoder.messengerAbi, "SentMessageExtension1", contracts.L1CrossDomainMessenger, erc20Tx, contracts.L1StandardBridge, big.NewInt(0)),
+	}
+	events := makeL1ContractEvents(logs)
+
+	bridgeEvents, err := decoder.decode(logs, events)
+	require.NoError(t, err)
+	require.Len(t, bridgeEvents.deposits, 2)
+
+	ethDeposit := bridgeEvents.deposits[0]
+	require.Equal(t, events[0].GUID.String(), ethDeposit.InitiatedL1EventGUID)
+	require.Equal(t, from, ethDeposit.Tx.FromAddress)
+	require.Equal(t, to, ethDeposit.Tx.ToAddress)
+	require.Equal(t, value, ethDeposit.Tx.Amount.Int)
+	require.Equal(t, uint64(10), ethDeposit.Tx.Timestamp)
+	require.Equal(t, common.Address{}, ethDeposit.TokenPair.L1TokenAddress)
+	require.Equal(t, predeploys.LegacyERC20ETHAddr, ethDeposit.TokenPair.L2TokenAddress)
+
+	ethMsgHash, err := crossdomain.HashCrossDomainMessageV1(nonce, contracts.L1StandardBridge, l2Bridge, value, gasLimit, []byte{0x01})
+	require.NoError(t, err)
+	require.Equal(t, ethMsgHash, ethDeposit.SentMessageHash)
+
+	erc20Deposit := bridgeEvents.deposits[1]
+	require.Equal(t, events[5].GUID.String(), erc20Deposit.InitiatedL1EventGUID)
+	require.Equal(t, l1Token, erc20Deposit.TokenPair.L1TokenAddress)
+	require.Equal(t, l2Token, erc20Deposit.TokenPair.L2TokenAddress)
+	require.Equal(t, []byte{0x03}, []byte(erc20Deposit.Tx.Data))
+
+	erc20MsgHash, err := crossdomain.HashCrossDomainMessageV1(big.NewInt(2), contracts.L1StandardBridge, l2Bridge, big.NewInt(0), gasLimit, []byte{0x04})
+	require.NoError(t, err)
+	require.Equal(t, erc20MsgHash, erc20Deposit.SentMessageHash)
+}
+
+func TestL1BridgeDecoderWithdrawals(t *testing.T) {
+	contracts := L1Contracts{OptimismPortal: common.HexToAddress("0x01")}
+	decoder, err := newL1BridgeDecoder(log.New(), contracts)
+	require.NoError(t, err)
+
+	withdrawalHash := common.HexToHash("0x1234")
+	from, to := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	logs := []types.Log{
+		makeLog(t, decoder.portalAbi, "WithdrawalProven", contracts.OptimismPortal, common.HexToHash("0x11"), withdrawalHash, from, to),
+		makeLog(t, decoder.portalAbi, "WithdrawalFinalized", contracts.OptimismPortal, common.HexToHash("0x22"), withdrawalHash, true),
+
+		// events from other contracts are ignored
+		makeLog(t, decoder.portalAbi, "WithdrawalFinalized", common.HexToAddress("0x02"), common.HexToHash("0x22"), withdrawalHash, true),
+	}
+	events := makeL1ContractEvents(logs)
+
+	bridgeEvents, err := decoder.decode(logs, events)
+	require.NoError(t, err)
+	require.Empty(t, bridgeEvents.deposits)
+	require.Equal(t, []crossDomainEvent{{withdrawalHash, events[0].GUID.String()}}, bridgeEvents.provenWithdrawals)
+	require.Equal(t, []crossDomainEvent{{withdrawalHash, events[1].GUID.String()}}, bridgeEvents.finalizedWithdrawals)
+}
+
+func TestL1BridgeDecoderDepositWithoutMessage(t *testing.T) {
+	contracts := L1Contracts{L1StandardBridge: common.HexToAddress("0x03"), OptimismPortal: common.HexToAddress("0x01")}
+	decoder, err := newL1BridgeDecoder(log.New(), contracts)
+	require.NoError(t, err)
+
+	// the deposit is skipped, without dropping the other events of the batch
+	withdrawalHash := common.HexToHash("0x1234")
+	logs := []types.Log{
+		makeLog(t, decoder.standardBridgeAbi, "ETHDepositInitiated", contracts.L1StandardBridge, common.HexToHash("0x11"), common.Address{}, common.Address{}, big.NewInt(1), []byte{}),
+		makeLog(t, decoder.portalAbi, "WithdrawalFinalized", contracts.OptimismPortal, common.HexToHash("0x22"), withdrawalHash, true),
+		makeLog(t, decoder.standardBridgeAbi, "ETHDepositInitiated", contracts.L1StandardBridge, common.HexToHash("0x33"), common.Address{}, common.Address{}, big.NewInt(1), []byte{}),
+	}
+	events := makeL1ContractEvents(logs)
+
+	bridgeEvents, err := decoder.decode(logs, events)
+	require.NoError(t, err)
+	require.Empty(t, bridgeEvents.deposits)
+	require.Equal(t, []crossDomainEvent{{withdrawalHash, events[1].GUID.String()}}, bridgeEvents.finalizedWithdrawals)
+}
+
+func TestL2BridgeDecoderWithdrawalWithoutMessage(t *testing.T) {
+	contracts := L2ContractPredeploys()
+	decoder, err := newL2BridgeDecoder(log.New(), contracts)
+	require.NoError(t, err)
+
+	l1Token, l2Token := common.HexToAddress("0xcc"), common.HexToAddress("0xdd")
+	msgHash := common.HexToHash("0x5678")
+	logs := []types.Log{
+		makeLog(t, decoder.standardBridgeAbi, "WithdrawalInitiated", contracts.L2StandardBridge, common.HexToHash("0x11"), l1Token, l2Token, common.Address{}, common.Address{}, big.NewInt(1), []byte{}),
+		makeLog(t, decoder.messengerAbi, "RelayedMessage", contracts.L2CrossDomainMessenger, common.HexToHash("0x22"), msgHash),
+	}
+	events := makeL2ContractEvents(logs)
+
+	bridgeEvents, err := decoder.decode(logs, events)
+	require.NoError(t, err)
+	require.Empty(t, bridgeEvents.withdrawals)
+	require.Equal(t, []crossDomainEvent{{msgHash, events[1].GUID.String()}}, bridgeEvents.finalizedDeposits)
+}
+
+func TestL2BridgeDecoder(t *testing.T) {
+	contracts := L2ContractPredeploys()
+	decoder, err := newL2BridgeDecoder(log.New(), contracts)
+	require.NoError(t, err)
+
+	from, to := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	l1Token, l2Token := common.HexToAddress("0xcc"), common.HexToAddress("0xdd")
+	withdrawalTx, relayTx := common.HexToHash("0x11"), common.HexToHash("0x22")
+	withdrawalHash, msgHash := common.HexToHash("0x1234"), common.HexToHash("0x5678")
+	amount := big.NewInt(100)
+
+	logs := []types.Log{
+		makeLog(t, decoder.standardBridgeAbi, "WithdrawalInitiated", contracts.L2StandardBridge, withdrawalTx, l1Token, l2Token, from, to, amount, []byte{0x01}),
+		makeLog(t, decoder.messagePasserAbi, "MessagePassed", contracts.L2ToL1MessagePasser, withdrawalTx,
+			big.NewInt(1), contracts.L2CrossDomainMessenger, common.HexToAddress("0xee"), big.NewInt(0), big.NewInt(200_000), []byte{0x02}, withdrawalHash),
+		makeLog(t, decoder.messengerAbi, "RelayedMessage", contracts.L2CrossDomainMessenger, relayTx, msgHash),
+	}
+	events := makeL2ContractEvents(logs)
+
+	bridgeEvents, err := decoder.decode(logs, events)
+	require.NoError(t, err)
+	require.Len(t, bridgeEvents.withdrawals, 1)
+
+	withdrawal := bridgeEvents.withdrawals[0]
+	require.Equal(t, events[0].GUID.String(), withdrawal.InitiatedL2EventGUID)
+	require.Equal(t, withdrawalHash, withdrawal.WithdrawalHash)
+	require.Equal(t, from, withdrawal.Tx.FromAddress)
+	require.Equal(t, to, withdrawal.Tx.ToAddress)
+	require.Equal(t, amount, withdrawal.Tx.Amount.Int)
+	require.Equal(t, l1Token, withdrawal.TokenPair.L1TokenAddress)
+	require.Equal(t, l2Token, withdrawal.TokenPair.L2TokenAddress)
+
+	require.Equal(t, []crossDomainEvent{{msgHash, events[2].GUID.String()}}, bridgeEvents.finalizedDeposits)
+}
+
+func TestL2BridgeDecoderIgnoresOtherMessages(t *testing.T) {
+	contracts := L2ContractPredeploys()
+	decoder, err := newL2BridgeDecoder(log.New(), contracts)
+	require.NoError(t, err)
+
+	// withdrawals sent directly through the message passer are not bridge withdrawals
+	msgPassed := makeLog(t, decoder.messagePasserAbi, "MessagePassed", contracts.L2ToL1MessagePasser, common.HexToHash("0x11"),
+		big.NewInt(1), common.HexToAddress("0xaa"), common.HexToAddress("0xbb"), big.NewInt(1), big.NewInt(200_000), []byte{}, common.HexToHash("0x1234"))
+
+	logs := []types.Log{msgPassed}
+	bridgeEvents, err := decoder.decode(logs, makeL2ContractEvents(logs))
+	require.NoError(t, err)
+	require.Empty(t, bridgeEvents.withdrawals)
+	require.Empty(t, bridgeEvents.finalizedDeposits)
+}
+
+// memBridgeDB is an in-memory BridgeDB, with the pending events semantics of the postgres implementation
+type memBridgeDB struct {
+	deposits    []*database.Deposit
+	withdrawals []*database.Withdrawal
+
+	pendingDepositEvents    []*database.PendingDepositEvent
+	pendingWithdrawalEvents []*database.PendingWithdrawalEvent
+}
+
+func (db *memBridgeDB) DepositsByAddress(address common.Address) ([]*database.DepositWithTransactionHash, error) {
+	panic("not implemented")
+}
+
+func (db *memBridgeDB) DepositBySentMessageHash(msgHash common.Hash) (*database.Deposit, error) {
+	for _, deposit := range db.deposits {
+		if deposit.SentMessageHash == msgHash {
+			return deposit, nil
+		}
+	}
+	return nil, nil
+}
+
+func (db *memBridgeDB) WithdrawalsByAddress(address common.Address) ([]*database.WithdrawalWithTransactionHashes, error) {
+	panic("not implemented")
+}
+
+func (db *memBridgeDB) WithdrawalByHash(withdrawalHash common.Hash) (*database.Withdrawal, error) {
+	for _, withdrawal := range db.withdrawals {
+		if withdrawal.WithdrawalHash == withdrawalHash {
+			return withdrawal, nil
+		}
+	}
+	return nil, nil
+}
+
+func (db *memBridgeDB) StoreDeposits(deposits []*database.Deposit) error {
+	db.deposits = append(db.deposits, deposits...)
+	return nil
+}
+
+func (db *memBridgeDB) MarkFinalizedDepositEvent(guid, finalizedL2EventGuid string) error {
+	for _, deposit := range db.deposits {
+		if deposit.GUID.String() == guid {
+			deposit.FinalizedL2EventGUID = &finalizedL2EventGuid
+		}
+	}
+	return nil
+}
+
+func (db *memBridgeDB) StorePendingDepositEvents(events []*database.PendingDepositEvent) error {
+	db.pendingDepositEvents = append(db.pendingDepositEvents, events...)
+	return nil
+}
+
+func (db *memBridgeDB) MarkPendingDepositEvents() error {
+	var pending []*database.PendingDepositEvent
+	for _, event := range db.pendingDepositEvents {
+		deposit, _ := db.DepositBySentMessageHash(event.SentMessageHash)
+		if deposit == nil {
+			pending = append(pending, event)
+			continue
+		}
+		deposit.FinalizedL2EventGUID = &event.L2EventGUID
+	}
+	db.pendingDepositEvents = pending
+	return nil
+}
+
+func (db *memBridgeDB) StoreWithdrawals(withdrawals []*database.Withdrawal) error {
+	db.withdrawals = append(db.withdrawals, withdrawals...)
+	return nil
+}
+
+func (db *memBridgeDB) MarkProvenWithdrawalEvent(guid, provenL1EventGuid string) error {
+	for _, withdrawal := range db.withdrawals {
+		if withdrawal.GUID.String() == guid {
+			withdrawal.ProvenL1EventGUID = &provenL1EventGuid
+		}
+	}
+	return nil
+}
+
+func (db *memBridgeDB) MarkFinalizedWithdrawalEvent(guid, finalizedL1EventGuid string) error {
+	for _, withdrawal := range db.withdrawals {
+		if withdrawal.GUID.String() == guid {
+			withdrawal.FinalizedL1EventGUID = &finalizedL1EventGuid
+		}
+	}
+	return nil
+}
+
+func (db *memBridgeDB) StorePendingWithdrawalEvents(events []*database.PendingWithdrawalEvent) error {
+	db.pendingWithdrawalEvents = append(db.pendingWithdrawalEvents, events...)
+	return nil
+}
+
+func (db *memBridgeDB) MarkPendingWithdrawalEvents() error {
+	var pending []*database.PendingWithdrawalEvent
+	for _, event := range db.pendingWithdrawalEvents {
+		withdrawal, _ := db.WithdrawalByHash(event.WithdrawalHash)
+		if withdrawal == nil {
+			pending = append(pending, event)
+			continue
+		}
+		if event.Finalized {
+			withdrawal.FinalizedL1EventGUID = &event.L1EventGUID
+		} else {
+			withdrawal.ProvenL1EventGUID = &event.L1EventGUID
+		}
+	}
+	db.pendingWithdrawalEvents = pending
+	return nil
+}
+
+func TestBridgeEventsOutOfOrder(t *testing.T) {
+	l1Contracts := L1Contracts{
+		OptimismPortal:         common.HexToAddress("0x01"),
+		L1CrossDomainMessenger: common.HexToAddress("0x02"),
+		L1StandardBridge:       common.HexToAddress("0x03"),
+	}
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+	l1Decoder, err := newL1BridgeDecoder(logger, l1Contracts)
+	require.NoError(t, err)
+	l2Contracts := L2ContractPredeploys()
+	l2Decoder, err := newL2BridgeDecoder(logger, l2Contracts)
+	require.NoError(t, err)
+
+	bridgeDB := new(memBridgeDB)
+	db := &database.DB{Bridge: bridgeDB}
+
+	from, to := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	nonce, gasLimit, value := big.NewInt(1), big.NewInt(200_000), big.NewInt(100)
+	l2Bridge := predeploys.L2StandardBridgeAddr
+	depositMsgHash, err := crossdomain.HashCrossDomainMessageV1(nonce, l1Contracts.L1StandardBridge, l2Bridge, value, gasLimit, []byte{})
+	require.NoError(t, err)
+	withdrawalHash := common.HexToHash("0x1234")
+
+	// The L1 processor is ahead: the withdrawal is proven & finalized before it is indexed on L2
+	l1Logs := []types.Log{
+		makeLog(t, l1Decoder.portalAbi, "WithdrawalProven", l1Contracts.OptimismPortal, common.HexToHash("0x11"), withdrawalHash, from, to),
+		makeLog(t, l1Decoder.portalAbi, "WithdrawalFinalized", l1Contracts.OptimismPortal, common.HexToHash("0x12"), withdrawalHash, true),
+	}
+	l1Events := makeL1ContractEvents(l1Logs)
+	require.NoError(t, l1ProcessBridgeEvents(logger, db, l1Decoder, l1Logs, l1Events))
+	require.Len(t, bridgeDB.pendingWithdrawalEvents, 2)
+
+	// The L2 processor is ahead: the deposit is relayed before it is indexed on L1
+	relayLogs := []types.Log{
+		makeLog(t, l2Decoder.messengerAbi, "RelayedMessage", l2Contracts.L2CrossDomainMessenger, common.HexToHash("0x21"), depositMsgHash),
+	}
+	relayEvents := makeL2ContractEvents(relayLogs)
+	require.NoError(t, l2ProcessBridgeEvents(logger, db, l2Decoder, relayLogs, relayEvents))
+	require.Len(t, bridgeDB.pendingDepositEvents, 1)
+
+	// Once indexed, the withdrawal is marked with the pending events
+	withdrawalLogs := []types.Log{
+		makeLog(t, l2Decoder.standardBridgeAbi, "WithdrawalInitiated", l2Contracts.L2StandardBridge, common.HexToHash("0x22"),
+			common.Address{}, predeploys.LegacyERC20ETHAddr, from, to, value, []byte{}),
+		makeLog(t, l2Decoder.messagePasserAbi, "MessagePassed", l2Contracts.L2ToL1MessagePasser, common.HexToHash("0x22"),
+			big.NewInt(1), l2Contracts.L2CrossDomainMessenger, common.HexToAddress("0xee"), big.NewInt(0), gasLimit, []byte{}, withdrawalHash),
+	}
+	require.NoError(t, l2ProcessBridgeEvents(logger, db, l2Decoder, withdrawalLogs, makeL2ContractEvents(withdrawalLogs)))
+	require.Len(t, bridgeDB.withdrawals, 1)
+	require.Equal(t, l1Events[0].GUID.String(), *bridgeDB.withdrawals[0].ProvenL1EventGUID)
+	require.Equal(t, l1Events[1].GUID.String(), *bridgeDB.withdrawals[0].FinalizedL1EventGUID)
+	require.Empty(t, bridgeDB.pendingWithdrawalEvents)
+
+	// Once indexed, the deposit is marked with the pending relay
+	depositLogs := []types.Log{
+		makeLog(t, l1Decoder.standardBridgeAbi, "ETHDepositInitiated", l1Contracts.L1StandardBridge, common.HexToHash("0x13"), from, to, value, []byte{}),
+		makeLog(t, l1Decoder.messengerAbi, "SentMessage", l1Contracts.L1CrossDomainMessenger, common.HexToHash("0x13"), l2Bridge, l1Contracts.L1StandardBridge, []byte{}, nonce, gasLimit),
+		makeLog(t, l1Decoder.messengerAbi, "SentMessageExtension1", l1Contracts.L1CrossDomainMessenger, common.HexToHash("0x13"), l1Contracts.L1StandardBridge, value),
+	}
+	require.NoError(t, l1ProcessBridgeEvents(logger, db, l1Decoder, depositLogs, makeL1ContractEvents(depositLogs)))
+	require.Len(t, bridgeDB.deposits, 1)
+	require.Equal(t, relayEvents[0].GUID.String(), *bridgeDB.deposits[0].FinalizedL2EventGUID)
+	require.Empty(t, bridgeDB.pendingDepositEvents)
+}
@@ -3,10 +3,12 @@ package processor
 import (
 	"context"
 	"errors"
+	"math/big"
 	"reflect"
 
 	"github.com/ethereum-optimism/optimism/indexer/database"
 	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum-optimism/optimism/op-service/backoff"
 	"github.com/google/uuid"
 
 	"github.com/ethereum/go-ethereum"
@@ -46,7 +48,7 @@ type L1Processor struct {
 	processor
 }
 
-func NewL1Processor(ethClient node.EthClient, db *database.DB, l1Contracts L1Contracts) (*L1Processor, error) {
+func NewL1Processor(ethClient node.EthClient, db *database.DB, l1Contracts L1Contracts, indexUnfinalized bool) (*L1Processor, error) {
 	l1ProcessLog := log.New("processor", "l1")
 	l1ProcessLog.Info("initializing processor")
 
@@ -55,6 +57,19 @@ func NewL1Processor(ethClient node.EthClient, db *database.DB, l1Contracts L1Con
 		return nil, err
 	}
 
+	// unfinalized state may have been reorged while offline. Rather than
+	// checking against the provider, all unfinalized state is re-indexed
+	rollbackHeight := big.NewInt(-1)
+	if latestHeader != nil {
+		rollbackHeight = latestHeader.Number.Int
+	}
+	err = db.Transaction(func(db *database.DB) error {
+		return db.RollbackL1BlockHeaders(rollbackHeight)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var fromL1Header *types.Header
 	if latestHeader != nil {
 		l1ProcessLog.Info("detected last indexed block", "height", latestHeader.Number.Int, "hash", latestHeader.Hash)
@@ -71,20 +86,50 @@ func NewL1Processor(ethClient node.EthClient, db *database.DB, l1Contracts L1Con
 		fromL1Header = nil
 	}
 
+	l1ProcessFn, err := l1ProcessFn(l1ProcessLog, ethClient, l1Contracts)
+	if err != nil {
+		return nil, err
+	}
+
 	l1Processor := &L1Processor{
 		processor: processor{
-			fetcher:    node.NewFetcher(ethClient, fromL1Header),
-			db:         db,
-			processFn:  l1ProcessFn(l1ProcessLog, ethClient, l1Contracts),
-			processLog: l1ProcessLog,
+			fetcher:          node.NewFetcher(ethClient, fromL1Header),
+			ethClient:        ethClient,
+			indexUnfinalized: indexUnfinalized,
+			db:               db,
+			headerStore:      l1HeaderStore,
+			processFn:        l1ProcessFn,
+			processLog:       l1ProcessLog,
+			retryStrategy:    backoff.Exponential(),
 		},
 	}
 
 	return l1Processor, nil
 }
 
-func l1ProcessFn(processLog log.Logger, ethClient node.EthClient, l1Contracts L1Contracts) func(db *database.DB, headers []*types.Header) error {
+var l1HeaderStore = headerStore{
+	latestHeader: func(db *database.DB) (*database.BlockHeader, error) {
+		header, err := db.Blocks.LatestL1BlockHeader()
+		if err != nil || header == nil {
+			return nil, err
+		}
+
+		return &header.BlockHeader, nil
+	},
+	markFinalized: func(db *database.DB, height *big.Int) error {
+		return db.Blocks.MarkFinalizedL1BlockHeaders(height)
+	},
+	rollback: func(db *database.DB, height *big.Int) error {
+		return db.RollbackL1BlockHeaders(height)
+	},
+}
+
+func l1ProcessFn(processLog log.Logger, ethClient node.EthClient, l1Contracts L1Contracts) (processFn, error) {
 	rawEthClient := ethclient.NewClient(ethClient.RawRpcClient())
+	bridgeDecoder, err := newL1BridgeDecoder(processLog, l1Contracts)
+	if err != nil {
+		return nil, err
+	}
 
 	contractAddrs := l1Contracts.toSlice()
 	processLog.Info("processor configured with contracts", "contracts", l1Contracts)
@@ -165,11 +210,83 @@ func l1ProcessFn(processLog log.Logger, ethClient node.EthClient, l1Contracts L1
 			if err != nil {
 				return err
 			}
+
+			err = l1ProcessBridgeEvents(processLog, db, bridgeDecoder, logs, l1ContractEvents)
+			if err != nil {
+				return err
+			}
 		} else {
 			processLog.Info("no l1 blocks of interest within batch")
 		}
 
 		// a-ok!
 		return nil
+	}, nil
+}
+
+// l1ProcessBridgeEvents stores the deposits initiated within the batch and marks the
+// proven & finalized withdrawals. The events of withdrawals that have not been indexed on L2
+// are stored as pending, and marked once the withdrawals are indexed. Likewise, the pending
+// relays of the stored deposits are marked.
+func l1ProcessBridgeEvents(processLog log.Logger, db *database.DB, bridgeDecoder *l1BridgeDecoder, logs []types.Log, events []*database.L1ContractEvent) error {
+	bridgeEvents, err := bridgeDecoder.decode(logs, events)
+	if err != nil {
+		return err
+	}
+
+	numDeposits := len(bridgeEvents.deposits)
+	if numDeposits > 0 {
+		processLog.Info("detected deposits", "size", numDeposits)
+		err = db.Bridge.StoreDeposits(bridgeEvents.deposits)
+		if err != nil {
+			return err
+		}
+	}
+
+	var pendingEvents []*database.PendingWithdrawalEvent
+	for _, proven := range bridgeEvents.provenWithdrawals {
+		withdrawal, err := db.Bridge.WithdrawalByHash(proven.hash)
+		if err != nil {
+			return err
+		} else if withdrawal == nil {
+			// not indexed on L2 yet, or not a bridge withdrawal
+			processLog.Debug("no indexed withdrawal for proven event", "withdrawal_hash", proven.hash)
+			pendingEvents = append(pendingEvents, &database.PendingWithdrawalEvent{L1EventGUID: proven.eventGUID, WithdrawalHash: proven.hash})
+			continue
+		}
+
+		err = db.Bridge.MarkProvenWithdrawalEvent(withdrawal.GUID.String(), proven.eventGUID)
+		if err != nil {
+			return err
+		}
 	}
+
+	for _, finalized := range bridgeEvents.finalizedWithdrawals {
+		withdrawal, err := db.Bridge.WithdrawalByHash(finalized.hash)
+		if err != nil {
+			return err
+		} else if withdrawal == nil {
+			processLog.Debug("no indexed withdrawal for finalized event", "withdrawal_hash", finalized.hash)
+			pendingEvents = append(pendingEvents, &database.PendingWithdrawalEvent{L1EventGUID: finalized.eventGUID, WithdrawalHash: finalized.hash, Finalized: true})
+			continue
+		}
+
+		err = db.Bridge.MarkFinalizedWithdrawalEvent(withdrawal.GUID.String(), finalized.eventGUID)
+		if err != nil {
+			return err
+		}
+	}
+
+	numPendingEvents := len(pendingEvents)
+	if numPendingEvents > 0 {
+		processLog.Info("storing pending withdrawal events", "size", numPendingEvents)
+		err = db.Bridge.StorePendingWithdrawalEvents(pendingEvents)
+		if err != nil {
+			return err
+		}
+	}
+
+	// All pending relays are considered rather than just those of the deposits in this batch,
+	// since relays indexed concurrently with a previous batch may have been missed
+	return db.Bridge.MarkPendingDepositEvents()
 }
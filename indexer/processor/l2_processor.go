@@ -3,10 +3,12 @@ package processor
 import (
 	"context"
 	"errors"
+	"math/big"
 	"reflect"
 
 	"github.com/ethereum-optimism/optimism/indexer/database"
 	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum-optimism/optimism/op-service/backoff"
 	"github.com/google/uuid"
 
 	"github.com/ethereum/go-ethereum"
@@ -54,7 +56,7 @@ type L2Processor struct {
 	processor
 }
 
-func NewL2Processor(ethClient node.EthClient, db *database.DB, l2Contracts L2Contracts) (*L2Processor, error) {
+func NewL2Processor(ethClient node.EthClient, db *database.DB, l2Contracts L2Contracts, indexUnfinalized bool) (*L2Processor, error) {
 	l2ProcessLog := log.New("processor", "l2")
 	l2ProcessLog.Info("initializing processor")
 
@@ -63,6 +65,19 @@ func NewL2Processor(ethClient node.EthClient, db *database.DB, l2Contracts L2Con
 		return nil, err
 	}
 
+	// unfinalized state may have been reorged while offline. Rather than
+	// checking against the provider, all unfinalized state is re-indexed
+	rollbackHeight := big.NewInt(-1)
+	if latestHeader != nil {
+		rollbackHeight = latestHeader.Number.Int
+	}
+	err = db.Transaction(func(db *database.DB) error {
+		return db.RollbackL2BlockHeaders(rollbackHeight)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var fromL2Header *types.Header
 	if latestHeader != nil {
 		l2ProcessLog.Info("detected last indexed block", "height", latestHeader.Number.Int, "hash", latestHeader.Hash)
@@ -78,20 +93,50 @@ func NewL2Processor(ethClient node.EthClient, db *database.DB, l2Contracts L2Con
 		fromL2Header = nil
 	}
 
+	l2ProcessFn, err := l2ProcessFn(l2ProcessLog, ethClient, l2Contracts)
+	if err != nil {
+		return nil, err
+	}
+
 	l2Processor := &L2Processor{
 		processor: processor{
-			fetcher:    node.NewFetcher(ethClient, fromL2Header),
-			db:         db,
-			processFn:  l2ProcessFn(l2ProcessLog, ethClient, l2Contracts),
-			processLog: l2ProcessLog,
+			fetcher:          node.NewFetcher(ethClient, fromL2Header),
+			ethClient:        ethClient,
+			indexUnfinalized: indexUnfinalized,
+			db:               db,
+			headerStore:      l2HeaderStore,
+			processFn:        l2ProcessFn,
+			processLog:       l2ProcessLog,
+			retryStrategy:    backoff.Exponential(),
 		},
 	}
 
 	return l2Processor, nil
 }
 
-func l2ProcessFn(processLog log.Logger, ethClient node.EthClient, l2Contracts L2Contracts) func(db *database.DB, headers []*types.Header) error {
+var l2HeaderStore = headerStore{
+	latestHeader: func(db *database.DB) (*database.BlockHeader, error) {
+		header, err := db.Blocks.LatestL2BlockHeader()
+		if err != nil || header == nil {
+			return nil, err
+		}
+
+		return &header.BlockHeader, nil
+	},
+	markFinalized: func(db *database.DB, height *big.Int) error {
+		return db.Blocks.MarkFinalizedL2BlockHeaders(height)
+	},
+	rollback: func(db *database.DB, height *big.Int) error {
+		return db.RollbackL2BlockHeaders(height)
+	},
+}
+
+func l2ProcessFn(processLog log.Logger, ethClient node.EthClient, l2Contracts L2Contracts) (processFn, error) {
 	rawEthClient := ethclient.NewClient(ethClient.RawRpcClient())
+	bridgeDecoder, err := newL2BridgeDecoder(processLog, l2Contracts)
+	if err != nil {
+		return nil, err
+	}
 
 	contractAddrs := l2Contracts.toSlice()
 	processLog.Info("processor configured with contracts", "contracts", l2Contracts)
@@ -159,9 +204,65 @@ func l2ProcessFn(processLog log.Logger, ethClient node.EthClient, l2Contracts L2
 			if err != nil {
 				return err
 			}
+
+			err = l2ProcessBridgeEvents(processLog, db, bridgeDecoder, logs, l2ContractEvents)
+			if err != nil {
+				return err
+			}
 		}
 
 		// a-ok!
 		return nil
+	}, nil
+}
+
+// l2ProcessBridgeEvents stores the withdrawals initiated within the batch and marks the
+// relayed deposits as finalized. The relays of deposits that have not been indexed on L1 are
+// stored as pending, and marked once the deposits are indexed. Likewise, the pending proven
+// & finalized events of the stored withdrawals are marked.
+func l2ProcessBridgeEvents(processLog log.Logger, db *database.DB, bridgeDecoder *l2BridgeDecoder, logs []types.Log, events []*database.L2ContractEvent) error {
+	bridgeEvents, err := bridgeDecoder.decode(logs, events)
+	if err != nil {
+		return err
+	}
+
+	numWithdrawals := len(bridgeEvents.withdrawals)
+	if numWithdrawals > 0 {
+		processLog.Info("detected withdrawals", "size", numWithdrawals)
+		err = db.Bridge.StoreWithdrawals(bridgeEvents.withdrawals)
+		if err != nil {
+			return err
+		}
+	}
+
+	var pendingEvents []*database.PendingDepositEvent
+	for _, finalized := range bridgeEvents.finalizedDeposits {
+		deposit, err := db.Bridge.DepositBySentMessageHash(finalized.hash)
+		if err != nil {
+			return err
+		} else if deposit == nil {
+			// not indexed on L1 yet, or relayed messages not sent by the standard bridge
+			processLog.Debug("no indexed deposit for relayed message", "msg_hash", finalized.hash)
+			pendingEvents = append(pendingEvents, &database.PendingDepositEvent{L2EventGUID: finalized.eventGUID, SentMessageHash: finalized.hash})
+			continue
+		}
+
+		err = db.Bridge.MarkFinalizedDepositEvent(deposit.GUID.String(), finalized.eventGUID)
+		if err != nil {
+			return err
+		}
 	}
+
+	numPendingEvents := len(pendingEvents)
+	if numPendingEvents > 0 {
+		processLog.Info("storing pending relayed messages", "size", numPendingEvents)
+		err = db.Bridge.StorePendingDepositEvents(pendingEvents)
+		if err != nil {
+			return err
+		}
+	}
+
+	// All pending events are considered rather than just those of the withdrawals in this batch,
+	// since events indexed concurrently with a previous batch may have been missed
+	return db.Bridge.MarkPendingWithdrawalEvents()
 }
@@ -1,10 +1,13 @@
 package processor
 
 import (
+	"errors"
+	"math/big"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/indexer/database"
 	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum-optimism/optimism/op-service/backoff"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -12,53 +15,190 @@ import (
 
 const defaultLoopInterval = 5 * time.Second
 
+var bigOne = big.NewInt(1)
+
 // processFn is the the function used to process unindexed headers. In
 // the event of a failure, all database operations are not committed
 type processFn func(*database.DB, []*types.Header) error
 
+// headerStore provides the layer specific access to the indexed block headers,
+// used to track their finality and roll back reorged headers
+type headerStore struct {
+	latestHeader  func(*database.DB) (*database.BlockHeader, error)
+	markFinalized func(*database.DB, *big.Int) error
+	rollback      func(*database.DB, *big.Int) error
+}
+
 type processor struct {
-	fetcher *node.Fetcher
+	fetcher   *node.Fetcher
+	ethClient node.EthClient
 
-	db         *database.DB
-	processFn  processFn
-	processLog log.Logger
+	// when set, the processor follows the latest head rather than the
+	// finalized head and rolls back indexed state on reorgs
+	indexUnfinalized bool
+
+	db          *database.DB
+	headerStore headerStore
+	processFn   processFn
+	processLog  log.Logger
+
+	retryStrategy backoff.Strategy
 }
 
 // Start kicks off the processing loop
 func (p processor) Start() {
 	pollTicker := time.NewTicker(defaultLoopInterval)
-	p.processLog.Info("starting processor...")
+	p.processLog.Info("starting processor...", "index_unfinalized", p.indexUnfinalized)
 
 	// Make this loop stoppable
+	attempt := 0
 	for range pollTicker.C {
 		p.processLog.Info("checking for new headers...")
 
-		headers, err := p.fetcher.NextFinalizedHeaders()
+		err := p.processNextHeaders()
 		if err != nil {
-			p.processLog.Error("unable to query for headers", "err", err)
+			attempt++
+			delay := p.retryStrategy.Duration(attempt)
+			p.processLog.Error("unable to index headers. retrying...", "attempt", attempt, "delay", delay, "err", err)
+			time.Sleep(delay)
 			continue
 		}
 
-		if len(headers) == 0 {
-			p.processLog.Info("no new headers. indexer must be at head...")
-			continue
+		attempt = 0
+	}
+}
+
+// processNextHeaders indexes the next batch of headers and updates the finality of the
+// indexed headers. If the batch fails to be indexed, the fetcher is rewound such that
+// the batch is fetched again on the next attempt.
+func (p processor) processNextHeaders() error {
+	lastHeader := p.fetcher.LastHeader()
+
+	var headers []*types.Header
+	var err error
+	if p.indexUnfinalized {
+		headers, err = p.fetcher.NextUnsafeHeaders()
+	} else {
+		headers, err = p.fetcher.NextFinalizedHeaders()
+	}
+
+	if err != nil {
+		if p.indexUnfinalized && errors.Is(err, node.ErrFetcherAndProviderMismatchedState) {
+			p.processLog.Warn("detected reorg", "last_header", lastHeader.Hash(), "height", lastHeader.Number)
+			return p.rollback()
 		}
 
-		batchLog := p.processLog.New("startHeight", headers[0].Number, "endHeight", headers[len(headers)-1].Number)
+		return err
+	}
+
+	var finalizedHeight *big.Int
+	if p.indexUnfinalized {
+		finalizedHeight, err = p.ethClient.FinalizedBlockHeight()
+		if err != nil {
+			return err
+		}
+	} else if len(headers) > 0 {
+		finalizedHeight = headers[len(headers)-1].Number
+	}
+
+	if len(headers) == 0 {
+		p.processLog.Info("no new headers. indexer must be at head...")
+	}
+
+	batchLog := p.processLog
+	if len(headers) > 0 {
+		batchLog = p.processLog.New("startHeight", headers[0].Number, "endHeight", headers[len(headers)-1].Number)
 		batchLog.Info("indexing batch of headers")
+	}
+
+	// wrap operations within a single transaction
+	err = p.db.Transaction(func(db *database.DB) error {
+		if len(headers) > 0 {
+			if err := p.processFn(db, headers); err != nil {
+				return err
+			}
+		}
 
-		// wrap operations within a single transaction
-		err = p.db.Transaction(func(db *database.DB) error {
-			return p.processFn(db, headers)
-		})
+		if finalizedHeight != nil {
+			return p.headerStore.markFinalized(db, finalizedHeight)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		batchLog.Info("unable to index batch", "err", err)
+		p.fetcher.Reset(lastHeader)
+		return err
+	} else if len(headers) > 0 {
+		batchLog.Info("done indexing batch")
+	}
+
+	return nil
+}
 
-		// TODO(DX-79) if processFn failed, the next poll should retry starting from this same batch of headers
+// rollback removes the indexed headers, starting from the latest, until the latest indexed
+// header is canonical. The fetcher is then rewound to continue from this header.
+func (p processor) rollback() error {
+	var canonicalHeader *types.Header
+	err := p.db.Transaction(func(db *database.DB) error {
+		var err error
+		canonicalHeader, err = p.rollbackHeaders(db)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	p.processLog.Info("rolled back to canonical header", "hash", canonicalHeader.Hash(), "height", canonicalHeader.Number)
+	p.fetcher.Reset(canonicalHeader)
+	return nil
+}
+
+// rollbackHeaders removes the non-canonical indexed headers from the supplied database and
+// returns the canonical header to continue from. Since the finalized chain cannot reorg, the
+// rollback stops at the finalized height.
+func (p processor) rollbackHeaders(db *database.DB) (*types.Header, error) {
+	finalizedHeight, err := p.ethClient.FinalizedBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	lastHeader := p.fetcher.LastHeader()
+	if lastHeader != nil && lastHeader.Number.Cmp(finalizedHeight) < 0 {
+		finalizedHeight = lastHeader.Number
+	}
+
+	for {
+		latestHeader, err := p.headerStore.latestHeader(db)
+		if err != nil {
+			return nil, err
+		}
+
+		if latestHeader == nil || latestHeader.Number.Int.Cmp(finalizedHeight) <= 0 {
+			// all unfinalized state has been removed
+			canonicalHeader, err := p.ethClient.BlockHeaderByNumber(finalizedHeight)
+			if err != nil {
+				return nil, err
+			} else if canonicalHeader == nil {
+				return nil, errors.New("unable to fetch header at the finalized height")
+			}
+
+			return canonicalHeader, nil
+		}
+
+		header, err := p.ethClient.BlockHeaderByNumber(latestHeader.Number.Int)
+		if err != nil {
+			return nil, err
+		} else if header != nil && header.Hash() == latestHeader.Hash {
+			return header, nil
+		}
 
+		p.processLog.Info("rolling back header", "hash", latestHeader.Hash, "height", latestHeader.Number.Int)
+		err = p.headerStore.rollback(db, new(big.Int).Sub(latestHeader.Number.Int, bigOne))
 		if err != nil {
-			batchLog.Info("unable to index batch", "err", err)
-			panic(err)
-		} else {
-			batchLog.Info("done indexing batch")
+			return nil, err
 		}
 	}
 }
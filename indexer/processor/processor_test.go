@@ -0,0 +1,144 @@
+package processor
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/indexer/database"
+	"github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// chainClient serves the headers of a canonical chain
+type chainClient struct {
+	headers         []*types.Header
+	finalizedHeight *big.Int
+}
+
+func (c *chainClient) FinalizedBlockHeight() (*big.Int, error) {
+	return c.finalizedHeight, nil
+}
+
+func (c *chainClient) LatestBlockHeight() (*big.Int, error) {
+	return big.NewInt(int64(len(c.headers) - 1)), nil
+}
+
+func (c *chainClient) BlockHeadersByRange(start, end *big.Int) ([]*types.Header, error) {
+	panic("not implemented")
+}
+
+func (c *chainClient) BlockHeaderByNumber(number *big.Int) (*types.Header, error) {
+	if number.Uint64() >= uint64(len(c.headers)) {
+		return nil, nil
+	}
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *chainClient) BlockHeaderByHash(hash common.Hash) (*types.Header, error) {
+	panic("not implemented")
+}
+
+func (c *chainClient) RawRpcClient() *rpc.Client {
+	panic("not implemented")
+}
+
+// makeChain makes a chain of the supplied length. The extra data distinguishes the headers of forks
+func makeChain(length int, extra byte, parent *types.Header) []*types.Header {
+	var headers []*types.Header
+	for i := 0; i < length; i++ {
+		header := &types.Header{Number: big.NewInt(0), Extra: []byte{extra}}
+		if parent != nil {
+			header.Number = new(big.Int).Add(parent.Number, bigOne)
+			header.ParentHash = parent.Hash()
+		}
+		headers = append(headers, header)
+		parent = header
+	}
+	return headers
+}
+
+// memHeaderStore keeps the indexed headers in memory
+type memHeaderStore struct {
+	headers []*types.Header
+}
+
+func (s *memHeaderStore) headerStore() headerStore {
+	return headerStore{
+		latestHeader: func(*database.DB) (*database.BlockHeader, error) {
+			if len(s.headers) == 0 {
+				return nil, nil
+			}
+			header := s.headers[len(s.headers)-1]
+			return &database.BlockHeader{Hash: header.Hash(), ParentHash: header.ParentHash, Number: database.U256{Int: header.Number}}, nil
+		},
+		markFinalized: func(*database.DB, *big.Int) error {
+			return nil
+		},
+		rollback: func(_ *database.DB, height *big.Int) error {
+			s.headers = s.headers[:height.Uint64()+1]
+			return nil
+		},
+	}
+}
+
+func TestProcessorRollbackHeaders(t *testing.T) {
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+
+	// blocks [0..5] are indexed, the canonical chain forked off after block 3
+	indexed := makeChain(6, 0, nil)
+	canonical := append(append([]*types.Header{}, indexed[:4]...), makeChain(3, 1, indexed[3])...)
+
+	newProcessor := func(finalizedHeight int64) (processor, *memHeaderStore) {
+		client := &chainClient{headers: canonical, finalizedHeight: big.NewInt(finalizedHeight)}
+		store := &memHeaderStore{headers: append([]*types.Header{}, indexed...)}
+		return processor{
+			fetcher:          node.NewFetcher(client, indexed[5]),
+			ethClient:        client,
+			indexUnfinalized: true,
+			headerStore:      store.headerStore(),
+			processLog:       logger,
+		}, store
+	}
+
+	t.Run("RollsBackToCanonicalHeader", func(t *testing.T) {
+		p, store := newProcessor(1)
+		header, err := p.rollbackHeaders(nil)
+		require.NoError(t, err)
+		require.Equal(t, indexed[3].Hash(), header.Hash())
+		require.Equal(t, indexed[:4], store.headers)
+	})
+
+	t.Run("StopsAtFinalizedHeight", func(t *testing.T) {
+		// headers at or below the finalized height are never rolled back, even if the provider disagrees
+		p, store := newProcessor(4)
+		header, err := p.rollbackHeaders(nil)
+		require.NoError(t, err)
+		require.Equal(t, canonical[4].Hash(), header.Hash())
+		require.Equal(t, indexed[:5], store.headers)
+	})
+
+	t.Run("StopsAtFetchedHeader", func(t *testing.T) {
+		// the fetcher is behind the finalized height
+		p, store := newProcessor(5)
+		p.fetcher.Reset(indexed[2])
+		store.headers = indexed[:3]
+		header, err := p.rollbackHeaders(nil)
+		require.NoError(t, err)
+		require.Equal(t, indexed[2].Hash(), header.Hash())
+		require.Equal(t, indexed[:3], store.headers)
+	})
+
+	t.Run("NothingIndexed", func(t *testing.T) {
+		p, store := newProcessor(2)
+		store.headers = nil
+		header, err := p.rollbackHeaders(nil)
+		require.NoError(t, err)
+		require.Equal(t, canonical[2].Hash(), header.Hash())
+	})
+}
@@ -87,6 +87,12 @@ type CLIConfig struct {
 
 	Stopped bool
 
+	// Network is the name of the network the batcher is running on, if any.
+	Network string
+
+	// NetworkRegistry is the directory of additional chain definitions to select the network from.
+	NetworkRegistry string
+
 	TxMgrConfig      txmgr.CLIConfig
 	RPCConfig        rpc.CLIConfig
 	LogConfig        oplog.CLIConfig
@@ -129,6 +135,8 @@ func NewConfig(ctx *cli.Context) CLIConfig {
 		MaxChannelDuration:     ctx.Uint64(flags.MaxChannelDurationFlag.Name),
 		MaxL1TxSize:            ctx.Uint64(flags.MaxL1TxSizeBytesFlag.Name),
 		Stopped:                ctx.Bool(flags.StoppedFlag.Name),
+		Network:                ctx.String(flags.NetworkFlag.Name),
+		NetworkRegistry:        ctx.String(flags.NetworkRegistryFlag.Name),
 		TxMgrConfig:            txmgr.ReadCLIConfig(ctx),
 		RPCConfig:              rpc.ReadCLIConfig(ctx),
 		LogConfig:              oplog.ReadCLIConfig(ctx),
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	opclient "github.com/ethereum-optimism/optimism/op-service/client"
@@ -70,6 +71,19 @@ func NewBatchSubmitterFromCLIConfig(cfg CLIConfig, l log.Logger, m metrics.Metri
 		return nil, fmt.Errorf("querying rollup config: %w", err)
 	}
 
+	if cfg.Network != "" {
+		chain, err := chaincfg.LoadChain(cfg.NetworkRegistry, cfg.Network)
+		if err != nil {
+			return nil, err
+		}
+		if err := chain.CheckL1(ctx, l1Client); err != nil {
+			return nil, fmt.Errorf("invalid L1 for network %s: %w", cfg.Network, err)
+		}
+		if err := chain.CheckRollupConfig(rcfg); err != nil {
+			return nil, fmt.Errorf("invalid rollup node for network %s: %w", cfg.Network, err)
+		}
+	}
+
 	txManager, err := txmgr.NewSimpleTxManager("batcher", l, m, cfg.TxMgrConfig)
 	if err != nil {
 		return nil, err
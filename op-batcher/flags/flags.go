@@ -2,12 +2,14 @@ package flags
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
 	"github.com/ethereum-optimism/optimism/op-batcher/rpc"
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
@@ -77,6 +79,16 @@ var (
 		Usage:   "Initialize the batcher in a stopped state. The batcher can be started using the admin_startBatcher RPC",
 		EnvVars: prefixEnvVars("STOPPED"),
 	}
+	NetworkFlag = &cli.StringFlag{
+		Name:    "network",
+		Usage:   fmt.Sprintf("Network the batcher is running on, predefined or a chain of the --network.registry directory. It is cross-checked against L1 and the rollup node at startup. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
+		EnvVars: prefixEnvVars("NETWORK"),
+	}
+	NetworkRegistryFlag = &cli.StringFlag{
+		Name:    "network.registry",
+		Usage:   "Directory with a sub-directory per chain, holding its chain.json definition, to select with --network in addition to the predefined networks",
+		EnvVars: prefixEnvVars("NETWORK_REGISTRY"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -94,6 +106,8 @@ var optionalFlags = []cli.Flag{
 	MaxChannelDurationFlag,
 	MaxL1TxSizeBytesFlag,
 	StoppedFlag,
+	NetworkFlag,
+	NetworkRegistryFlag,
 	SequencerHDPathFlag,
 }
 
@@ -50,6 +50,16 @@ func CanonicalizeASTIDs(in *solc.StorageLayout) *solc.StorageLayout {
 	}
 	sortedOldTypes.Sort()
 
+	// Struct members have AST IDs too, they are numbered after the storage variables
+	for _, oldType := range sortedOldTypes {
+		for _, member := range in.Types[oldType].Members {
+			if _, ok := astIDRemappings[member.AstId]; !ok {
+				astIDRemappings[member.AstId] = lastId
+				lastId++
+			}
+		}
+	}
+
 	seenTypes := make(map[string]bool)
 	for _, oldType := range sortedOldTypes {
 		if seenTypes[oldType] || oldType == "" {
@@ -102,6 +112,16 @@ func CanonicalizeASTIDs(in *solc.StorageLayout) *solc.StorageLayout {
 		if value.Base != "" {
 			layout.Base = replaceType(typeRemappings, value.Base)
 		}
+		for _, member := range value.Members {
+			layout.Members = append(layout.Members, solc.StorageLayoutEntry{
+				AstId:    astIDRemappings[member.AstId],
+				Contract: member.Contract,
+				Label:    member.Label,
+				Offset:   member.Offset,
+				Slot:     member.Slot,
+				Type:     replaceType(typeRemappings, member.Type),
+			})
+		}
 		outLayout.Types[newType] = layout
 
 	}
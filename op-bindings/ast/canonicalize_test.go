@@ -36,6 +36,10 @@ func TestCanonicalize(t *testing.T) {
 			"custom types",
 			"custom-types.json",
 		},
+		{
+			"struct members",
+			"structs.json",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
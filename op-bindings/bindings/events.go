@@ -0,0 +1,75 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrUnknownEvent is returned when a log is not emitted by any of the known events.
+var ErrUnknownEvent = errors.New("unknown event")
+
+// EventDecoder decodes logs of a single event of a contract into its typed binding.
+type EventDecoder struct {
+	Contract string
+	Event    string
+	Topic    common.Hash
+	Decode   func(log types.Log) (any, error)
+}
+
+// eventDecoders represents the set of event decoders by topic. It is populated in an init function.
+var eventDecoders = make(map[common.Hash][]EventDecoder)
+
+// registerEvent adds an event decoder to the set of event decoders, keeping the
+// decoders of a topic sorted by contract name.
+func registerEvent(decoder EventDecoder) {
+	decoders := append(eventDecoders[decoder.Topic], decoder)
+	sort.Slice(decoders, func(i, j int) bool {
+		return decoders[i].Contract < decoders[j].Contract
+	})
+	eventDecoders[decoder.Topic] = decoders
+}
+
+// GetEventDecoders returns the decoders of all events with the given topic, sorted by contract name.
+// Multiple contracts may declare an event with the same signature, e.g. Initialized(uint8).
+func GetEventDecoders(topic common.Hash) []EventDecoder {
+	return eventDecoders[topic]
+}
+
+// DecodeLog decodes a log into the typed event binding, e.g. *OptimismPortalTransactionDeposited.
+// If multiple contracts declare an event with the signature of the log, the first contract by name
+// of which the event matches the indexed arguments of the log is used.
+// Use DecodeContractLog to decode the log as the event of a specific contract.
+func DecodeLog(log types.Log) (any, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("%w: anonymous log", ErrUnknownEvent)
+	}
+	decoders := eventDecoders[log.Topics[0]]
+	if len(decoders) == 0 {
+		return nil, fmt.Errorf("%w: topic %s", ErrUnknownEvent, log.Topics[0])
+	}
+	var err error
+	for _, decoder := range decoders {
+		var event any
+		if event, err = decoder.Decode(log); err == nil {
+			return event, nil
+		}
+	}
+	return nil, err
+}
+
+// DecodeContractLog decodes a log into the typed binding of an event of the named contract.
+func DecodeContractLog(contract string, log types.Log) (any, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("%w: anonymous log", ErrUnknownEvent)
+	}
+	for _, decoder := range eventDecoders[log.Topics[0]] {
+		if decoder.Contract == contract {
+			return decoder.Decode(log)
+		}
+	}
+	return nil, fmt.Errorf("%w: topic %s of %s", ErrUnknownEvent, log.Topics[0], contract)
+}
@@ -0,0 +1,78 @@
+package bindings
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeLog(t *testing.T) {
+	withdrawalHash := common.Hash{0x01}
+	from := common.Address{0x02}
+	to := common.Address{0x03}
+	log := types.Log{
+		Address: common.Address{0xaa},
+		Topics: []common.Hash{
+			OptimismPortalWithdrawalProvenTopic,
+			withdrawalHash,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+	}
+
+	event, err := DecodeLog(log)
+	require.NoError(t, err)
+	proven, ok := event.(*OptimismPortalWithdrawalProven)
+	require.True(t, ok, "unexpected event type %T", event)
+	require.Equal(t, [32]byte(withdrawalHash), proven.WithdrawalHash)
+	require.Equal(t, from, proven.From)
+	require.Equal(t, to, proven.To)
+	require.Equal(t, log, proven.Raw)
+}
+
+func TestDecodeLogUnknown(t *testing.T) {
+	_, err := DecodeLog(types.Log{Topics: []common.Hash{{0x01}}})
+	require.True(t, errors.Is(err, ErrUnknownEvent))
+
+	_, err = DecodeLog(types.Log{})
+	require.True(t, errors.Is(err, ErrUnknownEvent))
+
+	_, err = DecodeContractLog("Unknown", types.Log{Topics: []common.Hash{OptimismPortalInitializedTopic}})
+	require.True(t, errors.Is(err, ErrUnknownEvent))
+}
+
+func TestDecodeContractLog(t *testing.T) {
+	// Initialized(uint8) is declared by all initializable contracts
+	require.Greater(t, len(GetEventDecoders(OptimismPortalInitializedTopic)), 1)
+	log := types.Log{
+		Topics: []common.Hash{OptimismPortalInitializedTopic},
+		Data:   common.LeftPadBytes([]byte{2}, 32),
+	}
+
+	event, err := DecodeContractLog("OptimismPortal", log)
+	require.NoError(t, err)
+	initialized, ok := event.(*OptimismPortalInitialized)
+	require.True(t, ok, "unexpected event type %T", event)
+	require.Equal(t, uint8(2), initialized.Version)
+
+	event, err = DecodeContractLog("SystemConfig", log)
+	require.NoError(t, err)
+	require.IsType(t, &SystemConfigInitialized{}, event)
+}
+
+func TestFilterQuery(t *testing.T) {
+	portal := common.Address{0xaa}
+	to := common.Address{0x03}
+	query, err := OptimismPortalWithdrawalProvenFilterQuery([]common.Address{portal}, nil, nil, []common.Address{to})
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{portal}, query.Addresses)
+	require.Equal(t, [][]common.Hash{
+		{OptimismPortalWithdrawalProvenTopic},
+		nil,
+		nil,
+		{common.BytesToHash(to.Bytes())},
+	}, query.Topics)
+}
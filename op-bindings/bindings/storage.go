@@ -0,0 +1,359 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// maxStorageBytesLength is the longest string or bytes value that is read,
+// to not read an unbounded number of slots from corrupted storage.
+const maxStorageBytesLength = 1 << 20
+
+// ErrStorageValueTooLarge is returned when a length read from storage is out of bounds.
+var ErrStorageValueTooLarge = errors.New("storage value too large")
+
+// StorageReader reads the storage slots of a single contract.
+type StorageReader interface {
+	StorageAt(ctx context.Context, key common.Hash) (common.Hash, error)
+}
+
+// StorageClient is the part of the ethclient API that is used to read storage over RPC.
+type StorageClient interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+type rpcStorageReader struct {
+	client      StorageClient
+	address     common.Address
+	blockNumber *big.Int
+}
+
+// NewRPCStorageReader reads the storage of the contract at the address with eth_getStorageAt,
+// at the given block number, or at the latest block if the block number is nil.
+func NewRPCStorageReader(client StorageClient, address common.Address, blockNumber *big.Int) StorageReader {
+	return &rpcStorageReader{client: client, address: address, blockNumber: blockNumber}
+}
+
+func (r *rpcStorageReader) StorageAt(ctx context.Context, key common.Hash) (common.Hash, error) {
+	val, err := r.client.StorageAt(ctx, r.address, key, r.blockNumber)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to read storage slot %s of %s: %w", key, r.address, err)
+	}
+	return common.BytesToHash(val), nil
+}
+
+type stateDBStorageReader struct {
+	db      vm.StateDB
+	address common.Address
+}
+
+// NewStateDBStorageReader reads the storage of the contract at the address from a state db.
+func NewStateDBStorageReader(db vm.StateDB, address common.Address) StorageReader {
+	return &stateDBStorageReader{db: db, address: address}
+}
+
+func (r *stateDBStorageReader) StorageAt(_ context.Context, key common.Hash) (common.Hash, error) {
+	return r.db.GetState(r.address, key), nil
+}
+
+// NewDatabaseStorageReader reads the storage of the contract at the address from the state
+// with the given root in a geth database, e.g. the chaindata of a stopped node.
+func NewDatabaseStorageReader(db ethdb.Database, root common.Hash, address common.Address) (StorageReader, error) {
+	statedb, err := state.New(root, state.NewDatabase(db), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state %s: %w", root, err)
+	}
+	return NewStateDBStorageReader(statedb, address), nil
+}
+
+// storageHandle creates the handle of a storage variable at a slot and offset.
+type storageHandle[T any] func(reader StorageReader, slot common.Hash, offset uint) T
+
+// StorageValue is a storage variable of a value type, which is stored in a single slot.
+type StorageValue[T any] struct {
+	reader StorageReader
+	slot   common.Hash
+	offset uint
+	size   uint
+	decode func(data []byte) T
+}
+
+// Slot returns the slot of the value.
+func (v StorageValue[T]) Slot() common.Hash {
+	return v.slot
+}
+
+// Offset returns the offset of the value in its slot, in bytes from the right.
+func (v StorageValue[T]) Offset() uint {
+	return v.offset
+}
+
+// Get reads the value.
+func (v StorageValue[T]) Get(ctx context.Context) (T, error) {
+	word, err := v.reader.StorageAt(ctx, v.slot)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	end := common.HashLength - v.offset
+	return v.decode(word[end-v.size : end]), nil
+}
+
+func valueHandle[T any](size uint, decode func(data []byte) T) storageHandle[StorageValue[T]] {
+	return func(reader StorageReader, slot common.Hash, offset uint) StorageValue[T] {
+		return StorageValue[T]{reader: reader, slot: slot, offset: offset, size: size, decode: decode}
+	}
+}
+
+// StorageBytes is a string or bytes storage variable.
+type StorageBytes struct {
+	reader StorageReader
+	slot   common.Hash
+}
+
+// Slot returns the slot of the value, which holds the data of short values
+// and the length of long values.
+func (b StorageBytes) Slot() common.Hash {
+	return b.slot
+}
+
+// Get reads the value.
+func (b StorageBytes) Get(ctx context.Context) ([]byte, error) {
+	word, err := b.reader.StorageAt(ctx, b.slot)
+	if err != nil {
+		return nil, err
+	}
+	// Short values are stored in the slot, with twice the length in the lowest byte
+	if word[31]&1 == 0 {
+		length := int(word[31] / 2)
+		if length > 31 {
+			return nil, fmt.Errorf("%w: short value of length %d in slot %s", ErrStorageValueTooLarge, length, b.slot)
+		}
+		return common.CopyBytes(word[:length]), nil
+	}
+	// Long values are stored from keccak256(slot), with twice the length plus one in the slot
+	length := new(big.Int).Rsh(word.Big(), 1)
+	if !length.IsUint64() || length.Uint64() > maxStorageBytesLength {
+		return nil, fmt.Errorf("%w: value of length %s in slot %s", ErrStorageValueTooLarge, length, b.slot)
+	}
+	n := length.Uint64()
+	data := make([]byte, 0, n+common.HashLength)
+	start := crypto.Keccak256Hash(b.slot[:])
+	for i := uint64(0); uint64(len(data)) < n; i++ {
+		chunk, err := b.reader.StorageAt(ctx, addSlot(start, i))
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:]...)
+	}
+	return data[:n], nil
+}
+
+// GetString reads the value as a string.
+func (b StorageBytes) GetString(ctx context.Context) (string, error) {
+	data, err := b.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func bytesHandle(reader StorageReader, slot common.Hash, _ uint) StorageBytes {
+	return StorageBytes{reader: reader, slot: slot}
+}
+
+// StorageMapping is a mapping storage variable, of which the values are found by key.
+type StorageMapping[K any, V any] struct {
+	reader StorageReader
+	slot   common.Hash
+	key    func(key K) []byte
+	value  storageHandle[V]
+}
+
+// Slot returns the slot of the mapping, which is used to compute the slots of its values.
+func (m StorageMapping[K, V]) Slot() common.Hash {
+	return m.slot
+}
+
+// Key returns the value of the mapping at the key.
+func (m StorageMapping[K, V]) Key(key K) V {
+	return m.value(m.reader, crypto.Keccak256Hash(m.key(key), m.slot[:]), 0)
+}
+
+func mappingHandle[K any, V any](key func(key K) []byte, value storageHandle[V]) storageHandle[StorageMapping[K, V]] {
+	return func(reader StorageReader, slot common.Hash, _ uint) StorageMapping[K, V] {
+		return StorageMapping[K, V]{reader: reader, slot: slot, key: key, value: value}
+	}
+}
+
+// StorageArray is a static or dynamic array storage variable.
+type StorageArray[V any] struct {
+	reader   StorageReader
+	slot     common.Hash
+	dynamic  bool
+	length   uint64
+	elemSize uint
+	elem     storageHandle[V]
+}
+
+// Slot returns the slot of the array. Dynamic arrays store their length in it.
+func (a StorageArray[V]) Slot() common.Hash {
+	return a.slot
+}
+
+// Length returns the length of the array. It is read from storage for dynamic arrays.
+func (a StorageArray[V]) Length(ctx context.Context) (uint64, error) {
+	if !a.dynamic {
+		return a.length, nil
+	}
+	word, err := a.reader.StorageAt(ctx, a.slot)
+	if err != nil {
+		return 0, err
+	}
+	length := word.Big()
+	if !length.IsUint64() {
+		return 0, fmt.Errorf("%w: array of length %s in slot %s", ErrStorageValueTooLarge, length, a.slot)
+	}
+	return length.Uint64(), nil
+}
+
+// Index returns the element of the array at the index. The index is not checked against the length.
+func (a StorageArray[V]) Index(i uint64) V {
+	start := a.slot
+	if a.dynamic {
+		start = crypto.Keccak256Hash(a.slot[:])
+	}
+	// Elements of at most 16 bytes are packed into a slot,
+	// larger elements start at a new slot.
+	if a.elemSize <= common.HashLength/2 {
+		perSlot := uint64(common.HashLength / a.elemSize)
+		return a.elem(a.reader, addSlot(start, i/perSlot), uint(i%perSlot)*a.elemSize)
+	}
+	slotsPerElem := (uint64(a.elemSize) + common.HashLength - 1) / common.HashLength
+	return a.elem(a.reader, addSlot(start, i*slotsPerElem), 0)
+}
+
+func arrayHandle[V any](elemSize uint, elem storageHandle[V]) storageHandle[StorageArray[V]] {
+	return func(reader StorageReader, slot common.Hash, _ uint) StorageArray[V] {
+		return StorageArray[V]{reader: reader, slot: slot, dynamic: true, elemSize: elemSize, elem: elem}
+	}
+}
+
+func staticArrayHandle[V any](length uint64, elemSize uint, elem storageHandle[V]) storageHandle[StorageArray[V]] {
+	return func(reader StorageReader, slot common.Hash, _ uint) StorageArray[V] {
+		return StorageArray[V]{reader: reader, slot: slot, length: length, elemSize: elemSize, elem: elem}
+	}
+}
+
+// slotKey returns the key of the n-th storage slot.
+func slotKey(n uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(n))
+}
+
+// addSlot returns the key of the slot n slots after the given slot.
+func addSlot(slot common.Hash, n uint64) common.Hash {
+	if n == 0 {
+		return slot
+	}
+	return common.BigToHash(new(big.Int).Add(slot.Big(), new(big.Int).SetUint64(n)))
+}
+
+func decodeBool(data []byte) bool {
+	return data[0] != 0
+}
+
+func decodeAddress(data []byte) common.Address {
+	return common.BytesToAddress(data)
+}
+
+func decodeRawBytes(data []byte) []byte {
+	return common.CopyBytes(data)
+}
+
+func decodeBigUint(data []byte) *big.Int {
+	return new(big.Int).SetBytes(data)
+}
+
+// decodeBigInt decodes a two's complement signed integer
+func decodeBigInt(data []byte) *big.Int {
+	v := new(big.Int).SetBytes(data)
+	if data[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(common.Big1, uint(len(data))*8))
+	}
+	return v
+}
+
+func decodeUint8(data []byte) uint8 {
+	return uint8(decodeBigUint(data).Uint64())
+}
+
+func decodeUint16(data []byte) uint16 {
+	return uint16(decodeBigUint(data).Uint64())
+}
+
+func decodeUint32(data []byte) uint32 {
+	return uint32(decodeBigUint(data).Uint64())
+}
+
+func decodeUint64(data []byte) uint64 {
+	return decodeBigUint(data).Uint64()
+}
+
+func decodeInt8(data []byte) int8 {
+	return int8(decodeBigInt(data).Int64())
+}
+
+func decodeInt16(data []byte) int16 {
+	return int16(decodeBigInt(data).Int64())
+}
+
+func decodeInt32(data []byte) int32 {
+	return int32(decodeBigInt(data).Int64())
+}
+
+func decodeInt64(data []byte) int64 {
+	return decodeBigInt(data).Int64()
+}
+
+func encodeAddressKey(key common.Address) []byte {
+	return common.LeftPadBytes(key[:], common.HashLength)
+}
+
+func encodeBoolKey(key bool) []byte {
+	if key {
+		return common.LeftPadBytes([]byte{1}, common.HashLength)
+	}
+	return make([]byte, common.HashLength)
+}
+
+func encodeUintKey[T uint8 | uint16 | uint32 | uint64](key T) []byte {
+	return common.LeftPadBytes(new(big.Int).SetUint64(uint64(key)).Bytes(), common.HashLength)
+}
+
+func encodeIntKey[T int8 | int16 | int32 | int64](key T) []byte {
+	return encodeBigKey(big.NewInt(int64(key)))
+}
+
+// encodeBigKey encodes an integer key, with negative keys in two's complement
+func encodeBigKey(key *big.Int) []byte {
+	if key.Sign() < 0 {
+		key = new(big.Int).Add(key, new(big.Int).Lsh(common.Big1, 256))
+	}
+	return common.LeftPadBytes(key.Bytes(), common.HashLength)
+}
+
+func encodeStringKey(key string) []byte {
+	return []byte(key)
+}
+
+func encodeBytesKey(key []byte) []byte {
+	return key
+}
@@ -0,0 +1,149 @@
+package bindings
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type mapStorageReader map[common.Hash]common.Hash
+
+func (r mapStorageReader) StorageAt(_ context.Context, key common.Hash) (common.Hash, error) {
+	return r[key], nil
+}
+
+func TestL2OutputOracleStorage(t *testing.T) {
+	ctx := context.Background()
+	outputRoot := common.Hash{0x01}
+	l2Outputs := crypto.Keccak256Hash(slotKey(3).Bytes())
+	reader := mapStorageReader{
+		// _initialized and _initializing are packed into slot 0
+		slotKey(0): common.HexToHash("0x0101"),
+		slotKey(1): slotKey(100),
+		slotKey(3): slotKey(2),
+		// each output proposal takes two slots
+		addSlot(l2Outputs, 2): outputRoot,
+		// the timestamp and the l2BlockNumber are packed into the second slot
+		addSlot(l2Outputs, 3): common.HexToHash("0x0000000000000000000000000000012c000000000000000000000000000000c8"),
+	}
+	storage := NewL2OutputOracleStorage(reader)
+
+	initialized, err := storage.Initialized().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), initialized)
+	initializing, err := storage.Initializing().Get(ctx)
+	require.NoError(t, err)
+	require.True(t, initializing)
+	startingBlockNumber, err := storage.StartingBlockNumber().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), startingBlockNumber)
+
+	length, err := storage.L2Outputs().Length(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), length)
+	output := storage.L2Outputs().Index(1)
+	require.Equal(t, addSlot(l2Outputs, 2), output.Slot())
+	root, err := output.OutputRoot().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(outputRoot), root)
+	timestamp, err := output.Timestamp().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), timestamp)
+	l2BlockNumber, err := output.L2BlockNumber().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(300), l2BlockNumber)
+}
+
+func TestStorageDatabaseReader(t *testing.T) {
+	ctx := context.Background()
+	passer := common.HexToAddress("0x4200000000000000000000000000000000000016")
+	withdrawalHash := common.Hash{0xaa}
+
+	db := rawdb.NewMemoryDatabase()
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(db), nil)
+	require.NoError(t, err)
+	statedb.SetState(passer, crypto.Keccak256Hash(withdrawalHash.Bytes(), slotKey(0).Bytes()), slotKey(1))
+	statedb.SetState(passer, slotKey(1), slotKey(5))
+	root, err := statedb.Commit(false)
+	require.NoError(t, err)
+	require.NoError(t, statedb.Database().TrieDB().Commit(root, false))
+
+	reader, err := NewDatabaseStorageReader(db, root, passer)
+	require.NoError(t, err)
+	storage := NewL2ToL1MessagePasserStorage(reader)
+	sent, err := storage.SentMessages().Key(withdrawalHash).Get(ctx)
+	require.NoError(t, err)
+	require.True(t, sent)
+	sent, err = storage.SentMessages().Key(common.Hash{0xbb}).Get(ctx)
+	require.NoError(t, err)
+	require.False(t, sent)
+	nonce, err := storage.MsgNonce().Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), nonce)
+}
+
+func TestStorageBytes(t *testing.T) {
+	ctx := context.Background()
+	long := strings.Repeat("optimism", 5)
+	start := crypto.Keccak256Hash(slotKey(1).Bytes())
+	reader := mapStorageReader{
+		// short values store twice their length in the lowest byte
+		slotKey(0): {0: 'o', 1: 'p', 31: 4},
+		// long values store twice their length plus one
+		slotKey(1):         common.BigToHash(big.NewInt(int64(2*len(long) + 1))),
+		start:              common.BytesToHash([]byte(long[:32])),
+		addSlot(start, 1):  common.BytesToHash(common.RightPadBytes([]byte(long[32:]), 32)),
+		slotKey(2):         common.BigToHash(big.NewInt(2*maxStorageBytesLength + 3)),
+		slotKey(3):         {31: 64},
+		addSlot(start, 10): {0x01},
+	}
+
+	short, err := bytesHandle(reader, slotKey(0), 0).GetString(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "op", short)
+	value, err := bytesHandle(reader, slotKey(1), 0).GetString(ctx)
+	require.NoError(t, err)
+	require.Equal(t, long, value)
+	_, err = bytesHandle(reader, slotKey(2), 0).Get(ctx)
+	require.ErrorIs(t, err, ErrStorageValueTooLarge)
+	_, err = bytesHandle(reader, slotKey(3), 0).Get(ctx)
+	require.ErrorIs(t, err, ErrStorageValueTooLarge)
+}
+
+func TestStorageArrayPacking(t *testing.T) {
+	ctx := context.Background()
+	reader := mapStorageReader{
+		slotKey(0): common.HexToHash("0xff02"),
+		slotKey(1): common.HexToHash("0x03"),
+	}
+	// 32 int8 values fit in a slot
+	array := staticArrayHandle(33, 1, valueHandle(1, decodeInt8))(reader, slotKey(0), 0)
+	first, err := array.Index(0).Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int8(2), first)
+	second, err := array.Index(1).Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int8(-1), second)
+	last, err := array.Index(32).Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int8(3), last)
+	length, err := array.Length(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(33), length)
+}
+
+func TestEncodeMappingKeys(t *testing.T) {
+	minusOne := common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	require.Equal(t, minusOne.Bytes(), encodeIntKey[int8](-1))
+	require.Equal(t, minusOne.Bytes(), encodeBigKey(big.NewInt(-1)))
+	require.Equal(t, slotKey(7).Bytes(), encodeUintKey[uint16](7))
+	require.Equal(t, slotKey(1).Bytes(), encodeBoolKey(true))
+	require.Equal(t, common.Address{0x01}.Hash().Bytes(), encodeAddressKey(common.Address{0x01}))
+}
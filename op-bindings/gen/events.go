@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+type eventsData struct {
+	Name    string
+	Package string
+	Events  []eventData
+	BigInt  bool
+}
+
+type eventData struct {
+	// Name is the normalized name of the event, as used by abigen
+	Name    string
+	Topic   string
+	Indexed []indexedArg
+}
+
+type indexedArg struct {
+	Name string
+	Type string
+}
+
+var eventsT = template.Must(template.New("events").Parse(eventsTmpl))
+
+// writeEvents writes the event registry and filter query builders of a contract.
+// Nothing is written for contracts without events.
+func writeEvents(name string, rawAbi []byte, outDir string, pkg string) error {
+	parsed, err := abi.JSON(bytes.NewReader(rawAbi))
+	if err != nil {
+		return fmt.Errorf("error parsing abi: %w", err)
+	}
+
+	d := eventsData{Name: name, Package: pkg}
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			continue
+		}
+		e := eventData{
+			Name:  abi.ToCamelCase(event.Name),
+			Topic: event.ID.Hex(),
+		}
+		for i, arg := range event.Inputs {
+			if !arg.Indexed {
+				continue
+			}
+			typ := topicType(arg.Type)
+			if typ == "*big.Int" {
+				d.BigInt = true
+			}
+			e.Indexed = append(e.Indexed, indexedArg{Name: argName(arg.Name, i), Type: typ})
+		}
+		d.Events = append(d.Events, e)
+	}
+	if len(d.Events) == 0 {
+		return nil
+	}
+	sort.Slice(d.Events, func(i, j int) bool {
+		return d.Events[i].Name < d.Events[j].Name
+	})
+
+	var buf bytes.Buffer
+	if err := eventsT.Execute(&buf, d); err != nil {
+		return fmt.Errorf("error writing events template: %w", err)
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting events: %w", err)
+	}
+	fname := filepath.Join(outDir, strings.ToLower(name)+"_events.go")
+	if err := os.WriteFile(fname, code, 0o600); err != nil {
+		return fmt.Errorf("error writing %s: %w", fname, err)
+	}
+	return nil
+}
+
+// topicType returns the Go type of an indexed event argument, matching the filter methods of abigen.
+// Dynamic and composite types are hashed into the topic, so they are filtered by their hash.
+func topicType(t abi.Type) string {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return "common.Hash"
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size)
+	}
+	return t.GetType().String()
+}
+
+// argName returns the name of a filter query argument, which must not clash with Go keywords
+// or the addresses argument.
+func argName(name string, i int) string {
+	if name == "" {
+		return fmt.Sprintf("arg%d", i)
+	}
+	camel := []rune(abi.ToCamelCase(name))
+	camel[0] = unicode.ToLower(camel[0])
+	s := string(camel)
+	if token.IsKeyword(s) || s == "addresses" {
+		s += "_"
+	}
+	return s
+}
+
+var eventsTmpl = `// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+{{- if .BigInt}}
+	"math/big"
+{{end}}
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+{{- range .Events}}
+	// {{$.Name}}{{.Name}}Topic is the topic of the {{.Name}} event of {{$.Name}}.
+	{{$.Name}}{{.Name}}Topic = common.HexToHash("{{.Topic}}")
+{{- end}}
+)
+{{range .Events}}
+// {{$.Name}}{{.Name}}FilterQuery returns a filter query for the {{.Name}} event of {{$.Name}},
+// emitted by any of the given addresses. Empty arguments match any value.
+func {{$.Name}}{{.Name}}FilterQuery(addresses []common.Address{{range .Indexed}}, {{.Name}} []{{.Type}}{{end}}) (ethereum.FilterQuery, error) {
+{{- range .Indexed}}
+	var {{.Name}}Rule []interface{}
+	for _, {{.Name}}Item := range {{.Name}} {
+		{{.Name}}Rule = append({{.Name}}Rule, {{.Name}}Item)
+	}
+{{- end}}
+	topics, err := abi.MakeTopics([]interface{}{ {{- $.Name}}{{.Name}}Topic}{{range .Indexed}}, {{.Name}}Rule{{end}})
+	if err != nil {
+		return ethereum.FilterQuery{}, err
+	}
+	return ethereum.FilterQuery{Addresses: addresses, Topics: topics}, nil
+}
+{{end}}
+func init() {
+	filterer, err := New{{.Name}}Filterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+{{range .Events}}
+	registerEvent(EventDecoder{
+		Contract: "{{$.Name}}",
+		Event:    "{{.Name}}",
+		Topic:    {{$.Name}}{{.Name}}Topic,
+		Decode: func(log types.Log) (any, error) {
+			event, err := filterer.Parse{{.Name}}(log)
+			if err != nil {
+				return nil, err
+			}
+			return event, nil
+		},
+	})
+{{- end}}
+}
+`
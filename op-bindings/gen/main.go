@@ -156,6 +156,13 @@ func main() {
 		}
 		outfile.Close()
 		log.Printf("wrote file %s\n", outfile.Name())
+
+		if err := writeEvents(name, rawAbi, f.OutDir, f.Package); err != nil {
+			log.Fatalf("error writing events of %s: %v\n", name, err)
+		}
+		if err := writeStorage(name, canonicalStorage, f.OutDir, f.Package); err != nil {
+			log.Fatalf("error writing storage of %s: %v\n", name, err)
+		}
 	}
 }
 
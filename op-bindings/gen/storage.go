@@ -0,0 +1,343 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+type storageData struct {
+	Name    string
+	Package string
+	Vars    []storageVar
+	Structs []*storageStruct
+	BigInt  bool
+	Common  bool
+}
+
+type storageVar struct {
+	Method    string
+	Label     string
+	TypeLabel string
+	Slot      uint
+	Offset    uint
+	GoType    string
+	Handle    string
+}
+
+type storageStruct struct {
+	GoType  string
+	Label   string
+	Members []storageVar
+}
+
+var storageT = template.Must(template.New("storage").Parse(storageTmpl))
+
+// writeStorage writes the typed storage accessors of a contract.
+// Nothing is written for contracts without storage variables.
+func writeStorage(name string, layout *solc.StorageLayout, outDir string, pkg string) error {
+	if len(layout.Storage) == 0 {
+		return nil
+	}
+	r := &storageResolver{
+		name:    name,
+		types:   layout.Types,
+		structs: make(map[string]*storageStruct),
+	}
+	vars, err := r.vars(layout.Storage)
+	if err != nil {
+		return fmt.Errorf("error resolving storage of %s: %w", name, err)
+	}
+	d := storageData{
+		Name:    name,
+		Package: pkg,
+		Vars:    vars,
+		Structs: r.order,
+		BigInt:  r.bigInt,
+		Common:  r.common || len(r.order) > 0,
+	}
+
+	var buf bytes.Buffer
+	if err := storageT.Execute(&buf, d); err != nil {
+		return fmt.Errorf("error writing storage template: %w", err)
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting storage: %w", err)
+	}
+	fname := filepath.Join(outDir, strings.ToLower(name)+"_storage.go")
+	if err := os.WriteFile(fname, code, 0o600); err != nil {
+		return fmt.Errorf("error writing %s: %w", fname, err)
+	}
+	return nil
+}
+
+// storageResolver resolves the Go types and handles of the storage types of a contract.
+type storageResolver struct {
+	name    string
+	types   map[string]solc.StorageLayoutType
+	structs map[string]*storageStruct
+	order   []*storageStruct
+	bigInt  bool
+	common  bool
+}
+
+// vars resolves storage variables, naming variables with a duplicate or reserved label after their slot.
+func (r *storageResolver) vars(entries []solc.StorageLayoutEntry, reserved ...string) ([]storageVar, error) {
+	var vars []storageVar
+	methods := make(map[string]bool)
+	for _, method := range reserved {
+		methods[method] = true
+	}
+	for _, entry := range entries {
+		goType, handle, err := r.resolve(entry.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Label, err)
+		}
+		method := abi.ToCamelCase(entry.Label)
+		if methods[method] {
+			method = fmt.Sprintf("%sSlot%d", method, entry.Slot)
+		}
+		methods[method] = true
+		vars = append(vars, storageVar{
+			Method:    method,
+			Label:     entry.Label,
+			TypeLabel: r.types[entry.Type].Label,
+			Slot:      entry.Slot,
+			Offset:    entry.Offset,
+			GoType:    goType,
+			Handle:    handle,
+		})
+	}
+	return vars, nil
+}
+
+// resolve returns the Go type of the handle of a storage type, and the expression of its storageHandle.
+func (r *storageResolver) resolve(typeName string) (string, string, error) {
+	t, ok := r.types[typeName]
+	if !ok {
+		return "", "", fmt.Errorf("unknown storage type %s", typeName)
+	}
+	switch t.Encoding {
+	case "bytes":
+		return "StorageBytes", "bytesHandle", nil
+	case "mapping":
+		keyType, keyEncoder, err := r.resolveKey(t.Key)
+		if err != nil {
+			return "", "", err
+		}
+		valueType, valueHandle, err := r.resolve(t.Value)
+		if err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf("StorageMapping[%s, %s]", keyType, valueType),
+			fmt.Sprintf("mappingHandle(%s, %s)", keyEncoder, valueHandle), nil
+	case "dynamic_array":
+		elemType, elemHandle, err := r.resolve(t.Base)
+		if err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf("StorageArray[%s]", elemType),
+			fmt.Sprintf("arrayHandle(%d, %s)", r.types[t.Base].NumberOfBytes, elemHandle), nil
+	case "inplace":
+	default:
+		return "", "", fmt.Errorf("unsupported encoding %s of %s", t.Encoding, typeName)
+	}
+
+	switch {
+	case strings.HasPrefix(typeName, "t_array("):
+		elemType, elemHandle, err := r.resolve(t.Base)
+		if err != nil {
+			return "", "", err
+		}
+		elemSize := r.types[t.Base].NumberOfBytes
+		length, err := staticArrayLength(typeName, t.Base)
+		if err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf("StorageArray[%s]", elemType),
+			fmt.Sprintf("staticArrayHandle(%d, %d, %s)", length, elemSize, elemHandle), nil
+	case strings.HasPrefix(typeName, "t_struct("):
+		if len(t.Members) == 0 {
+			return "", "", fmt.Errorf("no members of %s in the storage layout", t.Label)
+		}
+		s, err := r.resolveStruct(typeName, t)
+		if err != nil {
+			return "", "", err
+		}
+		return "*" + s.GoType, "new" + s.GoType, nil
+	}
+
+	goType, decode, err := r.resolveValue(typeName, t)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("StorageValue[%s]", goType), fmt.Sprintf("valueHandle(%d, %s)", t.NumberOfBytes, decode), nil
+}
+
+// resolveValue returns the Go type of a value type, and the function that decodes it.
+func (r *storageResolver) resolveValue(typeName string, t solc.StorageLayoutType) (string, string, error) {
+	switch {
+	case typeName == "t_bool":
+		return "bool", "decodeBool", nil
+	case typeName == "t_address", strings.HasPrefix(typeName, "t_address_payable"), strings.HasPrefix(typeName, "t_contract("):
+		r.common = true
+		return "common.Address", "decodeAddress", nil
+	case strings.HasPrefix(typeName, "t_enum("):
+		return "uint8", "decodeUint8", nil
+	case strings.HasPrefix(typeName, "t_userDefinedValueType("):
+		return "[]byte", "decodeRawBytes", nil
+	case strings.HasPrefix(typeName, "t_uint"), strings.HasPrefix(typeName, "t_int"):
+		signed := strings.HasPrefix(typeName, "t_int")
+		bits := t.NumberOfBytes * 8
+		goType, decode := "uint", "decodeUint"
+		if signed {
+			goType, decode = "int", "decodeInt"
+		}
+		switch bits {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%s%d", goType, bits), fmt.Sprintf("%s%d", decode, bits), nil
+		}
+		r.bigInt = true
+		if signed {
+			return "*big.Int", "decodeBigInt", nil
+		}
+		return "*big.Int", "decodeBigUint", nil
+	case strings.HasPrefix(typeName, "t_bytes"):
+		goType := fmt.Sprintf("[%d]byte", t.NumberOfBytes)
+		return goType, fmt.Sprintf("func(data []byte) (v %s) { copy(v[:], data); return }", goType), nil
+	}
+	return "", "", fmt.Errorf("unsupported storage type %s", typeName)
+}
+
+// resolveKey returns the Go type of a mapping key, and the function that encodes it.
+func (r *storageResolver) resolveKey(typeName string) (string, string, error) {
+	t, ok := r.types[typeName]
+	if !ok {
+		return "", "", fmt.Errorf("unknown mapping key type %s", typeName)
+	}
+	switch {
+	case strings.HasPrefix(typeName, "t_string"):
+		return "string", "encodeStringKey", nil
+	case t.Encoding == "bytes", strings.HasPrefix(typeName, "t_bytes_"):
+		return "[]byte", "encodeBytesKey", nil
+	}
+	goType, _, err := r.resolveValue(typeName, t)
+	if err != nil {
+		return "", "", err
+	}
+	switch {
+	case goType == "bool":
+		return goType, "encodeBoolKey", nil
+	case goType == "common.Address":
+		return goType, "encodeAddressKey", nil
+	case goType == "*big.Int":
+		return goType, "encodeBigKey", nil
+	case strings.HasPrefix(goType, "uint"):
+		return goType, fmt.Sprintf("encodeUintKey[%s]", goType), nil
+	case strings.HasPrefix(goType, "int"):
+		return goType, fmt.Sprintf("encodeIntKey[%s]", goType), nil
+	case strings.HasPrefix(goType, "["):
+		r.common = true
+		return goType, fmt.Sprintf("func(key %s) []byte { return common.RightPadBytes(key[:], common.HashLength) }", goType), nil
+	}
+	return "", "", fmt.Errorf("unsupported mapping key type %s", typeName)
+}
+
+// resolveStruct resolves the accessors of a struct with known members.
+// Structs are registered before resolving their members, as a struct may be nested in its own members.
+func (r *storageResolver) resolveStruct(typeName string, t solc.StorageLayoutType) (*storageStruct, error) {
+	if s, ok := r.structs[typeName]; ok {
+		return s, nil
+	}
+	structName := strings.TrimPrefix(t.Label, "struct ")
+	structName = strings.ReplaceAll(structName, ".", "")
+	s := &storageStruct{
+		GoType: r.name + abi.ToCamelCase(structName) + "Storage",
+		Label:  t.Label,
+	}
+	r.structs[typeName] = s
+	r.order = append(r.order, s)
+	members, err := r.vars(t.Members, "Slot")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.Label, err)
+	}
+	s.Members = members
+	return s, nil
+}
+
+// staticArrayLength parses the length of a static array type, e.g. t_array(t_uint256)50_storage.
+func staticArrayLength(typeName string, base string) (uint64, error) {
+	suffix := strings.TrimPrefix(typeName, "t_array("+base+")")
+	suffix = strings.TrimSuffix(suffix, "_storage")
+	length, err := strconv.ParseUint(suffix, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid static array type %s: %w", typeName, err)
+	}
+	return length, nil
+}
+
+var storageTmpl = `// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+{{if or .BigInt .Common -}}
+import (
+{{- if .BigInt}}
+	"math/big"
+{{end}}
+{{- if .Common}}
+	"github.com/ethereum/go-ethereum/common"
+{{- end}}
+)
+{{- end}}
+
+// {{.Name}}Storage reads the storage variables of a {{.Name}} contract, without calling the contract.
+type {{.Name}}Storage struct {
+	reader StorageReader
+}
+
+// New{{.Name}}Storage creates a reader of the storage variables of a {{.Name}} contract.
+func New{{.Name}}Storage(reader StorageReader) *{{.Name}}Storage {
+	return &{{.Name}}Storage{reader: reader}
+}
+{{range .Vars}}
+// {{.Method}} returns the {{.Label}} storage variable of type {{.TypeLabel}}, at slot {{.Slot}}{{if .Offset}} and offset {{.Offset}}{{end}}.
+func (s *{{$.Name}}Storage) {{.Method}}() {{.GoType}} {
+	return {{.Handle}}(s.reader, slotKey({{.Slot}}), {{.Offset}})
+}
+{{end}}
+{{- range .Structs}}
+{{$struct := .}}
+// {{.GoType}} reads the members of a {{.Label}} in the storage of {{$.Name}}.
+type {{.GoType}} struct {
+	reader StorageReader
+	slot   common.Hash
+}
+
+func new{{.GoType}}(reader StorageReader, slot common.Hash, _ uint) *{{.GoType}} {
+	return &{{.GoType}}{reader: reader, slot: slot}
+}
+
+// Slot returns the first slot of the struct.
+func (s *{{.GoType}}) Slot() common.Hash {
+	return s.slot
+}
+{{range .Members}}
+// {{.Method}} returns the {{.Label}} member of type {{.TypeLabel}}, at slot {{.Slot}}{{if .Offset}} and offset {{.Offset}}{{end}} of the struct.
+func (s *{{$struct.GoType}}) {{.Method}}() {{.GoType}} {
+	return {{.Handle}}(s.reader, addSlot(s.slot, {{.Slot}}), {{.Offset}})
+}
+{{end}}
+{{- end}}
+`
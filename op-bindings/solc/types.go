@@ -65,6 +65,8 @@ type StorageLayoutType struct {
 	Key           string `json:"key,omitempty"`
 	Value         string `json:"value,omitempty"`
 	Base          string `json:"base,omitempty"`
+	// Members are the fields of a struct, with slots relative to the start of the struct
+	Members []StorageLayoutEntry `json:"members,omitempty"`
 }
 
 type CompilerOutputEvm struct {
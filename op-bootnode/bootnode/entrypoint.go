@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
+	"github.com/ethereum-optimism/optimism/op-bootnode/crawler"
+	"github.com/ethereum-optimism/optimism/op-bootnode/flags"
 	opnode "github.com/ethereum-optimism/optimism/op-node"
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
@@ -12,6 +15,7 @@ import (
 	p2pcli "github.com/ethereum-optimism/optimism/op-node/p2p/cli"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/opio"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -37,12 +41,17 @@ func (l *l2Chain) PayloadByNumber(_ context.Context, _ uint64) (*eth.ExecutionPa
 	return nil, nil
 }
 
+func (l *l2Chain) PayloadByHash(_ context.Context, _ common.Hash) (*eth.ExecutionPayload, error) {
+	return nil, nil
+}
+
 func Main(cliCtx *cli.Context) error {
 	log.Info("Initializing bootnode")
 	logCfg := oplog.ReadCLIConfig(cliCtx)
 	logger := oplog.NewLogger(logCfg)
 	m := metrics.NewMetrics("default")
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	config, err := opnode.NewRollupConfig(cliCtx)
 	if err != nil {
@@ -67,11 +76,74 @@ func Main(cliCtx *cli.Context) error {
 
 	go p2pNode.DiscoveryProcess(ctx, logger, config, p2pConfig.TargetPeers())
 
+	if cliCtx.Bool(flags.CrawlerEnabled.Name) {
+		db, err := crawler.OpenDB(cliCtx.String(flags.CrawlerDB.Name))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		crawlerCfg := crawler.Config{
+			ChainID:        config.L2ChainID.Uint64(),
+			DialInterval:   cliCtx.Duration(flags.CrawlerDialInterval.Name),
+			ExportPath:     cliCtx.String(flags.CrawlerExport.Name),
+			ExportInterval: cliCtx.Duration(flags.CrawlerExportInterval.Name),
+		}
+		c := crawler.NewCrawler(logger.New("module", "crawler"), crawlerCfg, db, p2pNode.Host(), p2pNode.Dv5Udp(), crawler.NewMetrics(m.Registry()))
+		crawlerDone := make(chan struct{})
+		go func() {
+			c.Run(ctx)
+			close(crawlerDone)
+		}()
+		// the crawler writes to the db until it is stopped, so stop it before closing the db
+		defer func() {
+			cancel()
+			<-crawlerDone
+		}()
+	}
+
+	metricsCfg := opmetrics.ReadCLIConfig(cliCtx)
+	if err := metricsCfg.Check(); err != nil {
+		return fmt.Errorf("invalid metrics config: %w", err)
+	}
+	if metricsCfg.Enabled {
+		logger.Info("starting metrics server", "addr", metricsCfg.ListenAddr, "port", metricsCfg.ListenPort)
+		go func() {
+			if err := m.Serve(ctx, metricsCfg.ListenAddr, metricsCfg.ListenPort); err != nil {
+				logger.Error("error starting metrics server", "err", err)
+			}
+		}()
+	}
+
 	opio.BlockOnInterrupts()
 
 	return nil
 }
 
+// Export writes the node records of the crawler database as JSON, to the export file or to stdout.
+// The database is locked while the bootnode runs, use the periodic export of the crawler instead.
+func Export(cliCtx *cli.Context) error {
+	config, err := opnode.NewRollupConfig(cliCtx)
+	if err != nil {
+		return err
+	}
+	if err = validateConfig(config); err != nil {
+		return err
+	}
+	db, err := crawler.OpenDB(cliCtx.String(flags.CrawlerDB.Name))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	nodes, err := db.Nodes()
+	if err != nil {
+		return err
+	}
+	if path := cliCtx.String(flags.CrawlerExport.Name); path != "" {
+		return crawler.WriteExport(path, config.L2ChainID.Uint64(), nodes)
+	}
+	return crawler.EncodeExport(os.Stdout, config.L2ChainID.Uint64(), nodes)
+}
+
 // validateConfig ensures the minimal config required to run a bootnode
 func validateConfig(config *rollup.Config) error {
 	if config.L2ChainID == nil || config.L2ChainID.Uint64() == 0 {
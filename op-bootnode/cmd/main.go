@@ -25,6 +25,14 @@ func main() {
 	app.Usage = "Rollup Bootnode"
 	app.Description = "Broadcasts incoming P2P peers to each other, enabling peer bootstrapping."
 	app.Action = bootnode.Main
+	app.Commands = []*cli.Command{
+		{
+			Name:   "export",
+			Usage:  "Exports the node records of the crawler database as JSON",
+			Flags:  []cli.Flag{flags.RollupConfig, flags.Network, flags.CrawlerDB, flags.CrawlerExport},
+			Action: bootnode.Export,
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -0,0 +1,342 @@
+package crawler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+)
+
+const (
+	dialWorkerCount       = 8
+	dialTimeout           = 10 * time.Second
+	identifyTimeout       = 10 * time.Second
+	dialCheckInterval     = 30 * time.Second
+	tableKickoffDelay     = 3 * time.Second
+	discoveredNodesBuffer = 16
+)
+
+type Config struct {
+	// ChainID is the L2 chain ID of the nodes to crawl. Nodes of any opstack version are recorded.
+	ChainID uint64
+	// DialInterval is the minimum time between two dials of the same node
+	DialInterval time.Duration
+	// ExportPath is the file the node records are exported to as JSON, nothing is exported if empty
+	ExportPath string
+	// ExportInterval is the interval of the export and of the node metrics updates
+	ExportInterval time.Duration
+}
+
+// Crawler walks the discv5 DHT for nodes of the chain, and records them in the DB.
+// Recorded nodes are dialed periodically, to track their reachability and what they report over identify.
+type Crawler struct {
+	log     log.Logger
+	cfg     Config
+	db      *DB
+	host    host.Host
+	dv5     *discover.UDPv5
+	metrics Metrics
+
+	// dbLock serializes the read-modify-write updates of node records,
+	// as nodes are rediscovered and dialed concurrently
+	dbLock sync.Mutex
+}
+
+func NewCrawler(log log.Logger, cfg Config, db *DB, h host.Host, dv5 *discover.UDPv5, m Metrics) *Crawler {
+	return &Crawler{
+		log:     log,
+		cfg:     cfg,
+		db:      db,
+		host:    h,
+		dv5:     dv5,
+		metrics: m,
+	}
+}
+
+// Run crawls the network until the context is cancelled.
+func (c *Crawler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		c.discoverLoop(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		c.dialLoop(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		c.exportLoop(ctx)
+	}()
+	wg.Wait()
+	c.log.Info("stopped crawler")
+}
+
+// filter accepts the nodes of the chain, of any opstack version.
+func (c *Crawler) filter(node *enode.Node) bool {
+	var dat p2p.OpStackENRData
+	if err := node.Load(&dat); err != nil {
+		return false
+	}
+	return dat.ChainID() == c.cfg.ChainID
+}
+
+func (c *Crawler) discoverLoop(ctx context.Context) {
+	iter := enode.Filter(c.dv5.RandomNodes(), c.filter)
+	defer iter.Close()
+
+	found := make(chan *enode.Node, discoveredNodesBuffer)
+	go func() {
+		defer close(found)
+		for iter.Next() {
+			select {
+			case found <- iter.Node():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Kick off with the nodes of the table, the DHT walk may take a while to find anything
+	kickoff := time.NewTimer(tableKickoffDelay)
+	defer kickoff.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-kickoff.C:
+			for _, node := range c.dv5.AllNodes() {
+				if c.filter(node) {
+					c.recordNode(node)
+				}
+			}
+		case node, ok := <-found:
+			if !ok {
+				c.log.Info("discv5 DHT iteration stopped, no longer discovering nodes")
+				<-ctx.Done()
+				return
+			}
+			c.recordNode(node)
+		}
+	}
+}
+
+// recordNode creates or updates the record of a discovered node.
+func (c *Crawler) recordNode(node *enode.Node) {
+	var dat p2p.OpStackENRData
+	if err := node.Load(&dat); err != nil {
+		return
+	}
+	c.dbLock.Lock()
+	defer c.dbLock.Unlock()
+	rec, err := c.db.Node(node.ID())
+	if err != nil {
+		c.log.Error("failed to read node record", "node", node.ID(), "err", err)
+		return
+	}
+	now := time.Now()
+	if rec == nil {
+		rec = &NodeRecord{ID: node.ID(), FirstSeen: now}
+		c.log.Info("discovered new node", "node", node.ID(), "ip", node.IP(), "tcp", node.TCP(), "version", dat.Version())
+	}
+	// Don't go back to an older ENR, if we happen to see a stale copy of the record
+	if rec.ENR == "" || node.Seq() >= rec.Seq {
+		rec.ENR = node.String()
+		rec.Seq = node.Seq()
+		rec.ChainID = dat.ChainID()
+		rec.Version = dat.Version()
+		rec.IP = node.IP()
+		rec.TCP = node.TCP()
+		rec.UDP = node.UDP()
+		if info, _, err := p2p.EnrToAddrInfo(node); err == nil {
+			rec.PeerID = info.ID
+		}
+	}
+	rec.LastSeen = now
+	if err := c.db.PutNode(rec); err != nil {
+		c.log.Error("failed to write node record", "node", node.ID(), "err", err)
+	}
+}
+
+func (c *Crawler) dialLoop(ctx context.Context) {
+	dials := make(chan enode.ID)
+	var wg sync.WaitGroup
+	for i := 0; i < dialWorkerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range dials {
+				c.dial(ctx, id)
+			}
+		}()
+	}
+	defer wg.Wait()
+	defer close(dials)
+
+	ticker := time.NewTicker(dialCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			nodes, err := c.db.Nodes()
+			if err != nil {
+				c.log.Error("failed to read node records", "err", err)
+				continue
+			}
+			for _, rec := range nodes {
+				if !c.dialDue(rec) {
+					continue
+				}
+				select {
+				case dials <- rec.ID:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *Crawler) dialDue(rec *NodeRecord) bool {
+	return rec.TCP != 0 && rec.PeerID != "" && rec.PeerID != c.host.ID() && time.Since(rec.LastDial) >= c.cfg.DialInterval
+}
+
+// dial connects to a node, and records whether it is reachable and what it reports over identify.
+// The connection is closed again if we were not connected to the node before.
+func (c *Crawler) dial(ctx context.Context, id enode.ID) {
+	// Claim the dial first, the node may have been queued again while it was being dialed
+	c.dbLock.Lock()
+	rec, err := c.db.Node(id)
+	if err != nil || rec == nil || !c.dialDue(rec) {
+		c.dbLock.Unlock()
+		return
+	}
+	rec.LastDial = time.Now()
+	rec.Dials++
+	err = c.db.PutNode(rec)
+	c.dbLock.Unlock()
+	if err != nil {
+		c.log.Error("failed to write node record", "node", id, "err", err)
+		return
+	}
+
+	node, err := enode.Parse(enode.ValidSchemes, rec.ENR)
+	if err != nil {
+		c.recordDial(id, nil, err)
+		return
+	}
+	info, _, err := p2p.EnrToAddrInfo(node)
+	if err != nil {
+		c.recordDial(id, nil, err)
+		return
+	}
+	connected := c.host.Network().Connectedness(info.ID) == network.Connected
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	err = c.host.Connect(dialCtx, *info)
+	cancel()
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		c.log.Debug("failed to dial node", "node", id, "peer", info.ID, "err", err)
+		c.recordDial(id, nil, err)
+		return
+	}
+	c.waitIdentify(ctx, info.ID)
+	c.recordDial(id, info, nil)
+	if !connected {
+		_ = c.host.Network().ClosePeer(info.ID)
+	}
+}
+
+// waitIdentify waits for the identify protocol to complete on the connection to the peer,
+// if the host exposes its identify service.
+func (c *Crawler) waitIdentify(ctx context.Context, id peer.ID) {
+	h, ok := c.host.(interface{ IDService() identify.IDService })
+	if !ok {
+		return
+	}
+	conns := c.host.Network().ConnsToPeer(id)
+	if len(conns) == 0 {
+		return
+	}
+	select {
+	case <-h.IDService().IdentifyWait(conns[0]):
+	case <-time.After(identifyTimeout):
+	case <-ctx.Done():
+	}
+}
+
+// recordDial records the result of a dial: the identify info of the peer if it was reachable, or the dial error.
+func (c *Crawler) recordDial(id enode.ID, info *peer.AddrInfo, dialErr error) {
+	c.metrics.RecordDial(dialErr == nil)
+	c.dbLock.Lock()
+	defer c.dbLock.Unlock()
+	rec, err := c.db.Node(id)
+	if err != nil || rec == nil {
+		return
+	}
+	if dialErr != nil {
+		rec.Reachable = false
+		rec.DialFailures++
+		rec.LastError = dialErr.Error()
+	} else {
+		rec.Reachable = true
+		rec.LastConnected = time.Now()
+		rec.LastError = ""
+		pstore := c.host.Peerstore()
+		if v, err := pstore.Get(info.ID, "AgentVersion"); err == nil {
+			rec.Agent, _ = v.(string)
+		}
+		if v, err := pstore.Get(info.ID, "ProtocolVersion"); err == nil {
+			rec.ProtocolVersion, _ = v.(string)
+		}
+		if protocols, err := pstore.GetProtocols(info.ID); err == nil {
+			rec.Protocols = protocol.ConvertToStrings(protocols)
+		}
+	}
+	if err := c.db.PutNode(rec); err != nil {
+		c.log.Error("failed to write node record", "node", id, "err", err)
+	}
+}
+
+func (c *Crawler) exportLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.ExportInterval)
+	defer ticker.Stop()
+	for {
+		if err := c.export(); err != nil {
+			c.log.Error("failed to export node records", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Crawler) export() error {
+	nodes, err := c.db.Nodes()
+	if err != nil {
+		return err
+	}
+	c.metrics.RecordNodes(ChainNodes(c.cfg.ChainID, nodes))
+	if c.cfg.ExportPath == "" {
+		return nil
+	}
+	return WriteExport(c.cfg.ExportPath, c.cfg.ChainID, nodes)
+}
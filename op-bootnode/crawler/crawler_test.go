@@ -0,0 +1,173 @@
+package crawler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	decredSecp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+const testChainID = 901
+
+func newTestHost(t *testing.T, agent string) (host.Host, *enode.Node) {
+	priv, _, err := crypto.GenerateSecp256k1Key(nil)
+	require.NoError(t, err)
+	h, err := libp2p.New(
+		libp2p.Identity(priv),
+		libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+		libp2p.UserAgent(agent),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = h.Close() })
+
+	port, err := h.Addrs()[0].ValueForProtocol(ma.P_TCP)
+	require.NoError(t, err)
+	tcpPort, err := net.LookupPort("tcp", port)
+	require.NoError(t, err)
+
+	key := (*decredSecp.PrivateKey)(priv.(*crypto.Secp256k1PrivateKey)).ToECDSA()
+	key.Curve = gcrypto.S256()
+	var r enr.Record
+	r.Set(enr.IPv4(net.IPv4(127, 0, 0, 1)))
+	r.Set(enr.TCP(tcpPort))
+	r.Set(p2p.NewOpStackENRData(testChainID, 0))
+	require.NoError(t, enode.SignV4(&r, key))
+	node, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+	return h, node
+}
+
+func TestCrawlerDial(t *testing.T) {
+	local, _ := newTestHost(t, "crawler")
+	remote, node := newTestHost(t, "remote-agent")
+	db, err := OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+
+	c := NewCrawler(testlog.Logger(t, log.LvlInfo), Config{ChainID: testChainID, DialInterval: time.Hour}, db, local, nil, NoopMetrics)
+	c.recordNode(node)
+	rec, err := db.Node(node.ID())
+	require.NoError(t, err)
+	require.Equal(t, remote.ID(), rec.PeerID)
+	require.Equal(t, StateUndialed, rec.State())
+	require.True(t, c.dialDue(rec))
+
+	c.dial(context.Background(), node.ID())
+	rec, err = db.Node(node.ID())
+	require.NoError(t, err)
+	require.Equal(t, StateReachable, rec.State())
+	require.Equal(t, uint64(1), rec.Dials)
+	require.Equal(t, "remote-agent", rec.Agent)
+	require.NotEmpty(t, rec.Protocols)
+	require.False(t, c.dialDue(rec), "dialed nodes are not due until the dial interval passed")
+	// we were not connected before, so the crawler closes the connection again
+	require.NotEqual(t, network.Connected, local.Network().Connectedness(remote.ID()))
+
+	// unreachable once the node is gone
+	require.NoError(t, remote.Close())
+	rec.LastDial = time.Time{}
+	require.NoError(t, db.PutNode(rec))
+	c.dial(context.Background(), node.ID())
+	rec, err = db.Node(node.ID())
+	require.NoError(t, err)
+	require.Equal(t, StateUnreachable, rec.State())
+	require.Equal(t, uint64(1), rec.DialFailures)
+	require.NotEmpty(t, rec.LastError)
+	require.False(t, rec.LastConnected.IsZero())
+}
+
+func TestDBPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "crawler_db")
+	db, err := OpenDB(path)
+	require.NoError(t, err)
+	rec := &NodeRecord{ID: enode.ID{0x01}, ChainID: testChainID, Agent: "agent"}
+	require.NoError(t, db.PutNode(rec))
+	require.NoError(t, db.PutNode(&NodeRecord{ID: enode.ID{0x02}, ChainID: testChainID}))
+	require.NoError(t, db.Close())
+
+	db, err = OpenDB(path)
+	require.NoError(t, err)
+	defer db.Close()
+	got, err := db.Node(rec.ID)
+	require.NoError(t, err)
+	require.Equal(t, "agent", got.Agent)
+	missing, err := db.Node(enode.ID{0x03})
+	require.NoError(t, err)
+	require.Nil(t, missing)
+	nodes, err := db.Nodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	require.NoError(t, db.DeleteNode(rec.ID))
+	nodes, err = db.Nodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+}
+
+func TestExport(t *testing.T) {
+	nodes := []*NodeRecord{
+		{ID: enode.ID{0x01}, ChainID: testChainID, LastDial: time.Now(), Reachable: true},
+		{ID: enode.ID{0x02}, ChainID: testChainID},
+		{ID: enode.ID{0x03}, ChainID: testChainID + 1},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, EncodeExport(&buf, testChainID, nodes))
+	var out Export
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	require.Equal(t, uint64(testChainID), out.ChainID)
+	require.Len(t, out.Nodes, 2, "nodes of other chains are not exported")
+	require.Equal(t, StateReachable, out.Nodes[0].State)
+	require.Equal(t, StateUndialed, out.Nodes[1].State)
+
+	path := filepath.Join(t.TempDir(), "nodes.json")
+	require.NoError(t, WriteExport(path, testChainID, nodes))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &out))
+	require.Len(t, out.Nodes, 2)
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "no temporary files are left behind")
+}
+
+type nodesMetrics struct {
+	noopMetrics
+	nodes []*NodeRecord
+}
+
+func (m *nodesMetrics) RecordNodes(nodes []*NodeRecord) {
+	m.nodes = nodes
+}
+
+func TestExportMetrics(t *testing.T) {
+	local, _ := newTestHost(t, "crawler")
+	db, err := OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.PutNode(&NodeRecord{ID: enode.ID{0x01}, ChainID: testChainID}))
+	require.NoError(t, db.PutNode(&NodeRecord{ID: enode.ID{0x02}, ChainID: testChainID + 1}))
+
+	m := &nodesMetrics{}
+	c := NewCrawler(testlog.Logger(t, log.LvlInfo), Config{ChainID: testChainID}, db, local, nil, m)
+	require.NoError(t, c.export())
+	require.Len(t, m.nodes, 1, "nodes of other chains are not recorded")
+	require.Equal(t, enode.ID{0x01}, m.nodes[0].ID)
+}
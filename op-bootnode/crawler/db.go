@@ -0,0 +1,136 @@
+package crawler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// nodeKeyPrefix is the key prefix of node records in the database, followed by the node ID
+var nodeKeyPrefix = []byte("node-")
+
+// NodeRecord is what the crawler knows of a discovered node
+type NodeRecord struct {
+	ID     enode.ID `json:"id"`
+	ENR    string   `json:"enr"`
+	Seq    uint64   `json:"seq"`
+	PeerID peer.ID  `json:"peerID,omitempty"`
+	// ChainID and Version are the values of the "opstack" ENR entry
+	ChainID uint64 `json:"chainID"`
+	Version uint64 `json:"opstackVersion"`
+	IP      net.IP `json:"ip"`
+	TCP     int    `json:"tcp"`
+	UDP     int    `json:"udp"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+
+	// LastDial is the time of the last connection attempt, zero if the node was never dialed
+	LastDial time.Time `json:"lastDial"`
+	// LastConnected is the time of the last successful connection, zero if the node was never reachable
+	LastConnected time.Time `json:"lastConnected"`
+	// Reachable is true if the last connection attempt succeeded
+	Reachable    bool   `json:"reachable"`
+	Dials        uint64 `json:"dials"`
+	DialFailures uint64 `json:"dialFailures"`
+	LastError    string `json:"lastError,omitempty"`
+
+	// Agent, ProtocolVersion and Protocols are reported by the node over the libp2p identify protocol
+	Agent           string   `json:"agent,omitempty"`
+	ProtocolVersion string   `json:"protocolVersion,omitempty"`
+	Protocols       []string `json:"protocols,omitempty"`
+}
+
+// DB persists the node records of the crawler, to keep them across restarts
+type DB struct {
+	db ethdb.Database
+}
+
+// OpenDB opens the node database at the given path, or an in-memory database if the path is empty or "memory".
+func OpenDB(path string) (*DB, error) {
+	if path == "" || path == "memory" {
+		return &DB{db: rawdb.NewMemoryDatabase()}, nil
+	}
+	db, err := rawdb.NewLevelDBDatabase(path, 16, 16, "crawler", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open crawler db %s: %w", path, err)
+	}
+	return &DB{db: db}, nil
+}
+
+func nodeKey(id enode.ID) []byte {
+	return append(append([]byte{}, nodeKeyPrefix...), id[:]...)
+}
+
+// Node returns the record of a node, or nil if the node is unknown.
+func (d *DB) Node(id enode.ID) (*NodeRecord, error) {
+	data, err := d.db.Get(nodeKey(id))
+	if err != nil {
+		if ok, _ := d.db.Has(nodeKey(id)); !ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read node %s: %w", id, err)
+	}
+	var rec NodeRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("failed to decode node %s: %w", id, err)
+	}
+	return &rec, nil
+}
+
+// PutNode stores the record of a node.
+func (d *DB) PutNode(rec *NodeRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode node %s: %w", rec.ID, err)
+	}
+	if err := d.db.Put(nodeKey(rec.ID), data); err != nil {
+		return fmt.Errorf("failed to write node %s: %w", rec.ID, err)
+	}
+	return nil
+}
+
+// DeleteNode removes the record of a node.
+func (d *DB) DeleteNode(id enode.ID) error {
+	return d.db.Delete(nodeKey(id))
+}
+
+// Nodes returns the records of all nodes, ordered by node ID.
+func (d *DB) Nodes() ([]*NodeRecord, error) {
+	it := d.db.NewIterator(nodeKeyPrefix, nil)
+	defer it.Release()
+	var nodes []*NodeRecord
+	for it.Next() {
+		var rec NodeRecord
+		if err := json.Unmarshal(it.Value(), &rec); err != nil {
+			return nil, fmt.Errorf("failed to decode node at key %x: %w", it.Key(), err)
+		}
+		nodes = append(nodes, &rec)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate nodes: %w", err)
+	}
+	return nodes, nil
+}
+
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// State returns the reachability of the node, as labeled in metrics and exports.
+func (n *NodeRecord) State() string {
+	switch {
+	case n.LastDial.IsZero():
+		return StateUndialed
+	case n.Reachable:
+		return StateReachable
+	default:
+		return StateUnreachable
+	}
+}
@@ -0,0 +1,78 @@
+package crawler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Export is the JSON export of the node records of a chain
+type Export struct {
+	Time    time.Time     `json:"time"`
+	ChainID uint64        `json:"chainID"`
+	Nodes   []*ExportNode `json:"nodes"`
+}
+
+// ExportNode is an exported node record, with its reachability state
+type ExportNode struct {
+	*NodeRecord
+	State string `json:"state"`
+}
+
+func NewExport(chainID uint64, nodes []*NodeRecord) *Export {
+	out := &Export{
+		Time:    time.Now(),
+		ChainID: chainID,
+		Nodes:   make([]*ExportNode, 0, len(nodes)),
+	}
+	for _, n := range ChainNodes(chainID, nodes) {
+		out.Nodes = append(out.Nodes, &ExportNode{NodeRecord: n, State: n.State()})
+	}
+	return out
+}
+
+// ChainNodes returns the node records of the given chain.
+// The DB may hold nodes of other chains, if it was used for another chain before.
+func ChainNodes(chainID uint64, nodes []*NodeRecord) []*NodeRecord {
+	out := make([]*NodeRecord, 0, len(nodes))
+	for _, n := range nodes {
+		if n.ChainID == chainID {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// EncodeExport writes the JSON export of the node records of a chain.
+func EncodeExport(w io.Writer, chainID uint64, nodes []*NodeRecord) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(NewExport(chainID, nodes)); err != nil {
+		return fmt.Errorf("failed to encode export: %w", err)
+	}
+	return nil
+}
+
+// WriteExport writes the JSON export of the node records of a chain to a file.
+// The file is replaced atomically, for readers to never see a partial export.
+func WriteExport(path string, chainID uint64, nodes []*NodeRecord) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := EncodeExport(tmp, chainID, nodes); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
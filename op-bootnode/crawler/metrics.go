@@ -0,0 +1,89 @@
+package crawler
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+const MetricsNamespace = "op_bootnode_crawler"
+
+// Node states, as labeled in the nodes metric
+const (
+	StateReachable   = "reachable"
+	StateUnreachable = "unreachable"
+	StateUndialed    = "undialed"
+)
+
+type Metrics interface {
+	RecordDial(success bool)
+	// RecordNodes replaces the node counts with the counts of the given node records.
+	RecordNodes(nodes []*NodeRecord)
+}
+
+type metrics struct {
+	nodes    *prometheus.GaugeVec
+	agents   *prometheus.GaugeVec
+	versions *prometheus.GaugeVec
+	dials    *prometheus.CounterVec
+}
+
+func NewMetrics(r *prometheus.Registry) Metrics {
+	factory := opmetrics.With(r)
+	return &metrics{
+		nodes: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "nodes",
+			Help:      "Number of known nodes of the chain, by reachability",
+		}, []string{"state"}),
+		agents: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "agents",
+			Help:      "Number of reachable nodes by libp2p agent and protocol version",
+		}, []string{"agent", "protocol_version"}),
+		versions: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "opstack_versions",
+			Help:      "Number of known nodes by opstack ENR version",
+		}, []string{"version"}),
+		dials: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "dials_total",
+			Help:      "Count of dial attempts by result",
+		}, []string{"result"}),
+	}
+}
+
+func (m *metrics) RecordDial(success bool) {
+	if success {
+		m.dials.WithLabelValues("success").Inc()
+	} else {
+		m.dials.WithLabelValues("failure").Inc()
+	}
+}
+
+func (m *metrics) RecordNodes(nodes []*NodeRecord) {
+	m.nodes.Reset()
+	m.agents.Reset()
+	m.versions.Reset()
+	for _, state := range []string{StateReachable, StateUnreachable, StateUndialed} {
+		m.nodes.WithLabelValues(state).Set(0)
+	}
+	for _, n := range nodes {
+		m.nodes.WithLabelValues(n.State()).Inc()
+		m.versions.WithLabelValues(strconv.FormatUint(n.Version, 10)).Inc()
+		if n.Reachable {
+			m.agents.WithLabelValues(n.Agent, n.ProtocolVersion).Inc()
+		}
+	}
+}
+
+type noopMetrics struct{}
+
+var NoopMetrics Metrics = new(noopMetrics)
+
+func (*noopMetrics) RecordDial(bool) {}
+
+func (*noopMetrics) RecordNodes([]*NodeRecord) {}
@@ -3,11 +3,13 @@ package flags
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/urfave/cli/v2"
 )
 
@@ -28,13 +30,91 @@ var (
 		Usage:   fmt.Sprintf("Predefined network selection. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
 		EnvVars: prefixEnvVars("NETWORK"),
 	}
+	CrawlerEnabled = &cli.BoolFlag{
+		Name:    "crawler.enabled",
+		Usage:   "Crawl the discovery network for nodes of the chain, and periodically dial them to record their reachability",
+		EnvVars: prefixEnvVars("CRAWLER_ENABLED"),
+	}
+	CrawlerDB = &cli.StringFlag{
+		Name:      "crawler.db",
+		Usage:     "Database of the crawled node records, persisted across restarts. Set to 'memory' to never persist the records.",
+		TakesFile: true,
+		Value:     "bootnode_crawler_db",
+		EnvVars:   prefixEnvVars("CRAWLER_DB"),
+	}
+	CrawlerDialInterval = &cli.DurationFlag{
+		Name:    "crawler.dial-interval",
+		Usage:   "Minimum interval between two dials of the same node",
+		Value:   30 * time.Minute,
+		EnvVars: prefixEnvVars("CRAWLER_DIAL_INTERVAL"),
+	}
+	CrawlerExport = &cli.StringFlag{
+		Name:      "crawler.export",
+		Usage:     "File to periodically export the crawled node records to as JSON. Disabled if empty.",
+		TakesFile: true,
+		EnvVars:   prefixEnvVars("CRAWLER_EXPORT"),
+	}
+	CrawlerExportInterval = &cli.DurationFlag{
+		Name:    "crawler.export-interval",
+		Usage:   "Interval of the JSON export and of the node metrics updates",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("CRAWLER_EXPORT_INTERVAL"),
+	}
 )
 
 var Flags = []cli.Flag{
 	RollupConfig,
 	Network,
+	CrawlerEnabled,
+	CrawlerDB,
+	CrawlerDialInterval,
+	CrawlerExport,
+	CrawlerExportInterval,
 }
 
 func init() {
+	Flags = append(Flags, p2pFlags()...)
 	Flags = append(Flags, oplog.CLIFlags(envVarPrefix)...)
+	Flags = append(Flags, opmetrics.CLIFlags(envVarPrefix)...)
+}
+
+// p2pFlags returns copies of the P2P flags of the op-node, with the env vars of the op-bootnode.
+func p2pFlags() []cli.Flag {
+	out := make([]cli.Flag, 0, len(flags.P2PFlags))
+	for _, f := range flags.P2PFlags {
+		switch f := f.(type) {
+		case *cli.BoolFlag:
+			c := *f
+			c.EnvVars = bootnodeEnvVars(f.EnvVars)
+			out = append(out, &c)
+		case *cli.DurationFlag:
+			c := *f
+			c.EnvVars = bootnodeEnvVars(f.EnvVars)
+			out = append(out, &c)
+		case *cli.StringFlag:
+			c := *f
+			c.EnvVars = bootnodeEnvVars(f.EnvVars)
+			out = append(out, &c)
+		case *cli.Float64Flag:
+			c := *f
+			c.EnvVars = bootnodeEnvVars(f.EnvVars)
+			out = append(out, &c)
+		case *cli.UintFlag:
+			c := *f
+			c.EnvVars = bootnodeEnvVars(f.EnvVars)
+			out = append(out, &c)
+		default:
+			panic(fmt.Errorf("unexpected P2P flag type %T", f))
+		}
+	}
+	return out
+}
+
+// bootnodeEnvVars replaces the op-node prefix of env vars with the op-bootnode prefix
+func bootnodeEnvVars(envVars []string) []string {
+	out := make([]string, 0, len(envVars))
+	for _, envVar := range envVars {
+		out = append(out, envVarPrefix+"_"+strings.TrimPrefix(envVar, flags.EnvVarPrefix+"_"))
+	}
+	return out
 }
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+)
+
+// TestEnvVarPrefix asserts that all flags, including the P2P flags of the op-node, use the op-bootnode env var prefix.
+func TestEnvVarPrefix(t *testing.T) {
+	for _, flag := range Flags {
+		envFlag, ok := flag.(interface{ GetEnvVars() []string })
+		require.True(t, ok, "flag %s has no env vars", flag.Names()[0])
+		for _, envVar := range envFlag.GetEnvVars() {
+			require.True(t, strings.HasPrefix(envVar, envVarPrefix+"_"), "flag %s has env var %s", flag.Names()[0], envVar)
+		}
+	}
+	// the op-node flags are not changed
+	require.Equal(t, []string{"OP_NODE_P2P_LISTEN_IP"}, flags.ListenIP.EnvVars)
+}
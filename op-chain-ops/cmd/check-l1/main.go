@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// Script for checking that the L1 contracts of an OP Stack chain have been deployed and configured correctly.
+// It writes a JSON report of all checks and fails if any of them does not pass.
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "check-l1",
+		Usage: "Check that the L1 contracts of an OP Stack chain have been configured correctly",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "l1-rpc-url",
+				Required: true,
+				Usage:    "L1 RPC URL",
+				EnvVars:  []string{"L1_RPC_URL"},
+			},
+			&cli.StringFlag{
+				Name:     "rollup-config",
+				Required: true,
+				Usage:    "Path to the rollup config file",
+			},
+			&cli.StringFlag{
+				Name:     "deploy-config",
+				Required: true,
+				Usage:    "Path to the deploy config file",
+			},
+			&cli.StringFlag{
+				Name:  "deployments-file",
+				Usage: "Path to the deployments file written by deploy-l1",
+			},
+			&cli.StringFlag{
+				Name:  "deployment-dir",
+				Usage: "Path to a hardhat-deploy deployment directory, as an alternative to the deployments file",
+			},
+			&cli.StringFlag{
+				Name:  "out",
+				Usage: "Path to write the JSON report to. Defaults to stdout",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			rollupConfig, err := readRollupConfig(ctx.String("rollup-config"))
+			if err != nil {
+				return err
+			}
+			config, err := genesis.NewDeployConfig(ctx.String("deploy-config"))
+			if err != nil {
+				return err
+			}
+			deployments, err := readDeployments(ctx)
+			if err != nil {
+				return err
+			}
+			client, err := ethclient.Dial(ctx.String("l1-rpc-url"))
+			if err != nil {
+				return fmt.Errorf("cannot dial %s: %w", ctx.String("l1-rpc-url"), err)
+			}
+			defer client.Close()
+
+			log.Info("Checking L1 contracts")
+			report, err := genesis.CheckL1(ctx.Context, client, rollupConfig, config, deployments)
+			if err != nil {
+				return err
+			}
+			for _, check := range report.Failed() {
+				log.Error("Check failed", "contract", check.Contract, "check", check.Check,
+					"expected", check.Expected, "actual", check.Actual, "err", check.Error)
+			}
+			if err := writeReport(ctx.String("out"), report); err != nil {
+				return err
+			}
+			if !report.Passed {
+				return fmt.Errorf("%d of %d checks failed", len(report.Failed()), len(report.Checks))
+			}
+			log.Info("All L1 contracts are configured correctly", "checks", len(report.Checks))
+			return nil
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error checking L1", "err", err)
+	}
+}
+
+func readRollupConfig(path string) (*rollup.Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rollup config: %w", err)
+	}
+	defer file.Close()
+	var rollupConfig rollup.Config
+	if err := json.NewDecoder(file).Decode(&rollupConfig); err != nil {
+		return nil, fmt.Errorf("failed to decode rollup config: %w", err)
+	}
+	return &rollupConfig, nil
+}
+
+func readDeployments(ctx *cli.Context) (genesis.L1Deployments, error) {
+	if path := ctx.String("deployments-file"); path != "" {
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("failed to read deployments: %w", err)
+		}
+		return genesis.LoadL1Deployments(path)
+	}
+	if dir := ctx.String("deployment-dir"); dir != "" {
+		depPath, network := filepath.Split(dir)
+		hh, err := hardhat.New(network, nil, []string{depPath})
+		if err != nil {
+			return nil, err
+		}
+		return genesis.NewL1DeploymentsFromHardhat(hh)
+	}
+	return nil, errors.New("either a deployments file or a deployment directory is required")
+}
+
+func writeReport(path string, report *genesis.L1CheckReport) error {
+	out := os.Stdout
+	if path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create report file: %w", err)
+		}
+		defer file.Close()
+		out = file
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+const envPrefix = "OP_DEPLOY_L1"
+
+var (
+	L1RPCFlag = &cli.StringFlag{
+		Name:     txmgr.L1RPCFlagName,
+		Usage:    "HTTP provider URL for L1",
+		EnvVars:  []string{envPrefix + "_L1_ETH_RPC"},
+		Required: true,
+	}
+	DeployConfigFlag = &cli.StringFlag{
+		Name:     "deploy-config",
+		Usage:    "Path to the deploy config file",
+		EnvVars:  []string{envPrefix + "_DEPLOY_CONFIG"},
+		Required: true,
+	}
+	DeploymentsFileFlag = &cli.StringFlag{
+		Name:     "deployments-file",
+		Usage:    "File to persist the deployed contracts to. Run again with the same file to resume.",
+		EnvVars:  []string{envPrefix + "_DEPLOYMENTS_FILE"},
+		Required: true,
+	}
+	DeploymentDirFlag = &cli.StringFlag{
+		Name:    "deployment-dir",
+		Usage:   "Directory to write hardhat-deploy style deployments to, for use with op-node genesis l2",
+		EnvVars: []string{envPrefix + "_DEPLOYMENT_DIR"},
+	}
+)
+
+// Deploys the L1 contracts of a rollup from a deploy config, resuming from the deployments file if it exists.
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	flags := []cli.Flag{
+		L1RPCFlag,
+		DeployConfigFlag,
+		DeploymentsFileFlag,
+		DeploymentDirFlag,
+	}
+	flags = append(flags, txmgr.CLIFlags(envPrefix)...)
+
+	app := &cli.App{
+		Name:   "deploy-l1",
+		Usage:  "Deploy the L1 contracts of a rollup",
+		Flags:  flags,
+		Action: run,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error deploying L1 contracts", "err", err)
+	}
+}
+
+func run(ctx *cli.Context) error {
+	config, err := genesis.NewDeployConfig(ctx.String(DeployConfigFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	txMgrCfg := txmgr.ReadCLIConfig(ctx)
+	if err := txMgrCfg.Check(); err != nil {
+		return fmt.Errorf("invalid tx manager config: %w", err)
+	}
+	logger := log.Root()
+	txMgr, err := txmgr.NewSimpleTxManager("deploy-l1", logger, &txmetrics.NoopTxMetrics{}, txMgrCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create tx manager: %w", err)
+	}
+
+	l1, err := ethclient.DialContext(ctx.Context, ctx.String(L1RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	defer l1.Close()
+
+	deploymentsPath := ctx.String(DeploymentsFileFlag.Name)
+	deployer, err := genesis.NewL1Deployer(logger, config, deploymentsPath, l1, txMgr)
+	if err != nil {
+		return err
+	}
+	logger.Info("Deploying L1 contracts", "deployer", txMgr.From(), "deployments_file", deploymentsPath)
+	deployments, err := deployer.Run(ctx.Context)
+	if err != nil {
+		return err
+	}
+	if dir := ctx.String(DeploymentDirFlag.Name); dir != "" {
+		if err := deployments.WriteHardhatDeployments(dir); err != nil {
+			return err
+		}
+		logger.Info("Wrote hardhat deployments", "dir", dir)
+	}
+	logger.Info("L1 contracts are deployed")
+	return nil
+}
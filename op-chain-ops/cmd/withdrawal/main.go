@@ -0,0 +1,143 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/withdrawer"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+const envPrefix = "OP_WITHDRAWAL"
+
+var (
+	L1RPCFlag = &cli.StringFlag{
+		Name:     txmgr.L1RPCFlagName,
+		Usage:    "HTTP provider URL for L1",
+		EnvVars:  []string{envPrefix + "_L1_ETH_RPC"},
+		Required: true,
+	}
+	L2RPCFlag = &cli.StringFlag{
+		Name:     "l2-eth-rpc",
+		Usage:    "HTTP provider URL for L2. Must serve eth_getProof.",
+		EnvVars:  []string{envPrefix + "_L2_ETH_RPC"},
+		Required: true,
+	}
+	PortalAddressFlag = &cli.StringFlag{
+		Name:     "optimism-portal-address",
+		Usage:    "Address of the OptimismPortal contract on L1",
+		EnvVars:  []string{envPrefix + "_OPTIMISM_PORTAL_ADDRESS"},
+		Required: true,
+	}
+	TxHashFlag = &cli.StringFlag{
+		Name:     "tx-hash",
+		Usage:    "Hash of the L2 transaction that initiated the withdrawal",
+		EnvVars:  []string{envPrefix + "_TX_HASH"},
+		Required: true,
+	}
+	StateFileFlag = &cli.StringFlag{
+		Name:    "state-file",
+		Usage:   "File to persist the progress of the withdrawal to. Defaults to withdrawal-<tx-hash>.json. Run again with the same file to resume.",
+		EnvVars: []string{envPrefix + "_STATE_FILE"},
+	}
+	PollIntervalFlag = &cli.DurationFlag{
+		Name:    "poll-interval",
+		Usage:   "Time in between checks while waiting for an output proposal or the finalization period",
+		Value:   12 * time.Second,
+		EnvVars: []string{envPrefix + "_POLL_INTERVAL"},
+	}
+)
+
+// Proves and finalizes a withdrawal on L1, waiting for an output proposal and the finalization period as needed.
+// The progress is persisted, so the command can be interrupted and run again to resume the withdrawal.
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	flags := []cli.Flag{
+		L1RPCFlag,
+		L2RPCFlag,
+		PortalAddressFlag,
+		TxHashFlag,
+		StateFileFlag,
+		PollIntervalFlag,
+	}
+	flags = append(flags, txmgr.CLIFlags(envPrefix)...)
+
+	app := &cli.App{
+		Name:   "withdrawal",
+		Usage:  "Prove and finalize a withdrawal from L2 to L1",
+		Flags:  flags,
+		Action: run,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error processing withdrawal", "err", err)
+	}
+}
+
+func run(ctx *cli.Context) error {
+	var txHash common.Hash
+	if err := txHash.UnmarshalText([]byte(ctx.String(TxHashFlag.Name))); err != nil {
+		return fmt.Errorf("invalid tx hash: %w", err)
+	}
+	if !common.IsHexAddress(ctx.String(PortalAddressFlag.Name)) {
+		return errors.New("invalid portal address")
+	}
+	statePath := ctx.String(StateFileFlag.Name)
+	if statePath == "" {
+		statePath = fmt.Sprintf("withdrawal-%s.json", txHash)
+	}
+	cfg := withdrawer.Config{
+		PortalAddress: common.HexToAddress(ctx.String(PortalAddressFlag.Name)),
+		StatePath:     statePath,
+		PollInterval:  ctx.Duration(PollIntervalFlag.Name),
+	}
+
+	txMgrCfg := txmgr.ReadCLIConfig(ctx)
+	if err := txMgrCfg.Check(); err != nil {
+		return fmt.Errorf("invalid tx manager config: %w", err)
+	}
+	logger := log.Root()
+	txMgr, err := txmgr.NewSimpleTxManager("withdrawal", logger, &txmetrics.NoopTxMetrics{}, txMgrCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create tx manager: %w", err)
+	}
+
+	l1, err := ethclient.DialContext(ctx.Context, ctx.String(L1RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	defer l1.Close()
+	l2RPC, err := rpc.DialContext(ctx.Context, ctx.String(L2RPCFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer l2RPC.Close()
+
+	w, err := withdrawer.NewWithdrawer(logger, cfg, l1, ethclient.NewClient(l2RPC), gethclient.New(l2RPC), txMgr)
+	if err != nil {
+		return err
+	}
+	logger.Info("Starting withdrawal", "tx", txHash, "from", txMgr.From(), "state_file", statePath)
+	state, err := w.Run(ctx.Context, txHash)
+	if err != nil {
+		return err
+	}
+	if state.Success != nil && !*state.Success {
+		return fmt.Errorf("withdrawal %s was finalized, but the call to its target failed", state.WithdrawalHash)
+	}
+	logger.Info("Withdrawal is finalized", "withdrawal", state.WithdrawalHash)
+	return nil
+}
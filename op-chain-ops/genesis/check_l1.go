@@ -0,0 +1,313 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// L1CheckClient is the L1 chain the checked contracts live on
+type L1CheckClient interface {
+	bind.ContractCaller
+	bindings.StorageClient
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// L1Check is the outcome of a single check of the L1 system
+type L1Check struct {
+	Contract string `json:"contract"`
+	Check    string `json:"check"`
+	Passed   bool   `json:"passed"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// L1CheckReport is the outcome of all checks of the L1 system
+type L1CheckReport struct {
+	Passed bool      `json:"passed"`
+	Checks []L1Check `json:"checks"`
+}
+
+// Failed returns the checks that did not pass
+func (r *L1CheckReport) Failed() []L1Check {
+	var failed []L1Check
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// l1Checker collects the outcome of the checks of the L1 system
+type l1Checker struct {
+	ctx    context.Context
+	client L1CheckClient
+	opts   *bind.CallOpts
+	report *L1CheckReport
+}
+
+// CheckL1 checks that the L1 contracts of a rollup are deployed and configured as specified by the
+// deploy config, and that they are consistent with each other and with the rollup config.
+// It checks the EIP-1967 admin and implementation of every proxy, the initialized values of the
+// SystemConfig, L2OutputOracle and OptimismPortal, and the links in between the contracts.
+//
+// The deployments must include the ProxyAdmin and all proxies. Implementations are optional, and
+// if present, the proxies are checked to point to them. Failing checks do not stop the checking,
+// they are recorded in the returned report. An error is only returned if the check cannot be run.
+func CheckL1(ctx context.Context, client L1CheckClient, rollupConfig *rollup.Config, config *DeployConfig, deployments L1Deployments) (*L1CheckReport, error) {
+	if _, ok := deployments["ProxyAdmin"]; !ok {
+		return nil, fmt.Errorf("missing deployment of ProxyAdmin")
+	}
+	for _, proxy := range l1DeployerProxies {
+		if _, ok := deployments[proxy]; !ok {
+			return nil, fmt.Errorf("missing deployment of %s", proxy)
+		}
+	}
+	c := &l1Checker{
+		ctx:    ctx,
+		client: client,
+		opts:   &bind.CallOpts{Context: ctx},
+		report: &L1CheckReport{Passed: true},
+	}
+	proxyAdminAddr := deployments["ProxyAdmin"].Address
+
+	c.checkProxyAdmin(proxyAdminAddr, config)
+	for _, proxy := range l1DeployerProxies {
+		var implAddr *common.Address
+		if deployment, ok := deployments[strings.TrimSuffix(proxy, "Proxy")]; ok {
+			implAddr = &deployment.Address
+		}
+		c.checkProxy(proxy, deployments[proxy].Address, proxyAdminAddr, implAddr)
+	}
+	c.checkRollupConfig(rollupConfig, config, deployments)
+	c.checkSystemConfig(deployments["SystemConfigProxy"].Address, config)
+	c.checkL2OutputOracle(deployments["L2OutputOracleProxy"].Address, rollupConfig, config)
+	c.checkOptimismPortal(deployments["OptimismPortalProxy"].Address, config, deployments)
+	c.checkL1CrossDomainMessenger(deployments["L1CrossDomainMessengerProxy"].Address, deployments)
+	c.checkL1StandardBridge(deployments["L1StandardBridgeProxy"].Address, deployments)
+	c.checkOptimismMintableERC20Factory(deployments["OptimismMintableERC20FactoryProxy"].Address, deployments)
+	c.checkL1ERC721Bridge(deployments["L1ERC721BridgeProxy"].Address, deployments)
+	return c.report, nil
+}
+
+// add records the outcome of a check
+func (c *l1Checker) add(check L1Check) {
+	if !check.Passed {
+		c.report.Passed = false
+	}
+	c.report.Checks = append(c.report.Checks, check)
+}
+
+// equal records a check that the actual value equals the expected value.
+// Values are compared by their string representation, so that e.g. a uint64
+// from the config can be compared to a *big.Int returned by a contract.
+func (c *l1Checker) equal(contract, check string, expected, actual any, err error) {
+	if err != nil {
+		c.add(L1Check{Contract: contract, Check: check, Expected: fmt.Sprint(expected), Error: err.Error()})
+		return
+	}
+	c.add(L1Check{
+		Contract: contract,
+		Check:    check,
+		Passed:   fmt.Sprint(expected) == fmt.Sprint(actual),
+		Expected: fmt.Sprint(expected),
+		Actual:   fmt.Sprint(actual),
+	})
+}
+
+// code records a check that the code at the given address equals the given deployed bytecode
+func (c *l1Checker) code(contract string, addr common.Address, name string) {
+	check := fmt.Sprintf("code is %s", name)
+	expected, err := bindings.GetDeployedBytecode(name)
+	if err != nil {
+		c.add(L1Check{Contract: contract, Check: check, Error: err.Error()})
+		return
+	}
+	code, err := c.client.CodeAt(c.ctx, addr, nil)
+	if err != nil {
+		c.add(L1Check{Contract: contract, Check: check, Error: err.Error()})
+		return
+	}
+	c.add(L1Check{Contract: contract, Check: check, Passed: bytes.Equal(code, expected)})
+}
+
+// initialized records a check that the contract behind the proxy is initialized
+func (c *l1Checker) initialized(contract string, addr common.Address) {
+	view, err := decodeStorageVariables(c.ctx, contract, bindings.NewRPCStorageReader(c.client, addr, nil), []string{"_initialized"})
+	c.equal(contract, "_initialized", 1, view["_initialized"], err)
+}
+
+func (c *l1Checker) checkProxyAdmin(addr common.Address, config *DeployConfig) {
+	c.code("ProxyAdmin", addr, "ProxyAdmin")
+	proxyAdmin, err := bindings.NewProxyAdminCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: "ProxyAdmin", Check: "owner", Error: err.Error()})
+		return
+	}
+	owner, err := proxyAdmin.Owner(c.opts)
+	c.equal("ProxyAdmin", "owner", config.FinalSystemOwner, owner, err)
+}
+
+func (c *l1Checker) checkProxy(name string, addr common.Address, proxyAdminAddr common.Address, implAddr *common.Address) {
+	c.code(name, addr, "Proxy")
+
+	admin, err := c.client.StorageAt(c.ctx, addr, AdminSlot, nil)
+	c.equal(name, "EIP-1967 admin", proxyAdminAddr, common.BytesToAddress(admin), err)
+
+	impl, err := c.client.StorageAt(c.ctx, addr, ImplementationSlot, nil)
+	if implAddr != nil {
+		c.equal(name, "EIP-1967 implementation", *implAddr, common.BytesToAddress(impl), err)
+	} else if err != nil {
+		c.add(L1Check{Contract: name, Check: "EIP-1967 implementation", Error: err.Error()})
+	}
+	if err != nil {
+		return
+	}
+	code, err := c.client.CodeAt(c.ctx, common.BytesToAddress(impl), nil)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "implementation has code", Error: err.Error()})
+		return
+	}
+	c.add(L1Check{Contract: name, Check: "implementation has code", Passed: len(code) > 0, Actual: common.BytesToAddress(impl).String()})
+}
+
+func (c *l1Checker) checkRollupConfig(rollupConfig *rollup.Config, config *DeployConfig, deployments L1Deployments) {
+	chainID, err := c.client.ChainID(c.ctx)
+	c.equal("RollupConfig", "l1_chain_id", rollupConfig.L1ChainID, chainID, err)
+	c.equal("RollupConfig", "deposit_contract_address", deployments["OptimismPortalProxy"].Address, rollupConfig.DepositContractAddress, nil)
+	c.equal("RollupConfig", "l1_system_config_address", deployments["SystemConfigProxy"].Address, rollupConfig.L1SystemConfigAddress, nil)
+	c.equal("RollupConfig", "batch_inbox_address", config.BatchInboxAddress, rollupConfig.BatchInboxAddress, nil)
+	c.equal("RollupConfig", "block_time", config.L2BlockTime, rollupConfig.BlockTime, nil)
+	c.equal("RollupConfig", "genesis.system_config.batcherAddr", config.BatchSenderAddress, rollupConfig.Genesis.SystemConfig.BatcherAddr, nil)
+}
+
+func (c *l1Checker) checkSystemConfig(addr common.Address, config *DeployConfig) {
+	const name = "SystemConfig"
+	c.initialized(name, addr)
+	systemConfig, err := bindings.NewSystemConfigCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	owner, err := systemConfig.Owner(c.opts)
+	c.equal(name, "owner", config.FinalSystemOwner, owner, err)
+	overhead, err := systemConfig.Overhead(c.opts)
+	c.equal(name, "overhead", config.GasPriceOracleOverhead, overhead, err)
+	scalar, err := systemConfig.Scalar(c.opts)
+	c.equal(name, "scalar", config.GasPriceOracleScalar, scalar, err)
+	batcherHash, err := systemConfig.BatcherHash(c.opts)
+	c.equal(name, "batcherHash", config.BatchSenderAddress.Hash(), common.Hash(batcherHash), err)
+	gasLimit, err := systemConfig.GasLimit(c.opts)
+	expectedGasLimit := uint64(config.L2GenesisBlockGasLimit)
+	if expectedGasLimit == 0 {
+		expectedGasLimit = defaultL2GasLimit
+	}
+	c.equal(name, "gasLimit", expectedGasLimit, gasLimit, err)
+	unsafeBlockSigner, err := systemConfig.UnsafeBlockSigner(c.opts)
+	c.equal(name, "unsafeBlockSigner", config.P2PSequencerAddress, unsafeBlockSigner, err)
+}
+
+func (c *l1Checker) checkL2OutputOracle(addr common.Address, rollupConfig *rollup.Config, config *DeployConfig) {
+	const name = "L2OutputOracle"
+	c.initialized(name, addr)
+	oracle, err := bindings.NewL2OutputOracleCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	submissionInterval, err := oracle.SUBMISSIONINTERVAL(c.opts)
+	c.equal(name, "SUBMISSION_INTERVAL", config.L2OutputOracleSubmissionInterval, submissionInterval, err)
+	l2BlockTime, err := oracle.L2BLOCKTIME(c.opts)
+	c.equal(name, "L2_BLOCK_TIME", config.L2BlockTime, l2BlockTime, err)
+	proposer, err := oracle.PROPOSER(c.opts)
+	c.equal(name, "PROPOSER", config.L2OutputOracleProposer, proposer, err)
+	challenger, err := oracle.CHALLENGER(c.opts)
+	c.equal(name, "CHALLENGER", config.L2OutputOracleChallenger, challenger, err)
+	finalizationPeriod, err := oracle.FINALIZATIONPERIODSECONDS(c.opts)
+	c.equal(name, "FINALIZATION_PERIOD_SECONDS", config.FinalizationPeriodSeconds, finalizationPeriod, err)
+	// The oracle starts at the L2 genesis, so outputs are proposed for the blocks of the rollup
+	startingBlockNumber, err := oracle.StartingBlockNumber(c.opts)
+	c.equal(name, "startingBlockNumber", rollupConfig.Genesis.L2.Number, startingBlockNumber, err)
+	startingTimestamp, err := oracle.StartingTimestamp(c.opts)
+	c.equal(name, "startingTimestamp", rollupConfig.Genesis.L2Time, startingTimestamp, err)
+}
+
+func (c *l1Checker) checkOptimismPortal(addr common.Address, config *DeployConfig, deployments L1Deployments) {
+	const name = "OptimismPortal"
+	c.initialized(name, addr)
+	portal, err := bindings.NewOptimismPortalCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	guardian, err := portal.GUARDIAN(c.opts)
+	c.equal(name, "GUARDIAN", config.PortalGuardian, guardian, err)
+	paused, err := portal.Paused(c.opts)
+	c.equal(name, "paused", false, paused, err)
+	l2Oracle, err := portal.L2ORACLE(c.opts)
+	c.equal(name, "L2_ORACLE", deployments["L2OutputOracleProxy"].Address, l2Oracle, err)
+	systemConfig, err := portal.SYSTEMCONFIG(c.opts)
+	c.equal(name, "SYSTEM_CONFIG", deployments["SystemConfigProxy"].Address, systemConfig, err)
+}
+
+func (c *l1Checker) checkL1CrossDomainMessenger(addr common.Address, deployments L1Deployments) {
+	const name = "L1CrossDomainMessenger"
+	c.initialized(name, addr)
+	messenger, err := bindings.NewL1CrossDomainMessengerCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	portal, err := messenger.PORTAL(c.opts)
+	c.equal(name, "PORTAL", deployments["OptimismPortalProxy"].Address, portal, err)
+	otherMessenger, err := messenger.OTHERMESSENGER(c.opts)
+	c.equal(name, "OTHER_MESSENGER", predeploys.L2CrossDomainMessengerAddr, otherMessenger, err)
+}
+
+func (c *l1Checker) checkL1StandardBridge(addr common.Address, deployments L1Deployments) {
+	const name = "L1StandardBridge"
+	bridge, err := bindings.NewL1StandardBridgeCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	messenger, err := bridge.MESSENGER(c.opts)
+	c.equal(name, "MESSENGER", deployments["L1CrossDomainMessengerProxy"].Address, messenger, err)
+	otherBridge, err := bridge.OTHERBRIDGE(c.opts)
+	c.equal(name, "OTHER_BRIDGE", predeploys.L2StandardBridgeAddr, otherBridge, err)
+}
+
+func (c *l1Checker) checkOptimismMintableERC20Factory(addr common.Address, deployments L1Deployments) {
+	const name = "OptimismMintableERC20Factory"
+	factory, err := bindings.NewOptimismMintableERC20FactoryCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	bridge, err := factory.BRIDGE(c.opts)
+	c.equal(name, "BRIDGE", deployments["L1StandardBridgeProxy"].Address, bridge, err)
+}
+
+func (c *l1Checker) checkL1ERC721Bridge(addr common.Address, deployments L1Deployments) {
+	const name = "L1ERC721Bridge"
+	bridge, err := bindings.NewL1ERC721BridgeCaller(addr, c.client)
+	if err != nil {
+		c.add(L1Check{Contract: name, Check: "bindings", Error: err.Error()})
+		return
+	}
+	messenger, err := bridge.MESSENGER(c.opts)
+	c.equal(name, "MESSENGER", deployments["L1CrossDomainMessengerProxy"].Address, messenger, err)
+	otherBridge, err := bridge.OTHERBRIDGE(c.opts)
+	c.equal(name, "OTHER_BRIDGE", predeploys.L2ERC721BridgeAddr, otherBridge, err)
+}
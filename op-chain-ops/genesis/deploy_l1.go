@@ -0,0 +1,677 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/state"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+var (
+	// l1DeployerProxies are the proxies deployed by the L1Deployer, in deployment order.
+	l1DeployerProxies = []string{
+		"SystemConfigProxy",
+		"L2OutputOracleProxy",
+		"L1CrossDomainMessengerProxy",
+		"L1StandardBridgeProxy",
+		"OptimismPortalProxy",
+		"OptimismMintableERC20FactoryProxy",
+		"L1ERC721BridgeProxy",
+	}
+	// l1Contracts are the bindings of the contracts deployed by the L1Deployer.
+	l1Contracts = map[string]*bind.MetaData{
+		"ProxyAdmin":                   bindings.ProxyAdminMetaData,
+		"Proxy":                        bindings.ProxyMetaData,
+		"SystemConfig":                 bindings.SystemConfigMetaData,
+		"L2OutputOracle":               bindings.L2OutputOracleMetaData,
+		"OptimismPortal":               bindings.OptimismPortalMetaData,
+		"L1CrossDomainMessenger":       bindings.L1CrossDomainMessengerMetaData,
+		"L1StandardBridge":             bindings.L1StandardBridgeMetaData,
+		"OptimismMintableERC20Factory": bindings.OptimismMintableERC20FactoryMetaData,
+		"L1ERC721Bridge":               bindings.L1ERC721BridgeMetaData,
+	}
+)
+
+// L1Client is the L1 chain the contracts are deployed to
+type L1Client interface {
+	ethereum.ChainReader
+	bind.ContractCaller
+	bindings.StorageClient
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// l1Constructor is a contract deployment with its constructor arguments
+type l1Constructor struct {
+	name string
+	args []any
+}
+
+// l1Upgrade points a proxy to its implementation
+type l1Upgrade struct {
+	proxy          string
+	implementation string
+	// initialize is the initializer calldata that is called through the proxy. Nil to only upgrade.
+	initialize []byte
+	// storage are the values by label that the proxy storage must hold after the upgrade
+	storage map[string]any
+}
+
+// L1Deployer deploys the L1 contracts of a rollup from a DeployConfig to a live L1 chain.
+// It deploys the ProxyAdmin, the proxies and the implementations, and then upgrades and
+// initializes the proxies through the ProxyAdmin, whose ownership is finally transferred
+// to the FinalSystemOwner.
+//
+// The deployments are persisted after every step, and every step is skipped if it is already
+// done on chain, so that a deployment can be interrupted and resumed at any point. The code of
+// every contract and the storage of every proxy is checked against the expected values.
+type L1Deployer struct {
+	log             log.Logger
+	config          *DeployConfig
+	deploymentsPath string
+	l1              L1Client
+	txMgr           txmgr.TxManager
+
+	deployments L1Deployments
+}
+
+func NewL1Deployer(logger log.Logger, config *DeployConfig, deploymentsPath string, l1 L1Client, txMgr txmgr.TxManager) (*L1Deployer, error) {
+	if err := checkL1DeployConfig(config); err != nil {
+		return nil, err
+	}
+	if deploymentsPath == "" {
+		return nil, errors.New("missing deployments path")
+	}
+	return &L1Deployer{
+		log:             logger,
+		config:          config,
+		deploymentsPath: deploymentsPath,
+		l1:              l1,
+		txMgr:           txMgr,
+	}, nil
+}
+
+// checkL1DeployConfig checks the part of the deploy config that is used to deploy the L1 contracts.
+// The full DeployConfig.Check can only pass once the L1 contracts are deployed.
+func checkL1DeployConfig(config *DeployConfig) error {
+	if config.L2BlockTime == 0 {
+		return fmt.Errorf("%w: L2BlockTime cannot be 0", ErrInvalidDeployConfig)
+	}
+	if config.FinalizationPeriodSeconds == 0 {
+		return fmt.Errorf("%w: FinalizationPeriodSeconds cannot be 0", ErrInvalidDeployConfig)
+	}
+	if config.PortalGuardian == (common.Address{}) {
+		return fmt.Errorf("%w: PortalGuardian cannot be address(0)", ErrInvalidDeployConfig)
+	}
+	if config.P2PSequencerAddress == (common.Address{}) {
+		return fmt.Errorf("%w: P2PSequencerAddress cannot be address(0)", ErrInvalidDeployConfig)
+	}
+	if config.BatchSenderAddress == (common.Address{}) {
+		return fmt.Errorf("%w: BatchSenderAddress cannot be address(0)", ErrInvalidDeployConfig)
+	}
+	if config.L2OutputOracleSubmissionInterval == 0 {
+		return fmt.Errorf("%w: L2OutputOracleSubmissionInterval cannot be 0", ErrInvalidDeployConfig)
+	}
+	if config.L2OutputOracleStartingTimestamp <= 0 && config.L1StartingBlockTag == nil {
+		return fmt.Errorf("%w: L2OutputOracleStartingTimestamp requires a L1StartingBlockTag if not set", ErrInvalidDeployConfig)
+	}
+	if config.L2OutputOracleProposer == (common.Address{}) {
+		return fmt.Errorf("%w: L2OutputOracleProposer cannot be address(0)", ErrInvalidDeployConfig)
+	}
+	if config.L2OutputOracleChallenger == (common.Address{}) {
+		return fmt.Errorf("%w: L2OutputOracleChallenger cannot be address(0)", ErrInvalidDeployConfig)
+	}
+	if config.FinalSystemOwner == (common.Address{}) {
+		return fmt.Errorf("%w: FinalSystemOwner cannot be address(0)", ErrInvalidDeployConfig)
+	}
+	if config.GasPriceOracleScalar == 0 {
+		return fmt.Errorf("%w: GasPriceOracleScalar cannot be 0", ErrInvalidDeployConfig)
+	}
+	return nil
+}
+
+// Run deploys all L1 contracts that are not deployed yet and returns the deployments.
+func (d *L1Deployer) Run(ctx context.Context) (L1Deployments, error) {
+	deployments, err := LoadL1Deployments(d.deploymentsPath)
+	if err != nil {
+		return nil, err
+	}
+	d.deployments = deployments
+
+	if err := d.deploy(ctx, l1Constructor{name: "ProxyAdmin", args: []any{d.txMgr.From()}}); err != nil {
+		return nil, err
+	}
+	for _, proxy := range l1DeployerProxies {
+		if err := d.deploy(ctx, l1Constructor{name: proxy, args: []any{d.address("ProxyAdmin")}}); err != nil {
+			return nil, err
+		}
+	}
+	implementations, err := d.implementations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, implementation := range implementations {
+		if err := d.deploy(ctx, implementation); err != nil {
+			return nil, err
+		}
+	}
+	upgrades, err := d.upgrades(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, upgrade := range upgrades {
+		if err := d.upgrade(ctx, upgrade); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.transferProxyAdminOwnership(ctx); err != nil {
+		return nil, err
+	}
+	return d.deployments, nil
+}
+
+// address returns the address of a contract that is deployed in an earlier step
+func (d *L1Deployer) address(name string) common.Address {
+	return d.deployments[name].Address
+}
+
+func (d *L1Deployer) implementations(ctx context.Context) ([]l1Constructor, error) {
+	startingTimestamp, err := d.l2OutputOracleStartingTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []l1Constructor{
+		{
+			name: "SystemConfig",
+			args: d.systemConfigArgs(),
+		},
+		{
+			name: "L2OutputOracle",
+			args: []any{
+				uint642Big(d.config.L2OutputOracleSubmissionInterval),
+				uint642Big(d.config.L2BlockTime),
+				big.NewInt(0),
+				startingTimestamp,
+				d.config.L2OutputOracleProposer,
+				d.config.L2OutputOracleChallenger,
+				uint642Big(d.config.FinalizationPeriodSeconds),
+			},
+		},
+		{
+			// The implementation of the OptimismPortal is deployed
+			// as being paused to prevent invalid usage of the network
+			// as only the proxy should be used
+			name: "OptimismPortal",
+			args: []any{
+				d.address("L2OutputOracleProxy"),
+				d.config.PortalGuardian,
+				true, // _paused
+				d.address("SystemConfigProxy"),
+			},
+		},
+		{
+			name: "L1CrossDomainMessenger",
+			args: []any{d.address("OptimismPortalProxy")},
+		},
+		{
+			name: "L1StandardBridge",
+			args: []any{d.address("L1CrossDomainMessengerProxy")},
+		},
+		{
+			name: "OptimismMintableERC20Factory",
+			args: []any{d.address("L1StandardBridgeProxy")},
+		},
+		{
+			name: "L1ERC721Bridge",
+			args: []any{d.address("L1CrossDomainMessengerProxy"), predeploys.L2ERC721BridgeAddr},
+		},
+	}, nil
+}
+
+func (d *L1Deployer) l2GasLimit() uint64 {
+	if d.config.L2GenesisBlockGasLimit == 0 {
+		return defaultL2GasLimit
+	}
+	return uint64(d.config.L2GenesisBlockGasLimit)
+}
+
+func (d *L1Deployer) systemConfigArgs() []any {
+	return []any{
+		d.config.FinalSystemOwner,
+		uint642Big(d.config.GasPriceOracleOverhead),
+		uint642Big(d.config.GasPriceOracleScalar),
+		d.config.BatchSenderAddress.Hash(), // left-padded 32 bytes value, version is zero anyway
+		d.l2GasLimit(),
+		d.config.P2PSequencerAddress,
+		defaultResourceConfig,
+	}
+}
+
+// l2OutputOracleStartingTimestamp returns the configured starting timestamp of the L2OutputOracle,
+// or the timestamp of the L1 starting block if it is not set, as the L2 genesis is built on top of it.
+func (d *L1Deployer) l2OutputOracleStartingTimestamp(ctx context.Context) (*big.Int, error) {
+	if d.config.L2OutputOracleStartingTimestamp > 0 {
+		return big.NewInt(int64(d.config.L2OutputOracleStartingTimestamp)), nil
+	}
+	var header *types.Header
+	var err error
+	tag := (*rpc.BlockNumberOrHash)(d.config.L1StartingBlockTag)
+	if hash, ok := tag.Hash(); ok {
+		header, err = d.l1.HeaderByHash(ctx, hash)
+	} else if num, ok := tag.Number(); ok {
+		header, err = d.l1.HeaderByNumber(ctx, big.NewInt(num.Int64()))
+	} else {
+		return nil, fmt.Errorf("invalid L1 starting block tag: %v", tag)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 starting block %v: %w", tag, err)
+	}
+	return new(big.Int).SetUint64(header.Time), nil
+}
+
+func (d *L1Deployer) upgrades(ctx context.Context) ([]l1Upgrade, error) {
+	startingTimestamp, err := d.l2OutputOracleStartingTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	systemConfigABI, err := bindings.SystemConfigMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	systemConfigData, err := systemConfigABI.Pack("initialize", d.systemConfigArgs()...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot abi encode initialize for SystemConfig: %w", err)
+	}
+	l2OutputOracleABI, err := bindings.L2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	l2OutputOracleData, err := l2OutputOracleABI.Pack("initialize", big.NewInt(0), startingTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("cannot abi encode initialize for L2OutputOracle: %w", err)
+	}
+	optimismPortalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	optimismPortalData, err := optimismPortalABI.Pack("initialize", false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot abi encode initialize for OptimismPortal: %w", err)
+	}
+	l1CrossDomainMessengerABI, err := bindings.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	l1CrossDomainMessengerData, err := l1CrossDomainMessengerABI.Pack("initialize")
+	if err != nil {
+		return nil, fmt.Errorf("cannot abi encode initialize for L1CrossDomainMessenger: %w", err)
+	}
+	return []l1Upgrade{
+		{
+			proxy:          "SystemConfigProxy",
+			implementation: "SystemConfig",
+			initialize:     systemConfigData,
+			storage: map[string]any{
+				"_initialized": 1,
+				"_owner":       d.config.FinalSystemOwner,
+				"overhead":     d.config.GasPriceOracleOverhead,
+				"scalar":       d.config.GasPriceOracleScalar,
+				"batcherHash":  d.config.BatchSenderAddress.Hash(),
+				"gasLimit":     d.l2GasLimit(),
+			},
+		},
+		{
+			proxy:          "L2OutputOracleProxy",
+			implementation: "L2OutputOracle",
+			initialize:     l2OutputOracleData,
+			storage: map[string]any{
+				"_initialized":        1,
+				"startingBlockNumber": 0,
+				"startingTimestamp":   startingTimestamp,
+			},
+		},
+		{
+			proxy:          "OptimismPortalProxy",
+			implementation: "OptimismPortal",
+			initialize:     optimismPortalData,
+			storage: map[string]any{
+				"_initialized": 1,
+				"paused":       false,
+			},
+		},
+		{
+			proxy:          "L1CrossDomainMessengerProxy",
+			implementation: "L1CrossDomainMessenger",
+			initialize:     l1CrossDomainMessengerData,
+			storage: map[string]any{
+				"_initialized": 1,
+			},
+		},
+		{
+			proxy:          "L1StandardBridgeProxy",
+			implementation: "L1StandardBridge",
+		},
+		{
+			proxy:          "OptimismMintableERC20FactoryProxy",
+			implementation: "OptimismMintableERC20Factory",
+		},
+		{
+			proxy:          "L1ERC721BridgeProxy",
+			implementation: "L1ERC721Bridge",
+		},
+	}, nil
+}
+
+// deploy deploys a contract, unless it is deployed already. The code of the contract
+// is checked against the code that results from executing its creation code.
+func (d *L1Deployer) deploy(ctx context.Context, constructor l1Constructor) error {
+	meta, err := l1ContractMetaData(constructor.name)
+	if err != nil {
+		return err
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return err
+	}
+	input, err := parsed.Pack("", constructor.args...)
+	if err != nil {
+		return fmt.Errorf("cannot abi encode constructor arguments of %s: %w", constructor.name, err)
+	}
+	data := append(common.FromHex(meta.Bin), input...)
+
+	// Calling the creation code returns the code that is expected to be deployed,
+	// including the immutables that are set by the constructor.
+	code, err := d.l1.CallContract(ctx, ethereum.CallMsg{From: d.txMgr.From(), Data: data}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to simulate deployment of %s: %w", constructor.name, err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("deployment of %s results in empty code", constructor.name)
+	}
+
+	if deployment, ok := d.deployments[constructor.name]; ok {
+		done, err := d.resumeDeployment(ctx, constructor.name, deployment, code)
+		if done || err != nil {
+			return err
+		}
+	}
+
+	// The deployment is saved as pending before it is sent, with the CREATE address of the next nonce.
+	// If the deployment is interrupted before the receipt is seen, the contract can be found there on resume.
+	// The tx manager uses the latest nonce of the deployer too, as every tx is confirmed before the next one.
+	nonce, err := d.l1.NonceAt(ctx, d.txMgr.From(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch nonce of the deployer: %w", err)
+	}
+	pending := &L1Deployment{
+		Address: crypto.CreateAddress(d.txMgr.From(), nonce),
+		Pending: true,
+		Nonce:   nonce,
+	}
+	d.deployments[constructor.name] = pending
+	if err := d.deployments.Save(d.deploymentsPath); err != nil {
+		return err
+	}
+
+	receipt, err := d.txMgr.Send(ctx, txmgr.TxCandidate{TxData: data})
+	if err != nil {
+		return fmt.Errorf("failed to deploy %s: %w", constructor.name, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("deployment of %s in tx %s failed", constructor.name, receipt.TxHash)
+	}
+	if receipt.ContractAddress != pending.Address {
+		d.log.Warn("Contract is deployed at another address than predicted", "name", constructor.name,
+			"address", receipt.ContractAddress, "predicted", pending.Address, "nonce", nonce)
+	}
+	d.deployments[constructor.name] = &L1Deployment{
+		Address:         receipt.ContractAddress,
+		TransactionHash: receipt.TxHash,
+	}
+	if err := d.deployments.Save(d.deploymentsPath); err != nil {
+		return err
+	}
+	if err := d.checkCode(ctx, constructor.name, receipt.ContractAddress, code); err != nil {
+		return err
+	}
+	d.log.Info("Deployed contract", "name", constructor.name, "address", receipt.ContractAddress, "tx", receipt.TxHash)
+	return nil
+}
+
+// resumeDeployment checks a deployment that was saved by a previous run, and returns whether it is done.
+// A pending deployment is done if the contract is found at its predicted address, else it is deployed again.
+func (d *L1Deployer) resumeDeployment(ctx context.Context, name string, deployment *L1Deployment, code []byte) (bool, error) {
+	if !deployment.Pending {
+		if err := d.checkCode(ctx, name, deployment.Address, code); err != nil {
+			return false, err
+		}
+		d.log.Info("Contract is already deployed", "name", name, "address", deployment.Address)
+		return true, nil
+	}
+	deployed, err := d.l1.CodeAt(ctx, deployment.Address, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch code of pending deployment of %s: %w", name, err)
+	}
+	if len(deployed) == 0 {
+		d.log.Warn("Pending deployment was not confirmed, deploying again", "name", name, "address", deployment.Address, "nonce", deployment.Nonce)
+		return false, nil
+	}
+	if err := d.checkCode(ctx, name, deployment.Address, code); err != nil {
+		return false, err
+	}
+	// The tx hash of the deployment is unknown, as its receipt was never seen
+	d.deployments[name] = &L1Deployment{Address: deployment.Address}
+	if err := d.deployments.Save(d.deploymentsPath); err != nil {
+		return false, err
+	}
+	d.log.Info("Pending deployment was confirmed", "name", name, "address", deployment.Address, "nonce", deployment.Nonce)
+	return true, nil
+}
+
+func (d *L1Deployer) checkCode(ctx context.Context, name string, addr common.Address, expected []byte) error {
+	code, err := d.l1.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch code of %s: %w", name, err)
+	}
+	if !bytes.Equal(code, expected) {
+		return fmt.Errorf("code of %s at %s does not match the expected code", name, addr)
+	}
+	return nil
+}
+
+// upgrade points a proxy to its implementation and initializes it, unless it is upgraded already.
+// The proxy storage is checked after the upgrade.
+func (d *L1Deployer) upgrade(ctx context.Context, upgrade l1Upgrade) error {
+	proxyAddr := d.address(upgrade.proxy)
+	implAddr := d.address(upgrade.implementation)
+
+	current, err := d.l1.StorageAt(ctx, proxyAddr, ImplementationSlot, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch implementation of %s: %w", upgrade.proxy, err)
+	}
+	switch common.BytesToAddress(current) {
+	case implAddr:
+		d.log.Info("Proxy is already upgraded", "proxy", upgrade.proxy, "implementation", implAddr)
+	case common.Address{}:
+		if err := d.checkProxyAdminOwner(ctx); err != nil {
+			return fmt.Errorf("cannot upgrade %s: %w", upgrade.proxy, err)
+		}
+		proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+		if err != nil {
+			return err
+		}
+		var data []byte
+		if upgrade.initialize == nil {
+			data, err = proxyAdminABI.Pack("upgrade", proxyAddr, implAddr)
+		} else {
+			data, err = proxyAdminABI.Pack("upgradeAndCall", proxyAddr, implAddr, upgrade.initialize)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot abi encode upgrade of %s: %w", upgrade.proxy, err)
+		}
+		if err := d.send(ctx, d.address("ProxyAdmin"), data); err != nil {
+			return fmt.Errorf("failed to upgrade %s: %w", upgrade.proxy, err)
+		}
+		d.log.Info("Upgraded proxy", "proxy", upgrade.proxy, "implementation", implAddr)
+	default:
+		return fmt.Errorf("%s points to unexpected implementation %s", upgrade.proxy, common.BytesToAddress(current))
+	}
+	return d.checkProxy(ctx, upgrade)
+}
+
+func (d *L1Deployer) checkProxy(ctx context.Context, upgrade l1Upgrade) error {
+	proxyAddr := d.address(upgrade.proxy)
+	reader := bindings.NewRPCStorageReader(d.l1, proxyAddr, nil)
+
+	impl, err := reader.StorageAt(ctx, ImplementationSlot)
+	if err != nil {
+		return fmt.Errorf("failed to fetch implementation of %s: %w", upgrade.proxy, err)
+	}
+	if implAddr := d.address(upgrade.implementation); common.BytesToAddress(impl[:]) != implAddr {
+		return fmt.Errorf("%s points to implementation %s, expected %s", upgrade.proxy, common.BytesToAddress(impl[:]), implAddr)
+	}
+	admin, err := reader.StorageAt(ctx, AdminSlot)
+	if err != nil {
+		return fmt.Errorf("failed to fetch admin of %s: %w", upgrade.proxy, err)
+	}
+	if adminAddr := d.address("ProxyAdmin"); common.BytesToAddress(admin[:]) != adminAddr {
+		return fmt.Errorf("%s is administered by %s, expected %s", upgrade.proxy, common.BytesToAddress(admin[:]), adminAddr)
+	}
+	if len(upgrade.storage) == 0 {
+		return nil
+	}
+
+	labels := make([]string, 0, len(upgrade.storage))
+	for label := range upgrade.storage {
+		labels = append(labels, label)
+	}
+	view, err := decodeStorageVariables(ctx, upgrade.implementation, reader, labels)
+	if err != nil {
+		return fmt.Errorf("failed to decode storage of %s: %w", upgrade.proxy, err)
+	}
+	for label, expected := range upgrade.storage {
+		value, ok := view[label]
+		if !ok {
+			return fmt.Errorf("%s has no storage variable %s", upgrade.proxy, label)
+		}
+		// The decoded values are compared by their JSON encoding,
+		// as the decoded types differ from the configured ones.
+		want, err := json.Marshal(expected)
+		if err != nil {
+			return err
+		}
+		got, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(want, got) {
+			return fmt.Errorf("%s has %s set to %s, expected %s", upgrade.proxy, label, got, want)
+		}
+	}
+	return nil
+}
+
+// decodeStorageVariables decodes the storage variables with the given labels, using the storage layout
+// of the named contract. Only these variables are decoded, which saves reading all storage of the contract.
+func decodeStorageVariables(ctx context.Context, name string, reader bindings.StorageReader, labels []string) (state.StorageView, error) {
+	layout, err := bindings.GetStorageLayout(name)
+	if err != nil {
+		return nil, err
+	}
+	filtered := &solc.StorageLayout{Types: layout.Types}
+	for _, entry := range layout.Storage {
+		for _, label := range labels {
+			if entry.Label == label {
+				filtered.Storage = append(filtered.Storage, entry)
+				break
+			}
+		}
+	}
+	return state.DecodeStorage(ctx, filtered, reader, nil)
+}
+
+// checkProxyAdminOwner checks that the ProxyAdmin can still be used by the deployer
+func (d *L1Deployer) checkProxyAdminOwner(ctx context.Context) error {
+	owner, err := d.proxyAdminOwner(ctx)
+	if err != nil {
+		return err
+	}
+	if owner != d.txMgr.From() {
+		return fmt.Errorf("ProxyAdmin is owned by %s, not the deployer %s", owner, d.txMgr.From())
+	}
+	return nil
+}
+
+func (d *L1Deployer) proxyAdminOwner(ctx context.Context) (common.Address, error) {
+	proxyAdmin, err := bindings.NewProxyAdminCaller(d.address("ProxyAdmin"), d.l1)
+	if err != nil {
+		return common.Address{}, err
+	}
+	owner, err := proxyAdmin.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch ProxyAdmin owner: %w", err)
+	}
+	return owner, nil
+}
+
+// transferProxyAdminOwnership hands the ProxyAdmin over to the FinalSystemOwner once all proxies are set up
+func (d *L1Deployer) transferProxyAdminOwnership(ctx context.Context) error {
+	owner, err := d.proxyAdminOwner(ctx)
+	if err != nil {
+		return err
+	}
+	if owner == d.config.FinalSystemOwner {
+		d.log.Info("ProxyAdmin is already owned by the final system owner", "owner", owner)
+		return nil
+	}
+	if err := d.checkProxyAdminOwner(ctx); err != nil {
+		return fmt.Errorf("cannot transfer ProxyAdmin ownership: %w", err)
+	}
+	proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	data, err := proxyAdminABI.Pack("transferOwnership", d.config.FinalSystemOwner)
+	if err != nil {
+		return fmt.Errorf("cannot abi encode ProxyAdmin ownership transfer: %w", err)
+	}
+	if err := d.send(ctx, d.address("ProxyAdmin"), data); err != nil {
+		return fmt.Errorf("failed to transfer ProxyAdmin ownership: %w", err)
+	}
+	if owner, err := d.proxyAdminOwner(ctx); err != nil {
+		return err
+	} else if owner != d.config.FinalSystemOwner {
+		return fmt.Errorf("ProxyAdmin is owned by %s after the ownership transfer, expected %s", owner, d.config.FinalSystemOwner)
+	}
+	d.log.Info("Transferred ProxyAdmin ownership", "owner", d.config.FinalSystemOwner)
+	return nil
+}
+
+// send calls a contract and waits for the tx to be confirmed. Callers check the on-chain state before
+// sending, so a call that was confirmed in an interrupted run is not sent again on resume. A call that
+// was not confirmed is sent again with the same nonce, which replaces the tx of the interrupted run.
+func (d *L1Deployer) send(ctx context.Context, to common.Address, data []byte) error {
+	receipt, err := d.txMgr.Send(ctx, txmgr.TxCandidate{TxData: data, To: &to})
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("tx %s failed", receipt.TxHash)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package genesis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+)
+
+// L1Deployment is a contract deployed to L1 by the L1Deployer
+type L1Deployment struct {
+	Address         common.Address `json:"address"`
+	TransactionHash common.Hash    `json:"transactionHash"`
+	// Pending is set while the deployment tx is not confirmed yet. The address is then
+	// the predicted CREATE address of the deployment tx with the given nonce.
+	Pending bool   `json:"pending,omitempty"`
+	Nonce   uint64 `json:"nonce,omitempty"`
+}
+
+// L1Deployments are the contracts deployed to L1 by the L1Deployer, by name.
+// They are persisted after every deployment, so that an interrupted deployment
+// can be resumed where it stopped.
+type L1Deployments map[string]*L1Deployment
+
+// LoadL1Deployments reads the deployments from the given path.
+// Empty deployments are returned if there is no deployments file yet.
+func LoadL1Deployments(path string) (L1Deployments, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return make(L1Deployments), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read deployments: %w", err)
+	}
+	var deployments L1Deployments
+	if err := json.Unmarshal(data, &deployments); err != nil {
+		return nil, fmt.Errorf("failed to decode deployments: %w", err)
+	}
+	if deployments == nil {
+		deployments = make(L1Deployments)
+	}
+	return deployments, nil
+}
+
+// NewL1DeploymentsFromHardhat reads the L1 deployments from hardhat-deploy artifacts.
+// The ProxyAdmin and all proxies are required, the implementations are optional.
+func NewL1DeploymentsFromHardhat(hh *hardhat.Hardhat) (L1Deployments, error) {
+	deployments := make(L1Deployments)
+	for name := range l1Contracts {
+		if name == "Proxy" {
+			continue
+		}
+		deployment, err := hh.GetDeployment(name)
+		if errors.Is(err, hardhat.ErrCannotFindDeployment) && name != "ProxyAdmin" {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		deployments[name] = &L1Deployment{Address: deployment.Address, TransactionHash: deployment.TransactionHash}
+	}
+	for _, name := range l1DeployerProxies {
+		deployment, err := hh.GetDeployment(name)
+		if err != nil {
+			return nil, err
+		}
+		deployments[name] = &L1Deployment{Address: deployment.Address, TransactionHash: deployment.TransactionHash}
+	}
+	return deployments, nil
+}
+
+// Save writes the deployments to the given path. The file is replaced atomically,
+// so an interruption never leaves a partially written deployments file behind.
+func (d L1Deployments) Save(path string) error {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode deployments: %w", err)
+	}
+	return writeFileAtomic(path, data)
+}
+
+// WriteHardhatDeployments writes the deployments in the hardhat-deploy format
+// to the given directory, so that it can be used as the deployment directory
+// of the L2 genesis generation.
+func (d L1Deployments) WriteHardhatDeployments(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create deployment directory: %w", err)
+	}
+	for name, deployment := range d {
+		meta, err := l1ContractMetaData(name)
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(map[string]any{
+			"address":         deployment.Address,
+			"abi":             json.RawMessage(meta.ABI),
+			"transactionHash": deployment.TransactionHash,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode deployment of %s: %w", name, err)
+		}
+		if err := writeFileAtomic(filepath.Join(dir, name+".json"), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply sets the L1 proxy addresses of the deploy config that are required
+// for the L2 genesis creation.
+func (d L1Deployments) Apply(config *DeployConfig) error {
+	for name, addr := range map[string]*common.Address{
+		"L1StandardBridgeProxy":       &config.L1StandardBridgeProxy,
+		"L1CrossDomainMessengerProxy": &config.L1CrossDomainMessengerProxy,
+		"L1ERC721BridgeProxy":         &config.L1ERC721BridgeProxy,
+		"SystemConfigProxy":           &config.SystemConfigProxy,
+		"OptimismPortalProxy":         &config.OptimismPortalProxy,
+	} {
+		deployment, ok := d[name]
+		if !ok {
+			return fmt.Errorf("missing deployment of %s", name)
+		}
+		*addr = deployment.Address
+	}
+	return nil
+}
+
+// l1ContractMetaData returns the bindings metadata of a contract deployed by the L1Deployer.
+// All proxies share the metadata of the Proxy contract.
+func l1ContractMetaData(name string) (*bind.MetaData, error) {
+	if meta, ok := l1Contracts[name]; ok {
+		return meta, nil
+	}
+	for _, proxy := range l1DeployerProxies {
+		if proxy == name {
+			return l1Contracts["Proxy"], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown L1 contract %s", name)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
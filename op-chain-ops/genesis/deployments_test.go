@@ -0,0 +1,52 @@
+package genesis
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+)
+
+func TestL1Deployments(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "deployments.json")
+
+	deployments, err := LoadL1Deployments(path)
+	require.NoError(t, err)
+	require.Empty(t, deployments)
+
+	deployments["ProxyAdmin"] = &L1Deployment{Address: common.Address{0x01}, TransactionHash: common.Hash{0x01}}
+	for i, name := range l1DeployerProxies {
+		deployments[name] = &L1Deployment{Address: common.Address{0x02, byte(i)}, TransactionHash: common.Hash{0x02, byte(i)}}
+	}
+	require.NoError(t, deployments.Save(path))
+	loaded, err := LoadL1Deployments(path)
+	require.NoError(t, err)
+	require.Equal(t, deployments, loaded)
+
+	var config DeployConfig
+	require.NoError(t, deployments.Apply(&config))
+	require.Equal(t, deployments["SystemConfigProxy"].Address, config.SystemConfigProxy)
+	require.Equal(t, deployments["OptimismPortalProxy"].Address, config.OptimismPortalProxy)
+
+	require.NoError(t, deployments.WriteHardhatDeployments(filepath.Join(dir, "devnet")))
+	hh, err := hardhat.New("devnet", nil, []string{dir})
+	require.NoError(t, err)
+	var fromHardhat DeployConfig
+	require.NoError(t, fromHardhat.GetDeployedAddresses(hh))
+	require.Equal(t, config, fromHardhat)
+	hardhatDeployments, err := NewL1DeploymentsFromHardhat(hh)
+	require.NoError(t, err)
+	require.Equal(t, deployments, hardhatDeployments)
+
+	delete(deployments, "L1ERC721BridgeProxy")
+	require.ErrorContains(t, deployments.Apply(&config), "L1ERC721BridgeProxy")
+}
+
+func TestL1DeploymentsUnknownContract(t *testing.T) {
+	deployments := L1Deployments{"Unknown": &L1Deployment{}}
+	require.ErrorContains(t, deployments.WriteHardhatDeployments(t.TempDir()), "unknown L1 contract")
+}
@@ -0,0 +1,310 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+const (
+	// maxDecodeArrayLength is the longest dynamic array that is decoded,
+	// to not read an unbounded number of slots from corrupted storage.
+	maxDecodeArrayLength = 1 << 16
+	// maxDecodeBytesLength is the longest string or bytes value that is decoded
+	maxDecodeBytesLength = 1 << 20
+)
+
+// StorageView is a decoded view of the storage of a contract, by the label of
+// each storage variable. When a label is used by multiple storage variables,
+// the later variables are labeled as label@slot.
+//
+// The values are JSON serialisable, and can be encoded again with EncodeStorage:
+//   - bool as bool
+//   - address and contract types as common.Address
+//   - signed and unsigned integers and enums as *big.Int
+//   - fixed size bytes, bytes and user defined value types as hexutil.Bytes
+//   - string as string
+//   - arrays as []any
+//   - structs as map[string]any of the member labels
+//   - mappings as map[string]any, of the keys that were asked for
+type StorageView map[string]any
+
+// MappingKeys are the keys to read mappings at, by the label of the mapping variable,
+// as storage can not be iterated. A key of a nested mapping is a []any with a key for
+// every level of nesting. Keys use the same types as the values for EncodeStorage.
+type MappingKeys map[string][]any
+
+// DecodeStorage reads the storage variables of a contract with the given storage layout.
+func DecodeStorage(ctx context.Context, layout *solc.StorageLayout, reader bindings.StorageReader, keys MappingKeys) (StorageView, error) {
+	d := &decoder{
+		types:  layout.Types,
+		reader: reader,
+		cache:  make(map[common.Hash]common.Hash),
+	}
+	view := make(StorageView)
+	for _, entry := range layout.Storage {
+		slot := common.BigToHash(new(big.Int).SetUint64(uint64(entry.Slot)))
+		val, err := d.decode(ctx, entry.Type, slot, entry.Offset, keys[entry.Label])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s: %w", entry.Label, err)
+		}
+		label := entry.Label
+		if _, ok := view[label]; ok {
+			label = fmt.Sprintf("%s@%d", entry.Label, entry.Slot)
+		}
+		view[label] = val
+	}
+	return view, nil
+}
+
+// GetStorage reads the storage values of a contract in a db given a contract name and address.
+// It is the reverse of SetStorage.
+func GetStorage(ctx context.Context, name string, address common.Address, db vm.StateDB, keys MappingKeys) (StorageView, error) {
+	layout, err := bindings.GetStorageLayout(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get storage: %w", err)
+	}
+	view, err := DecodeStorage(ctx, layout, bindings.NewStateDBStorageReader(db, address), keys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return view, nil
+}
+
+type decoder struct {
+	types  map[string]solc.StorageLayoutType
+	reader bindings.StorageReader
+	// cache holds the slots that were read, as tightly packed variables share slots
+	cache map[common.Hash]common.Hash
+}
+
+func (d *decoder) slot(ctx context.Context, key common.Hash) (common.Hash, error) {
+	if val, ok := d.cache[key]; ok {
+		return val, nil
+	}
+	val, err := d.reader.StorageAt(ctx, key)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	d.cache[key] = val
+	return val, nil
+}
+
+// decode decodes a value of the given type, that starts at the given slot and offset.
+// The keys are used to read mappings, and nested mappings.
+func (d *decoder) decode(ctx context.Context, typeName string, slot common.Hash, offset uint, keys []any) (any, error) {
+	storageType, ok := d.types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("storage type %s not found", typeName)
+	}
+
+	switch storageType.Encoding {
+	case "inplace":
+		if isStruct(storageType) {
+			if len(storageType.Members) == 0 {
+				return nil, fmt.Errorf("storage layout has no struct members of %s", storageType.Label)
+			}
+			out := make(map[string]any, len(storageType.Members))
+			for _, member := range storageType.Members {
+				val, err := d.decode(ctx, member.Type, addSlot(slot, uint64(member.Slot)), member.Offset, nil)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", storageType.Label, member.Label, err)
+				}
+				out[member.Label] = val
+			}
+			return out, nil
+		}
+		if storageType.Base != "" {
+			length, err := staticArrayLength(storageType)
+			if err != nil {
+				return nil, err
+			}
+			return d.decodeArrayElements(ctx, storageType.Base, slot, length)
+		}
+		if offset+storageType.NumberOfBytes > 32 || storageType.NumberOfBytes == 0 {
+			return nil, fmt.Errorf("invalid offset %d of %s", offset, storageType.Label)
+		}
+		word, err := d.slot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		return decodeInplaceValue(storageType, word[32-offset-storageType.NumberOfBytes:32-offset]), nil
+	case "bytes":
+		data, err := d.decodeBytes(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", storageType.Label, err)
+		}
+		if storageType.Label == "string" {
+			return string(data), nil
+		}
+		return hexutil.Bytes(data), nil
+	case "dynamic_array":
+		word, err := d.slot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		length := word.Big()
+		if !length.IsUint64() || length.Uint64() > maxDecodeArrayLength {
+			return nil, fmt.Errorf("length %d of %s is too large", length, storageType.Label)
+		}
+		return d.decodeArrayElements(ctx, storageType.Base, crypto.Keccak256Hash(slot[:]), length.Uint64())
+	case "mapping":
+		return d.decodeMapping(ctx, storageType, slot, keys)
+	default:
+		return nil, fmt.Errorf("unknown encoding %s of %s", storageType.Encoding, storageType.Label)
+	}
+}
+
+func (d *decoder) decodeArrayElements(ctx context.Context, baseName string, start common.Hash, length uint64) ([]any, error) {
+	base, ok := d.types[baseName]
+	if !ok {
+		return nil, fmt.Errorf("storage type %s not found", baseName)
+	}
+	out := make([]any, 0, length)
+	for i := uint64(0); i < length; i++ {
+		slot, offset := arrayElementSlot(base, start, i)
+		val, err := d.decode(ctx, baseName, slot, offset, nil)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		out = append(out, val)
+	}
+	return out, nil
+}
+
+func (d *decoder) decodeBytes(ctx context.Context, slot common.Hash) ([]byte, error) {
+	word, err := d.slot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	// short values have an even length marker in the last byte
+	if word[31]&1 == 0 {
+		length := word[31] / 2
+		if length > 31 {
+			return nil, fmt.Errorf("invalid short length %d", length)
+		}
+		return common.CopyBytes(word[:length]), nil
+	}
+	length := new(big.Int).Rsh(word.Big(), 1)
+	if !length.IsUint64() || length.Uint64() > maxDecodeBytesLength {
+		return nil, fmt.Errorf("length %d is too large", length)
+	}
+	if length.Uint64() < 32 {
+		return nil, fmt.Errorf("invalid long length %d", length)
+	}
+	data := make([]byte, 0, length.Uint64()+31)
+	start := crypto.Keccak256Hash(slot[:])
+	for i := uint64(0); uint64(len(data)) < length.Uint64(); i++ {
+		chunk, err := d.slot(ctx, addSlot(start, i))
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:]...)
+	}
+	return data[:length.Uint64()], nil
+}
+
+// decodeMapping reads the values of a mapping at the given keys. The keys of the view are
+// the keys formatted like the decoded values, e.g. checksummed addresses and decimal integers.
+func (d *decoder) decodeMapping(ctx context.Context, storageType solc.StorageLayoutType, slot common.Hash, keys []any) (map[string]any, error) {
+	keyType, ok := d.types[storageType.Key]
+	if !ok {
+		return nil, fmt.Errorf("storage type %s not found", storageType.Key)
+	}
+
+	// Group the keys of nested mappings by their first key
+	var order []string
+	firstKeys := make(map[string]any)
+	nested := make(map[string][]any)
+	for _, key := range keys {
+		first, rest := key, []any(nil)
+		if path, ok := key.([]any); ok {
+			if len(path) == 0 {
+				return nil, errors.New("empty mapping key path")
+			}
+			first = path[0]
+			if len(path) == 2 {
+				rest = []any{path[1]}
+			} else if len(path) > 2 {
+				rest = []any{path[1:]}
+			}
+		}
+		formatted, err := formatMappingKey(keyType, first)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %v of %s: %w", first, storageType.Label, err)
+		}
+		if _, ok := firstKeys[formatted]; !ok {
+			order = append(order, formatted)
+			firstKeys[formatted] = first
+		}
+		nested[formatted] = append(nested[formatted], rest...)
+	}
+
+	out := make(map[string]any, len(order))
+	for _, formatted := range order {
+		valueSlot, err := encodeMappingSlot(d.types, storageType.Key, firstKeys[formatted], slot)
+		if err != nil {
+			return nil, err
+		}
+		val, err := d.decode(ctx, storageType.Value, valueSlot, 0, nested[formatted])
+		if err != nil {
+			return nil, fmt.Errorf("%s[%s]: %w", storageType.Label, formatted, err)
+		}
+		out[formatted] = val
+	}
+	return out, nil
+}
+
+// formatMappingKey formats a mapping key like the decoded value of the key type,
+// so that the same key given in different forms results in the same view.
+func formatMappingKey(keyType solc.StorageLayoutType, key any) (string, error) {
+	if keyType.Encoding == "bytes" {
+		data, err := bytesValue(keyType, key)
+		if err != nil {
+			return "", err
+		}
+		if keyType.Label == "string" {
+			return string(data), nil
+		}
+		return hexutil.Encode(data), nil
+	}
+	val, err := encodeInplaceValue(keyType, key)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(decodeInplaceValue(keyType, val[32-keyType.NumberOfBytes:])), nil
+}
+
+// decodeInplaceValue decodes a value type from its bytes in a storage slot
+func decodeInplaceValue(storageType solc.StorageLayoutType, data []byte) any {
+	label := storageType.Label
+	switch {
+	case label == "bool":
+		return data[len(data)-1] != 0
+	case label == "address" || label == "address payable" || strings.HasPrefix(label, "contract "):
+		return common.BytesToAddress(data)
+	case fixedBytesRe.MatchString(label):
+		return hexutil.Bytes(common.CopyBytes(data))
+	case intRe.MatchString(label):
+		number := new(big.Int).SetBytes(data)
+		if len(data) > 0 && data[0]&0x80 != 0 {
+			number.Sub(number, new(big.Int).Lsh(common.Big1, uint(len(data))*8))
+		}
+		return number
+	case strings.HasPrefix(label, "uint") || strings.HasPrefix(label, "enum "):
+		return new(big.Int).SetBytes(data)
+	default:
+		// user defined value types, the underlying type is not part of the layout
+		return hexutil.Bytes(common.CopyBytes(data))
+	}
+}
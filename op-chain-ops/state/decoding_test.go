@@ -0,0 +1,314 @@
+package state_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/state"
+)
+
+// complexLayout is the storage layout of the following contract:
+//
+//	contract Layout {
+//	    enum Status { None, Active, Closed }
+//	    struct Point { uint64 x; uint64 y; address owner; bytes32 tag; }
+//	    int16 small;
+//	    bytes4 selector;
+//	    Status status;
+//	    string longString;
+//	    bytes data;
+//	    uint128[] nums;
+//	    Point point;
+//	    Point[] points;
+//	    uint8[3] fixedSmall;
+//	    mapping(address => mapping(uint256 => bool)) nested;
+//	    mapping(string => Point) byName;
+//	    mapping(int256 => uint256) signed;
+//	}
+func complexLayout(t *testing.T) *solc.StorageLayout {
+	data, err := os.ReadFile("./testdata/complex-layout.json")
+	require.NoError(t, err)
+	var layout solc.StorageLayout
+	require.NoError(t, json.Unmarshal(data, &layout))
+	return &layout
+}
+
+func slotKey(n uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(n))
+}
+
+func addSlot(slot common.Hash, n uint64) common.Hash {
+	return common.BigToHash(new(big.Int).Add(slot.Big(), new(big.Int).SetUint64(n)))
+}
+
+func TestEncodeComplexStorage(t *testing.T) {
+	layout := complexLayout(t)
+	owner := common.HexToAddress("0x829BD824B016326A401d083B33D092293333A830")
+	longString := strings.Repeat("optimism", 5)
+
+	values := state.StorageValues{
+		"small":      -2,
+		"selector":   "0xdeadbeef",
+		"status":     uint8(2),
+		"longString": longString,
+		"data":       hexutil.Bytes{0x01, 0x02},
+		"nums":       []any{1, 2, 3},
+		"point": map[string]any{
+			"x":     uint64(1),
+			"y":     uint64(2),
+			"owner": owner,
+			"tag":   common.Hash{0xff},
+		},
+		"fixedSmall": []uint8{1, 2, 3},
+		"nested": map[any]any{
+			owner: map[any]any{7: true},
+		},
+		"signed": map[string]any{"-1": 5},
+	}
+	slots, err := state.ComputeStorageSlots(layout, values)
+	require.NoError(t, err)
+	got := make(map[common.Hash]common.Hash)
+	for _, slot := range slots {
+		got[slot.Key] = slot.Value
+	}
+
+	expect := make(map[common.Hash]common.Hash)
+	// small, selector and status are packed into slot 0
+	expect[slotKey(0)] = common.HexToHash("0x02deadbeeffffe")
+	// long strings store 2 * length + 1, and the data from the hash of the slot
+	expect[slotKey(1)] = slotKey(81)
+	dataStart := crypto.Keccak256Hash(slotKey(1).Bytes())
+	expect[dataStart] = common.BytesToHash([]byte(longString[:32]))
+	expect[addSlot(dataStart, 1)] = common.BytesToHash(common.RightPadBytes([]byte(longString[32:]), 32))
+	// short bytes are stored in place, with 2 * length
+	expect[slotKey(2)] = common.Hash{0: 0x01, 1: 0x02, 31: 4}
+	// dynamic arrays store the length, and two uint128 per slot
+	expect[slotKey(3)] = slotKey(3)
+	numsStart := crypto.Keccak256Hash(slotKey(3).Bytes())
+	expect[numsStart] = common.BigToHash(new(big.Int).Or(new(big.Int).Lsh(big.NewInt(2), 128), big.NewInt(1)))
+	expect[addSlot(numsStart, 1)] = slotKey(3)
+	// struct members are relative to the slot of the struct
+	expect[slotKey(4)] = common.BigToHash(new(big.Int).Or(new(big.Int).Lsh(big.NewInt(2), 64), big.NewInt(1)))
+	expect[slotKey(5)] = owner.Hash()
+	expect[slotKey(6)] = common.Hash{0xff}
+	// small static array elements are packed
+	expect[slotKey(8)] = common.HexToHash("0x030201")
+	// nested mappings hash the key with the slot of the outer mapping value
+	outer := crypto.Keccak256Hash(owner.Hash().Bytes(), slotKey(9).Bytes())
+	expect[crypto.Keccak256Hash(slotKey(7).Bytes(), outer.Bytes())] = slotKey(1)
+	// negative signed keys are sign extended
+	minusOne := common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	expect[crypto.Keccak256Hash(minusOne.Bytes(), slotKey(11).Bytes())] = slotKey(5)
+
+	require.Equal(t, expect, got)
+}
+
+func TestEncodeStorageErrors(t *testing.T) {
+	layout := complexLayout(t)
+	cases := map[string]state.StorageValues{
+		"int out of range":       {"small": 1 << 15},
+		"bytes4 too long":        {"selector": "0xdeadbeef00"},
+		"enum out of range":      {"status": 256},
+		"too many elements":      {"fixedSmall": []any{1, 2, 3, 4}},
+		"unknown member":         {"point": map[string]any{"z": 1}},
+		"struct not a map":       {"point": 1},
+		"array not a slice":      {"nums": 1},
+		"string not a string":    {"longString": 1},
+		"mapping not a map":      {"nested": 1},
+		"invalid mapping key":    {"byName": map[any]any{1: map[string]any{}}},
+		"unknown variable":       {"unknown": 1},
+		"nil value":              {"small": nil},
+		"invalid address string": {"point": map[string]any{"owner": 1}},
+	}
+	for name, values := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := state.ComputeStorageSlots(layout, values)
+			require.Error(t, err)
+		})
+	}
+}
+
+// requireViewEqual compares views by their JSON encoding, as equal big integers
+// are not always deeply equal.
+func requireViewEqual(t *testing.T, expect, got state.StorageView) {
+	expectJSON, err := json.Marshal(expect)
+	require.NoError(t, err)
+	gotJSON, err := json.Marshal(got)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expectJSON), string(gotJSON))
+}
+
+type storageClient map[common.Hash]common.Hash
+
+func (c storageClient) StorageAt(_ context.Context, _ common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	val := c[key]
+	return val[:], nil
+}
+
+func TestDecodeStorage(t *testing.T) {
+	layout := complexLayout(t)
+	owner := common.HexToAddress("0x829BD824B016326A401d083B33D092293333A830")
+	other := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	point := map[string]any{
+		"x":     big.NewInt(1),
+		"y":     big.NewInt(2),
+		"owner": owner,
+		"tag":   hexutil.Bytes(common.Hash{0xff}.Bytes()),
+	}
+	emptyPoint := map[string]any{
+		"x":     new(big.Int),
+		"y":     new(big.Int),
+		"owner": common.Address{},
+		"tag":   hexutil.Bytes(common.Hash{}.Bytes()),
+	}
+	values := state.StorageValues{
+		"small":      -2,
+		"selector":   "0xdeadbeef",
+		"status":     uint8(2),
+		"longString": strings.Repeat("optimism", 5),
+		"data":       hexutil.Bytes(bytes.Repeat([]byte{0xab}, 70)),
+		"nums":       []any{1, 2, 3},
+		"point":      point,
+		"points":     []any{point, point},
+		"fixedSmall": []uint8{1, 2, 3},
+		"nested": map[any]any{
+			owner: map[any]any{7: true, 8: false},
+		},
+		"byName": map[string]any{"home": point},
+		"signed": map[string]any{"-1": 5},
+	}
+
+	db := state.NewMemoryStateDB(nil)
+	addr := common.Address{0x42}
+	db.CreateAccount(addr)
+	slots, err := state.ComputeStorageSlots(layout, values)
+	require.NoError(t, err)
+	client := make(storageClient)
+	for _, slot := range slots {
+		db.SetState(addr, slot.Key, slot.Value)
+		client[slot.Key] = slot.Value
+	}
+
+	keys := state.MappingKeys{
+		// keys in different forms are formatted the same
+		"nested": {[]any{owner, 7}, []any{owner.Hex(), "0x8"}, other},
+		"byName": {"home", "away"},
+		"signed": {-1, "1"},
+	}
+	expect := state.StorageView{
+		"small":      big.NewInt(-2),
+		"selector":   hexutil.Bytes{0xde, 0xad, 0xbe, 0xef},
+		"status":     big.NewInt(2),
+		"longString": strings.Repeat("optimism", 5),
+		"data":       hexutil.Bytes(bytes.Repeat([]byte{0xab}, 70)),
+		"nums":       []any{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+		"point":      point,
+		"points":     []any{point, point},
+		"fixedSmall": []any{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+		"nested": map[string]any{
+			owner.Hex(): map[string]any{"7": true, "8": false},
+			other.Hex(): map[string]any{},
+		},
+		"byName": map[string]any{"home": point, "away": emptyPoint},
+		"signed": map[string]any{"-1": big.NewInt(5), "1": new(big.Int)},
+	}
+
+	ctx := context.Background()
+	view, err := state.DecodeStorage(ctx, layout, bindings.NewStateDBStorageReader(db, addr), keys)
+	require.NoError(t, err)
+	requireViewEqual(t, expect, view)
+	require.IsType(t, common.Address{}, view["point"].(map[string]any)["owner"])
+	require.IsType(t, hexutil.Bytes{}, view["selector"])
+	require.IsType(t, &big.Int{}, view["small"])
+
+	rpcView, err := state.DecodeStorage(ctx, layout, bindings.NewRPCStorageReader(client, addr, nil), keys)
+	require.NoError(t, err)
+	requireViewEqual(t, view, rpcView)
+
+	// The JSON view encodes to the same storage again
+	data, err := json.Marshal(view)
+	require.NoError(t, err)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var decoded state.StorageValues
+	require.NoError(t, dec.Decode(&decoded))
+	reencoded, err := state.ComputeStorageSlots(layout, decoded)
+	require.NoError(t, err)
+	for _, slot := range reencoded {
+		require.Equal(t, db.GetState(addr, slot.Key), slot.Value, "slot %s", slot.Key)
+	}
+}
+
+func TestDecodeStorageDuplicateLabels(t *testing.T) {
+	layout := &solc.StorageLayout{
+		Storage: []solc.StorageLayoutEntry{
+			{Label: "__gap", Slot: 0, Type: "t_array(t_uint256)2_storage"},
+			{Label: "__gap", Slot: 2, Type: "t_array(t_uint256)2_storage"},
+		},
+		Types: map[string]solc.StorageLayoutType{
+			"t_array(t_uint256)2_storage": {Encoding: "inplace", Label: "uint256[2]", NumberOfBytes: 64, Base: "t_uint256"},
+			"t_uint256":                   {Encoding: "inplace", Label: "uint256", NumberOfBytes: 32},
+		},
+	}
+	client := storageClient{slotKey(3): slotKey(1)}
+	view, err := state.DecodeStorage(context.Background(), layout, bindings.NewRPCStorageReader(client, common.Address{}, nil), nil)
+	require.NoError(t, err)
+	requireViewEqual(t, state.StorageView{
+		"__gap":   []any{new(big.Int), new(big.Int)},
+		"__gap@2": []any{new(big.Int), big.NewInt(1)},
+	}, view)
+}
+
+func TestDecodeStorageInvalid(t *testing.T) {
+	layout := complexLayout(t)
+	ctx := context.Background()
+
+	// a dynamic array with an absurd length is not read
+	client := storageClient{slotKey(3): common.BigToHash(new(big.Int).Lsh(common.Big1, 200))}
+	_, err := state.DecodeStorage(ctx, layout, bindings.NewRPCStorageReader(client, common.Address{}, nil), nil)
+	require.ErrorContains(t, err, "nums")
+
+	// structs without members can not be decoded
+	layout = complexLayout(t)
+	typ := layout.Types["t_struct(Point)200_storage"]
+	typ.Members = nil
+	layout.Types["t_struct(Point)200_storage"] = typ
+	_, err = state.DecodeStorage(ctx, layout, bindings.NewRPCStorageReader(storageClient{}, common.Address{}, nil), nil)
+	require.ErrorContains(t, err, "point")
+}
+
+func TestGetStorage(t *testing.T) {
+	db := state.NewMemoryStateDB(nil)
+	addr := common.Address{0x42}
+	db.CreateAccount(addr)
+	values := state.StorageValues{
+		"number":         uint64(10),
+		"timestamp":      uint64(1000),
+		"hash":           common.Hash{0x01},
+		"sequenceNumber": uint64(3),
+	}
+	require.NoError(t, state.SetStorage("L1Block", addr, values, db))
+
+	view, err := state.GetStorage(context.Background(), "L1Block", addr, db, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), view["number"].(*big.Int).Uint64())
+	require.Equal(t, uint64(1000), view["timestamp"].(*big.Int).Uint64())
+	require.Equal(t, hexutil.Bytes(common.Hash{0x01}.Bytes()), view["hash"])
+	require.Equal(t, uint64(3), view["sequenceNumber"].(*big.Int).Uint64())
+	require.Zero(t, view["basefee"].(*big.Int).Sign())
+
+	_, err = state.GetStorage(context.Background(), "Unknown", addr, db, nil)
+	require.Error(t, err)
+}
@@ -1,11 +1,13 @@
 package state
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
@@ -15,178 +17,445 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+var (
+	fixedBytesRe = regexp.MustCompile(`^bytes([0-9]+)$`)
+	intRe        = regexp.MustCompile(`^int([0-9]+)$`)
+	arrayLenRe   = regexp.MustCompile(`\[([0-9]+)\]$`)
+)
+
 // EncodeStorageKeyValue encodes the key value pair that is stored in state
 // given a StorageLayoutEntry and StorageLayoutType. A single input may result
-// in multiple outputs. Unknown or unimplemented types will return an error.
+// in multiple outputs. Only the given type is known, so the keys and values
+// of mappings must be elementary types. Use EncodeStorage to encode values
+// with nested types.
 // Note that encoding uints is *not* overflow safe, so be sure to check
 // the ABI before setting very large values
 func EncodeStorageKeyValue(value any, entry solc.StorageLayoutEntry, storageType solc.StorageLayoutType) ([]*EncodedStorage, error) {
-	label := storageType.Label
-	encoded := make([]*EncodedStorage, 0)
+	types := map[string]solc.StorageLayoutType{entry.Type: storageType}
+	for _, name := range []string{storageType.Key, storageType.Value, storageType.Base} {
+		if _, ok := types[name]; name == "" || ok {
+			continue
+		}
+		if elem, ok := elementaryStorageType(name); ok {
+			types[name] = elem
+		}
+	}
+	slot := common.BigToHash(new(big.Int).SetUint64(uint64(entry.Slot)))
+	return encodeStorageValue(types, entry.Type, slot, entry.Offset, value)
+}
+
+// elementaryStorageType returns the storage layout type of an elementary type,
+// given its solc type identifier, e.g. t_uint256 or t_string_storage.
+func elementaryStorageType(typeName string) (solc.StorageLayoutType, bool) {
+	name := strings.TrimPrefix(typeName, "t_")
+	switch {
+	case name == "bool":
+		return solc.StorageLayoutType{Encoding: "inplace", Label: "bool", NumberOfBytes: 1}, true
+	case name == "address" || name == "address_payable" || strings.HasPrefix(name, "contract("):
+		return solc.StorageLayoutType{Encoding: "inplace", Label: "address", NumberOfBytes: 20}, true
+	case strings.HasPrefix(name, "string_"):
+		return solc.StorageLayoutType{Encoding: "bytes", Label: "string", NumberOfBytes: 32}, true
+	case strings.HasPrefix(name, "bytes_"):
+		return solc.StorageLayoutType{Encoding: "bytes", Label: "bytes", NumberOfBytes: 32}, true
+	}
+	for _, prefix := range []string{"uint", "int", "bytes"} {
+		bits, err := strconv.ParseUint(strings.TrimPrefix(name, prefix), 10, 64)
+		if !strings.HasPrefix(name, prefix) || err != nil {
+			continue
+		}
+		size := bits / 8
+		if prefix == "bytes" {
+			size = bits
+		}
+		if size == 0 || size > 32 {
+			return solc.StorageLayoutType{}, false
+		}
+		return solc.StorageLayoutType{Encoding: "inplace", Label: name, NumberOfBytes: uint(size)}, true
+	}
+	return solc.StorageLayoutType{}, false
+}
+
+// encodeStorageValue encodes a value of the given type, that starts at the given
+// slot and offset, into the storage slots it occupies. It recurses into the
+// types nested in structs, arrays and mappings.
+// Note that encoding uints is *not* overflow safe for types of 32 bytes, so be
+// sure to check the ABI before setting very large values
+func encodeStorageValue(types map[string]solc.StorageLayoutType, typeName string, slot common.Hash, offset uint, value any) ([]*EncodedStorage, error) {
+	if value == nil {
+		return nil, fmt.Errorf("cannot encode %s: %w", typeName, errInvalidType)
+	}
+	storageType, ok := types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("storage type %s not found", typeName)
+	}
+	if offset != 0 && storageType.Encoding != "inplace" {
+		return nil, fmt.Errorf("%s with %s encoding must not have an offset", storageType.Label, storageType.Encoding)
+	}
 
-	key := encodeSlotKey(entry)
 	switch storageType.Encoding {
 	case "inplace":
-		switch label {
-		case "bool":
-			val, err := EncodeBoolValue(value, entry.Offset)
+		if isStruct(storageType) {
+			return encodeStruct(types, storageType, slot, value)
+		}
+		if storageType.Base != "" {
+			length, err := staticArrayLength(storageType)
 			if err != nil {
-				return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, err)
+				return nil, err
 			}
-			encoded = append(encoded, &EncodedStorage{key, val})
-		case "address":
-			val, err := EncodeAddressValue(value, entry.Offset)
+			elems, err := sliceValue(value)
 			if err != nil {
-				return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, err)
+				return nil, fmt.Errorf("cannot encode %s: %w", storageType.Label, err)
 			}
-			encoded = append(encoded, &EncodedStorage{key, val})
-		case "bytes":
-			return nil, fmt.Errorf("%w: %s", errUnimplemented, label)
-		case "bytes32":
-			val, err := EncodeBytes32Value(value, entry.Offset)
-			if err != nil {
-				return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, err)
-			}
-			encoded = append(encoded, &EncodedStorage{key, val})
-		default:
-			switch true {
-			case strings.HasPrefix(label, "contract"):
-				val, err := EncodeAddressValue(value, entry.Offset)
-				if err != nil {
-					return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, err)
-				}
-				encoded = append(encoded, &EncodedStorage{key, val})
-			case strings.HasPrefix(label, "uint"):
-				val, err := EncodeUintValue(value, entry.Offset)
-				if err != nil {
-					return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, err)
-				}
-				encoded = append(encoded, &EncodedStorage{key, val})
-			default:
-				// structs are not supported
-				return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, errUnimplemented)
+			if uint64(elems.Len()) > length {
+				return nil, fmt.Errorf("cannot encode %s: %d elements do not fit", storageType.Label, elems.Len())
 			}
+			return encodeArrayElements(types, storageType.Base, slot, elems)
 		}
-	case "dynamic_array":
-	case "bytes":
-		switch label {
-		case "string":
-			val, err := EncodeStringValue(value, entry.Offset)
-			if err != nil {
-				return nil, fmt.Errorf("cannot encode %s: %w", storageType.Encoding, errUnimplemented)
-			}
-			encoded = append(encoded, &EncodedStorage{key, val})
-		default:
-			return nil, fmt.Errorf("%w: %s", errUnimplemented, label)
+		val, err := encodeInplaceValue(storageType, value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %s: %w", storageType.Label, err)
 		}
-	case "mapping":
-		if strings.HasPrefix(storageType.Value, "mapping") {
-			return nil, fmt.Errorf("%w: %s", errUnimplemented, "nested mappings")
+		if offset+storageType.NumberOfBytes > 32 {
+			return nil, fmt.Errorf("cannot encode %s: offset %d out of range", storageType.Label, offset)
 		}
-
-		values, ok := value.(map[any]any)
-		if !ok {
-			return nil, fmt.Errorf("mapping must be map[any]any")
+		return []*EncodedStorage{{slot, handleOffset(val, offset)}}, nil
+	case "bytes":
+		data, err := bytesValue(storageType, value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %s: %w", storageType.Label, err)
 		}
-
-		keyEncoder, err := getElementEncoder(storageType, "key")
+		return encodeBytes(slot, data), nil
+	case "dynamic_array":
+		elems, err := sliceValue(value)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("cannot encode %s: %w", storageType.Label, err)
 		}
-		valueEncoder, err := getElementEncoder(storageType, "value")
+		// The slot holds the length, the elements are stored from the hash of the slot onwards
+		length := common.BigToHash(new(big.Int).SetUint64(uint64(elems.Len())))
+		encoded, err := encodeArrayElements(types, storageType.Base, crypto.Keccak256Hash(slot[:]), elems)
 		if err != nil {
 			return nil, err
 		}
-
-		// Mapping values have 0 offset
-		for rawKey, rawVal := range values {
-			encodedKey, err := keyEncoder(rawKey, 0)
+		return append([]*EncodedStorage{{slot, length}}, encoded...), nil
+	case "mapping":
+		values := reflect.ValueOf(value)
+		if values.Kind() != reflect.Map {
+			return nil, fmt.Errorf("cannot encode %s: mapping must be a map", storageType.Label)
+		}
+		encoded := make([]*EncodedStorage, 0)
+		iter := values.MapRange()
+		for iter.Next() {
+			key, err := encodeMappingSlot(types, storageType.Key, iter.Key().Interface(), slot)
+			if err != nil {
+				return nil, fmt.Errorf("cannot encode key of %s: %w", storageType.Label, err)
+			}
+			// Mapping values have 0 offset
+			val, err := encodeStorageValue(types, storageType.Value, key, 0, iter.Value().Interface())
 			if err != nil {
 				return nil, err
 			}
+			encoded = append(encoded, val...)
+		}
+		return encoded, nil
+	default:
+		return nil, fmt.Errorf("unknown encoding %s of %s", storageType.Encoding, storageType.Label)
+	}
+}
 
-			encodedSlot := encodeSlotKey(entry)
+// encodeStruct encodes a struct given as a map from member labels to values.
+// Members that are not in the map are left empty.
+func encodeStruct(types map[string]solc.StorageLayoutType, storageType solc.StorageLayoutType, slot common.Hash, value any) ([]*EncodedStorage, error) {
+	if len(storageType.Members) == 0 {
+		return nil, fmt.Errorf("cannot encode %s: storage layout has no struct members", storageType.Label)
+	}
+	values := reflect.ValueOf(value)
+	if values.Kind() != reflect.Map {
+		return nil, fmt.Errorf("cannot encode %s: struct must be a map", storageType.Label)
+	}
+	encoded := make([]*EncodedStorage, 0)
+	iter := values.MapRange()
+	for iter.Next() {
+		label, ok := iter.Key().Interface().(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot encode %s: member name must be a string", storageType.Label)
+		}
+		member, ok := structMember(storageType, label)
+		if !ok {
+			return nil, fmt.Errorf("cannot encode %s: unknown member %s", storageType.Label, label)
+		}
+		val, err := encodeStorageValue(types, member.Type, addSlot(slot, uint64(member.Slot)), member.Offset, iter.Value().Interface())
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %s.%s: %w", storageType.Label, label, err)
+		}
+		encoded = append(encoded, val...)
+	}
+	return encoded, nil
+}
 
-			preimage := [64]byte{}
-			copy(preimage[0:32], encodedKey.Bytes())
-			copy(preimage[32:64], encodedSlot.Bytes())
+// encodeArrayElements encodes the elements of a static or dynamic array, starting at the given slot
+func encodeArrayElements(types map[string]solc.StorageLayoutType, baseName string, start common.Hash, elems reflect.Value) ([]*EncodedStorage, error) {
+	base, ok := types[baseName]
+	if !ok {
+		return nil, fmt.Errorf("storage type %s not found", baseName)
+	}
+	encoded := make([]*EncodedStorage, 0)
+	for i := 0; i < elems.Len(); i++ {
+		slot, offset := arrayElementSlot(base, start, uint64(i))
+		val, err := encodeStorageValue(types, baseName, slot, offset, elems.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode element %d: %w", i, err)
+		}
+		encoded = append(encoded, val...)
+	}
+	return encoded, nil
+}
 
-			hash := crypto.Keccak256(preimage[:])
-			key := common.BytesToHash(hash)
+// encodeBytes encodes a string or bytes value. Values shorter than 32 bytes are stored in
+// the slot together with 2 * the length, longer values are stored from the hash of the
+// slot onwards, with 2 * the length + 1 in the slot.
+func encodeBytes(slot common.Hash, data []byte) []*EncodedStorage {
+	if len(data) < 32 {
+		padded := common.RightPadBytes(data, 32)
+		padded[31] = byte(len(data) * 2)
+		return []*EncodedStorage{{slot, common.BytesToHash(padded)}}
+	}
+	length := new(big.Int).SetUint64(uint64(len(data))*2 + 1)
+	encoded := []*EncodedStorage{{slot, common.BigToHash(length)}}
+	start := crypto.Keccak256Hash(slot[:])
+	for i := 0; i < len(data); i += 32 {
+		end := i + 32
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := common.RightPadBytes(data[i:end], 32)
+		encoded = append(encoded, &EncodedStorage{addSlot(start, uint64(i/32)), common.BytesToHash(chunk)})
+	}
+	return encoded
+}
 
-			val, err := valueEncoder(rawVal, 0)
-			if err != nil {
-				return nil, err
+// encodeMappingSlot computes the slot of the value of a mapping key. Value type keys are
+// padded to 32 bytes like in ABI encoding, string and bytes keys are hashed as is.
+func encodeMappingSlot(types map[string]solc.StorageLayoutType, keyType string, key any, slot common.Hash) (common.Hash, error) {
+	storageType, ok := types[keyType]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("storage type %s not found", keyType)
+	}
+	if storageType.Encoding == "bytes" {
+		data, err := bytesValue(storageType, key)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return crypto.Keccak256Hash(data, slot[:]), nil
+	}
+	val, err := encodeInplaceValue(storageType, key)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	size := storageType.NumberOfBytes
+	var word common.Hash
+	switch {
+	case fixedBytesRe.MatchString(storageType.Label):
+		// fixed size bytes are left aligned
+		copy(word[:], val[32-size:])
+	case intRe.MatchString(storageType.Label) && size < 32 && val[32-size]&0x80 != 0:
+		// negative signed integers are sign extended
+		word = val
+		for i := 0; i < int(32-size); i++ {
+			word[i] = 0xff
+		}
+	default:
+		word = val
+	}
+	return crypto.Keccak256Hash(word[:], slot[:]), nil
+}
+
+// encodeInplaceValue encodes a value type into the low order bytes of a hash,
+// and checks that it fits in the number of bytes of the type.
+func encodeInplaceValue(storageType solc.StorageLayoutType, value any) (common.Hash, error) {
+	label := storageType.Label
+	size := storageType.NumberOfBytes
+	if size == 0 || size > 32 {
+		return common.Hash{}, fmt.Errorf("invalid size %d", size)
+	}
+
+	var val common.Hash
+	var err error
+	switch {
+	case label == "bool":
+		val, err = encodeBoolValue(value)
+	case label == "address" || label == "address payable" || strings.HasPrefix(label, "contract "):
+		val, err = encodeAddressValue(value)
+	case fixedBytesRe.MatchString(label):
+		val, err = encodeFixedBytesValue(value, size)
+	case intRe.MatchString(label):
+		val, err = encodeIntValue(value, size)
+	default:
+		// uints, enums and user defined value types
+		if data, ok := rawBytes(value); ok {
+			if uint(len(data)) > size {
+				return common.Hash{}, fmt.Errorf("%d bytes do not fit in %d bytes", len(data), size)
 			}
-			encoded = append(encoded, &EncodedStorage{key, val})
+			val = common.BytesToHash(data)
+		} else {
+			val, err = encodeUintValue(value)
+		}
+	}
+	if err != nil {
+		return common.Hash{}, err
+	}
+	for _, b := range val[:32-size] {
+		if b != 0 {
+			return common.Hash{}, fmt.Errorf("value does not fit in %d bytes", size)
+		}
+	}
+	return val, nil
+}
+
+// encodeFixedBytesValue encodes a fixed size bytes value into the low order
+// bytes of a hash. Shorter values are right padded to the size.
+func encodeFixedBytesValue(value any, size uint) (common.Hash, error) {
+	if hash, ok := value.(common.Hash); ok && size == 32 {
+		return hash, nil
+	}
+	data, ok := rawBytes(value)
+	if !ok {
+		str, isStr := value.(string)
+		if !isStr {
+			return common.Hash{}, errInvalidType
+		}
+		var err error
+		if data, err = hexutil.Decode(str); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	if uint(len(data)) > size {
+		return common.Hash{}, fmt.Errorf("%d bytes do not fit in bytes%d", len(data), size)
+	}
+	var val common.Hash
+	copy(val[32-size:], common.RightPadBytes(data, int(size)))
+	return val, nil
+}
+
+// encodeIntValue encodes a signed integer as two's complement in the given
+// number of bytes, in the low order bytes of a hash.
+func encodeIntValue(value any, size uint) (common.Hash, error) {
+	var number *big.Int
+	switch v := value.(type) {
+	case int:
+		number = big.NewInt(int64(v))
+	case int8:
+		number = big.NewInt(int64(v))
+	case int16:
+		number = big.NewInt(int64(v))
+	case int32:
+		number = big.NewInt(int64(v))
+	case int64:
+		number = big.NewInt(v)
+	case *big.Int:
+		number = v
+	case string, json.Number:
+		str := fmt.Sprint(v)
+		var ok bool
+		if number, ok = new(big.Int).SetString(str, 0); !ok {
+			return common.Hash{}, errInvalidType
 		}
 	default:
-		return nil, fmt.Errorf("unknown encoding %s: %w", storageType.Encoding, errUnimplemented)
+		return common.Hash{}, errInvalidType
 	}
-	return encoded, nil
+	bits := size * 8
+	limit := new(big.Int).Lsh(common.Big1, bits-1)
+	if number.Cmp(limit) >= 0 || number.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return common.Hash{}, fmt.Errorf("value does not fit in int%d", bits)
+	}
+	if number.Sign() < 0 {
+		number = new(big.Int).Add(number, new(big.Int).Lsh(common.Big1, bits))
+	}
+	return common.BigToHash(number), nil
 }
 
-// encodeSlotKey will encode the storage slot key. This does not
-// support mappings.
-func encodeSlotKey(entry solc.StorageLayoutEntry) common.Hash {
-	slot := new(big.Int).SetUint64(uint64(entry.Slot))
-	return common.BigToHash(slot)
+// bytesValue returns the data of a string or bytes value
+func bytesValue(storageType solc.StorageLayoutType, value any) ([]byte, error) {
+	if storageType.Label == "string" {
+		str, ok := value.(string)
+		if !ok {
+			return nil, errInvalidType
+		}
+		return []byte(str), nil
+	}
+	if data, ok := rawBytes(value); ok {
+		return data, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, errInvalidType
+	}
+	return hexutil.Decode(str)
 }
 
-// ElementEncoder is a function that can encode an element
-// based on a solidity type
-type ElementEncoder func(value any, offset uint) (common.Hash, error)
-
-// getElementEncoder will return the correct ElementEncoder
-// given a solidity type. The kind refers to the key or the value
-// when getting an encoder for a mapping. This is only useful
-// if the key itself is not populated for some reason.
-func getElementEncoder(storageType solc.StorageLayoutType, kind string) (ElementEncoder, error) {
-	var target string
-	if kind == "key" {
-		target = storageType.Key
-	} else if kind == "value" {
-		target = storageType.Value
-	} else {
-		return nil, fmt.Errorf("unknown storage %s", kind)
-	}
-
-	switch target {
-	case "t_address":
-		return EncodeAddressValue, nil
-	case "t_bool":
-		return EncodeBoolValue, nil
-	case "t_bytes32":
-		return EncodeBytes32Value, nil
+// rawBytes returns the bytes of byte slice like values
+func rawBytes(value any) ([]byte, bool) {
+	switch v := value.(type) {
+	case []byte:
+		return v, true
+	case hexutil.Bytes:
+		return v, true
 	default:
-		if strings.HasPrefix(target, "t_uint") {
-			return EncodeUintValue, nil
-		}
-	}
-
-	// Special case fallback if the target is empty, pull it
-	// from the label. This requires knowledge of whether we want
-	// the key or the value in the label.
-	if target == "" {
-		r := regexp.MustCompile(`mapping\((?P<key>[[:alnum:]]*) => (?P<value>[[:alnum:]]*)\)`)
-		result := r.FindStringSubmatch(storageType.Label)
-
-		for i, key := range r.SubexpNames() {
-			if kind == key {
-				res := "t_" + result[i]
-				layout := solc.StorageLayoutType{}
-				if kind == "key" {
-					layout.Key = res
-				} else if kind == "value" {
-					layout.Value = res
-				} else {
-					return nil, fmt.Errorf("unknown storage %s", kind)
-				}
-				return getElementEncoder(layout, kind)
-			}
+		return nil, false
+	}
+}
+
+// sliceValue returns the elements of an array value
+func sliceValue(value any) (reflect.Value, error) {
+	elems := reflect.ValueOf(value)
+	if elems.Kind() != reflect.Slice && elems.Kind() != reflect.Array {
+		return reflect.Value{}, errors.New("array must be a slice")
+	}
+	return elems, nil
+}
+
+func isStruct(storageType solc.StorageLayoutType) bool {
+	return len(storageType.Members) > 0 || strings.HasPrefix(storageType.Label, "struct ")
+}
+
+func structMember(storageType solc.StorageLayoutType, label string) (solc.StorageLayoutEntry, bool) {
+	for _, member := range storageType.Members {
+		if member.Label == label {
+			return member, true
 		}
 	}
-	return nil, fmt.Errorf("unsupported type: %s", target)
+	return solc.StorageLayoutEntry{}, false
+}
+
+// staticArrayLength returns the length of a static array type, which is part of its label
+func staticArrayLength(storageType solc.StorageLayoutType) (uint64, error) {
+	match := arrayLenRe.FindStringSubmatch(storageType.Label)
+	if match == nil {
+		return 0, fmt.Errorf("no length in array type %s", storageType.Label)
+	}
+	return strconv.ParseUint(match[1], 10, 64)
+}
+
+// arrayElementSlot returns the slot and offset of an array element. Elements of 16 bytes
+// or less are packed into slots, larger elements start at a new slot.
+func arrayElementSlot(base solc.StorageLayoutType, start common.Hash, index uint64) (common.Hash, uint) {
+	size := uint64(base.NumberOfBytes)
+	if size == 0 {
+		size = 32
+	}
+	if size <= 16 {
+		perSlot := 32 / size
+		return addSlot(start, index/perSlot), uint((index % perSlot) * size)
+	}
+	return addSlot(start, index*((size+31)/32)), 0
+}
+
+// addSlot adds to a storage slot, wrapping around at 2**256
+func addSlot(slot common.Hash, n uint64) common.Hash {
+	if n == 0 {
+		return slot
+	}
+	sum := new(big.Int).Add(slot.Big(), new(big.Int).SetUint64(n))
+	return common.BytesToHash(sum.Bytes())
 }
 
 // EncodeBytes32Value will encode a bytes32 value. The offset
@@ -411,23 +680,23 @@ func encodeUintValue(value any) (common.Hash, error) {
 		}
 		result := new(big.Int).SetUint64(uint64(val))
 		return common.BigToHash(result), nil
-	case "string":
-		val, ok := value.(string)
-		if !ok {
-			return common.Hash{}, errInvalidType
-		}
-		number, err := hexutil.DecodeBig(val)
+	case "string", "Number":
+		str := val.String()
+		number, err := hexutil.DecodeBig(str)
 		if err != nil {
+			var ok bool
 			if errors.Is(err, hexutil.ErrMissingPrefix) {
-				number, ok = new(big.Int).SetString(val, 10)
+				number, ok = new(big.Int).SetString(str, 10)
 				if !ok {
 					return common.Hash{}, errInvalidType
 				}
 			} else if errors.Is(err, hexutil.ErrLeadingZero) {
-				number, ok = new(big.Int).SetString(val[2:], 16)
+				number, ok = new(big.Int).SetString(str[2:], 16)
 				if !ok {
 					return common.Hash{}, errInvalidType
 				}
+			} else {
+				return common.Hash{}, err
 			}
 		}
 		return common.BigToHash(number), nil
@@ -13,8 +13,7 @@ import (
 )
 
 var (
-	errInvalidType   = errors.New("invalid type")
-	errUnimplemented = errors.New("type unimplemented")
+	errInvalidType = errors.New("invalid type")
 )
 
 // StorageValues represents the values to be set in storage.
@@ -33,17 +32,13 @@ type EncodedStorage struct {
 	Value common.Hash
 }
 
-// EncodeStorage will encode a storage layout
-func EncodeStorage(entry solc.StorageLayoutEntry, value any, storageType solc.StorageLayoutType) ([]*EncodedStorage, error) {
-	if storageType.NumberOfBytes > 32 {
-		return nil, fmt.Errorf("%s is larger than 32 bytes", storageType.Encoding)
-	}
-
-	encoded, err := EncodeStorageKeyValue(value, entry, storageType)
-	if err != nil {
-		return nil, err
-	}
-	return encoded, nil
+// EncodeStorage will encode the value of a storage variable into the storage
+// slots it occupies. The layout provides the types of the variable and of all
+// the types nested in it. Tightly packed values of different variables can be
+// combined with MergeStorage.
+func EncodeStorage(layout *solc.StorageLayout, entry solc.StorageLayoutEntry, value any) ([]*EncodedStorage, error) {
+	slot := common.BigToHash(new(big.Int).SetUint64(uint64(entry.Slot)))
+	return encodeStorageValue(layout.Types, entry.Type, slot, entry.Offset, value)
 }
 
 // SetStorage will set the storage values in a db given a contract name,
@@ -85,7 +80,7 @@ func ComputeStorageSlots(layout *solc.StorageLayout, values StorageValues) ([]*E
 
 		}
 
-		storage, err := EncodeStorage(target, value, storageType)
+		storage, err := EncodeStorage(layout, target, value)
 		if err != nil {
 			return nil, fmt.Errorf("cannot encode storage for %s: %w", target.Label, err)
 		}
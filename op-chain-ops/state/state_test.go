@@ -473,3 +473,29 @@ func TestEncodeStringValue(t *testing.T) {
 		require.Equal(t, got, test.expect)
 	}
 }
+
+func TestEncodeStorageKeyValue(t *testing.T) {
+	entry := solc.StorageLayoutEntry{Label: "balances", Slot: 3, Type: "t_mapping(t_address,t_uint256)"}
+	storageType := solc.StorageLayoutType{
+		Encoding:      "mapping",
+		Label:         "mapping(address => uint256)",
+		NumberOfBytes: 32,
+		Key:           "t_address",
+		Value:         "t_uint256",
+	}
+	owner := common.HexToAddress("0x1234")
+	encoded, err := state.EncodeStorageKeyValue(map[any]any{owner: 100}, entry, storageType)
+	require.NoError(t, err)
+	require.Len(t, encoded, 1)
+	slot := crypto.Keccak256Hash(common.LeftPadBytes(owner[:], 32), common.BigToHash(big.NewInt(3)).Bytes())
+	require.Equal(t, slot, encoded[0].Key)
+	require.Equal(t, common.BigToHash(big.NewInt(100)), encoded[0].Value)
+
+	entry = solc.StorageLayoutEntry{Label: "paused", Slot: 1, Offset: 20, Type: "t_bool"}
+	storageType = solc.StorageLayoutType{Encoding: "inplace", Label: "bool", NumberOfBytes: 1}
+	encoded, err = state.EncodeStorageKeyValue(true, entry, storageType)
+	require.NoError(t, err)
+	require.Len(t, encoded, 1)
+	require.Equal(t, common.BigToHash(big.NewInt(1)), encoded[0].Key)
+	require.Equal(t, common.BigToHash(new(big.Int).Lsh(big.NewInt(1), 160)), encoded[0].Value)
+}
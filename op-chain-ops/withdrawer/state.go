@@ -0,0 +1,78 @@
+package withdrawer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+)
+
+// State is the progress of a withdrawal. It is persisted after every step,
+// so that an interrupted withdrawal can be resumed where it stopped.
+type State struct {
+	// TxHash is the hash of the L2 transaction that initiated the withdrawal
+	TxHash common.Hash `json:"txHash"`
+	// L2BlockNumber is the L2 block that includes the withdrawal transaction
+	L2BlockNumber uint64 `json:"l2BlockNumber"`
+
+	Withdrawal     *crossdomain.Withdrawal `json:"withdrawal,omitempty"`
+	WithdrawalHash common.Hash             `json:"withdrawalHash"`
+
+	// L2OutputIndex is the index of the output proposal the withdrawal was last proven against
+	L2OutputIndex *uint64      `json:"l2OutputIndex,omitempty"`
+	ProveTxHash   *common.Hash `json:"proveTxHash,omitempty"`
+
+	FinalizeTxHash *common.Hash `json:"finalizeTxHash,omitempty"`
+	// Success is whether the call to the withdrawal target succeeded when finalizing
+	Success *bool `json:"success,omitempty"`
+}
+
+// LoadState reads the state of a withdrawal from the given path.
+// A nil state is returned if there is no state file yet.
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read withdrawal state: %w", err)
+	}
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode withdrawal state: %w", err)
+	}
+	return &state, nil
+}
+
+// Save writes the state to the given path. The file is replaced atomically,
+// so an interruption never leaves a partially written state behind.
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode withdrawal state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create withdrawal state file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write withdrawal state: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync withdrawal state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close withdrawal state file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace withdrawal state file: %w", err)
+	}
+	return nil
+}
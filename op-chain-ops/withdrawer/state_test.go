@@ -0,0 +1,70 @@
+package withdrawer
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+)
+
+func TestState(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "withdrawal.json")
+
+	state, err := LoadState(path)
+	require.NoError(t, err)
+	require.Nil(t, state, "no state before the first run")
+
+	sender := common.Address{0xaa}
+	target := common.Address{0xbb}
+	wd := crossdomain.NewWithdrawal(big.NewInt(1), &sender, &target, big.NewInt(100), big.NewInt(200_000), []byte{0x01, 0x02})
+	hash, err := wd.Hash()
+	require.NoError(t, err)
+	state = &State{
+		TxHash:         common.Hash{0x01},
+		L2BlockNumber:  123,
+		Withdrawal:     wd,
+		WithdrawalHash: hash,
+	}
+	require.NoError(t, state.Save(path))
+	loaded, err := LoadState(path)
+	require.NoError(t, err)
+	require.Equal(t, state, loaded)
+
+	index := uint64(4)
+	proveTx := common.Hash{0x02}
+	state.L2OutputIndex = &index
+	state.ProveTxHash = &proveTx
+	require.NoError(t, state.Save(path))
+	loaded, err = LoadState(path)
+	require.NoError(t, err)
+	require.Equal(t, state, loaded)
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0644))
+	_, err = LoadState(path)
+	require.Error(t, err)
+}
+
+func TestConfigCheck(t *testing.T) {
+	cfg := Config{PortalAddress: common.Address{0x01}, StatePath: "withdrawal.json", PollInterval: 1}
+	require.NoError(t, cfg.Check())
+	c := cfg
+	c.PortalAddress = common.Address{}
+	require.Error(t, c.Check())
+	c = cfg
+	c.StatePath = ""
+	require.Error(t, c.Check())
+	c = cfg
+	c.PollInterval = 0
+	require.Error(t, c.Check())
+}
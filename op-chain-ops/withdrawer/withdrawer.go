@@ -0,0 +1,371 @@
+package withdrawer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/withdrawals"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// maxWait is the longest time to wait in between checks of the withdrawal status,
+// so that a deleted output proposal is noticed while waiting out the finalization period.
+const maxWait = time.Hour
+
+// L1Client is the L1 chain the portal and output oracle live on
+type L1Client interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// L2Client is the L2 chain the withdrawal was initiated on
+type L2Client interface {
+	withdrawals.ReceiptClient
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+type Config struct {
+	// PortalAddress is the address of the OptimismPortal on L1
+	PortalAddress common.Address
+	// StatePath is the file the progress of the withdrawal is persisted to
+	StatePath string
+	// PollInterval is the time in between checks while waiting for an output proposal or finalization
+	PollInterval time.Duration
+}
+
+func (c *Config) Check() error {
+	if c.PortalAddress == (common.Address{}) {
+		return errors.New("missing portal address")
+	}
+	if c.StatePath == "" {
+		return errors.New("missing state path")
+	}
+	if c.PollInterval == 0 {
+		return errors.New("poll interval must not be 0")
+	}
+	return nil
+}
+
+// Withdrawer proves and finalizes a withdrawal on L1. Every step is persisted to the state file,
+// and the on-chain status of the withdrawal is checked before each step, so that a run can be
+// interrupted and resumed at any point, even if part of the withdrawal was completed by someone else.
+type Withdrawer struct {
+	log     log.Logger
+	cfg     Config
+	l1      L1Client
+	l2      L2Client
+	l2Proof withdrawals.ProofClient
+	txMgr   txmgr.TxManager
+
+	portal    *bindings.OptimismPortalCaller
+	portalABI *abi.ABI
+}
+
+func NewWithdrawer(logger log.Logger, cfg Config, l1 L1Client, l2 L2Client, l2Proof withdrawals.ProofClient, txMgr txmgr.TxManager) (*Withdrawer, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	portal, err := bindings.NewOptimismPortalCaller(cfg.PortalAddress, l1)
+	if err != nil {
+		return nil, err
+	}
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Withdrawer{
+		log:       logger,
+		cfg:       cfg,
+		l1:        l1,
+		l2:        l2,
+		l2Proof:   l2Proof,
+		txMgr:     txMgr,
+		portal:    portal,
+		portalABI: portalABI,
+	}, nil
+}
+
+// Run proves and finalizes the withdrawal initiated by the given L2 transaction.
+// It blocks until the withdrawal is finalized, which includes waiting for an output proposal
+// that covers the withdrawal and waiting out the finalization period after proving it.
+func (w *Withdrawer) Run(ctx context.Context, txHash common.Hash) (*State, error) {
+	state, err := LoadState(w.cfg.StatePath)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &State{TxHash: txHash}
+	} else if state.TxHash != txHash {
+		return nil, fmt.Errorf("state file %s is of the withdrawal in tx %s, not %s", w.cfg.StatePath, state.TxHash, txHash)
+	}
+	if state.Withdrawal == nil {
+		if err := w.findWithdrawal(ctx, state); err != nil {
+			return nil, err
+		}
+		if err := state.Save(w.cfg.StatePath); err != nil {
+			return nil, err
+		}
+	}
+	w.log.Info("Processing withdrawal", "tx", state.TxHash, "withdrawal", state.WithdrawalHash, "l2_block", state.L2BlockNumber)
+
+	opts := &bind.CallOpts{Context: ctx}
+	l2ooAddr, err := w.portal.L2ORACLE(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L2 output oracle address: %w", err)
+	}
+	l2oo, err := bindings.NewL2OutputOracleCaller(l2ooAddr, w.l1)
+	if err != nil {
+		return nil, err
+	}
+	finalizationPeriod, err := l2oo.FINALIZATIONPERIODSECONDS(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch finalization period: %w", err)
+	}
+
+	for {
+		finalized, err := w.portal.FinalizedWithdrawals(opts, state.WithdrawalHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if withdrawal is finalized: %w", err)
+		}
+		if finalized {
+			if state.FinalizeTxHash == nil {
+				w.log.Info("Withdrawal was already finalized", "withdrawal", state.WithdrawalHash)
+			}
+			return state, nil
+		}
+
+		proven, err := w.portal.ProvenWithdrawals(opts, state.WithdrawalHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch proven withdrawal: %w", err)
+		}
+		if proven.Timestamp.Sign() == 0 {
+			if err := w.prove(ctx, l2oo, state); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if ok, err := outputProposed(opts, l2oo, proven.L2OutputIndex, proven.OutputRoot); err != nil {
+			return nil, err
+		} else if !ok {
+			w.log.Warn("Output the withdrawal was proven against was deleted, proving again", "index", proven.L2OutputIndex, "output_root", common.Hash(proven.OutputRoot))
+			if err := w.prove(ctx, l2oo, state); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		head, err := w.l1.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 head: %w", err)
+		}
+		// the portal requires the L1 block time to be strictly after the end of the finalization period
+		finalizeAt := new(big.Int).Add(proven.Timestamp, finalizationPeriod).Uint64()
+		if head.Time <= finalizeAt {
+			wait := time.Until(time.Unix(int64(finalizeAt), 0))
+			if wait > maxWait {
+				wait = maxWait
+			} else if wait < w.cfg.PollInterval {
+				wait = w.cfg.PollInterval
+			}
+			w.log.Info("Waiting for the finalization period to pass", "withdrawal", state.WithdrawalHash,
+				"proven_at", proven.Timestamp, "finalize_after", finalizeAt, "l1_time", head.Time)
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := w.finalize(ctx, state); err != nil {
+			return nil, err
+		}
+		return state, nil
+	}
+}
+
+// findWithdrawal finds the withdrawal that was initiated by the L2 transaction of the state
+func (w *Withdrawer) findWithdrawal(ctx context.Context, state *State) error {
+	receipt, err := w.l2.TransactionReceipt(ctx, state.TxHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch receipt of L2 tx %s: %w", state.TxHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("L2 tx %s failed, it did not initiate a withdrawal", state.TxHash)
+	}
+	ev, err := withdrawals.ParseMessagePassed(receipt)
+	if err != nil {
+		return fmt.Errorf("failed to find withdrawal in L2 tx %s: %w", state.TxHash, err)
+	}
+	wd := crossdomain.NewWithdrawal(ev.Nonce, &ev.Sender, &ev.Target, ev.Value, ev.GasLimit, ev.Data)
+	hash, err := wd.Hash()
+	if err != nil {
+		return err
+	}
+	if hash != ev.WithdrawalHash {
+		return fmt.Errorf("computed withdrawal hash %s does not match withdrawal hash %s of the MessagePassed event", hash, common.Hash(ev.WithdrawalHash))
+	}
+	state.Withdrawal = wd
+	state.WithdrawalHash = hash
+	state.L2BlockNumber = receipt.BlockNumber.Uint64()
+	return nil
+}
+
+// prove waits for an output proposal that covers the withdrawal, and proves the withdrawal against it
+func (w *Withdrawer) prove(ctx context.Context, l2oo *bindings.L2OutputOracleCaller, state *State) error {
+	opts := &bind.CallOpts{Context: ctx}
+	for {
+		latest, err := l2oo.LatestBlockNumber(opts)
+		if err != nil {
+			return fmt.Errorf("failed to fetch latest proposed L2 block: %w", err)
+		}
+		if latest.Uint64() >= state.L2BlockNumber {
+			break
+		}
+		w.log.Info("Waiting for an output proposal that covers the withdrawal", "l2_block", state.L2BlockNumber, "latest_proposed", latest)
+		if err := sleep(ctx, w.cfg.PollInterval); err != nil {
+			return err
+		}
+	}
+
+	blockNum := new(big.Int).SetUint64(state.L2BlockNumber)
+	index, err := l2oo.GetL2OutputIndexAfter(opts, blockNum)
+	if err != nil {
+		return fmt.Errorf("failed to fetch index of output after L2 block %d: %w", state.L2BlockNumber, err)
+	}
+	output, err := l2oo.GetL2Output(opts, index)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output %d: %w", index, err)
+	}
+	header, err := w.l2.HeaderByNumber(ctx, output.L2BlockNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %d of output %d: %w", output.L2BlockNumber, index, err)
+	}
+	params, err := withdrawals.ProveWithdrawalParameters(ctx, w.l2Proof, w.l2, state.TxHash, header, l2oo)
+	if err != nil {
+		return fmt.Errorf("failed to build withdrawal proof: %w", err)
+	}
+	if params.L2OutputIndex.Cmp(index) != 0 {
+		return fmt.Errorf("output index changed from %d to %d while building the proof", index, params.L2OutputIndex)
+	}
+	// The proof is checked against the proposed output root, a mismatch means the L2 node
+	// disagrees with the proposer, and the portal would reject the proof.
+	root, err := rollup.ComputeL2OutputRoot(&params.OutputRootProof)
+	if err != nil {
+		return err
+	}
+	if root != output.OutputRoot {
+		return fmt.Errorf("output root %s of L2 block %d does not match proposed output root %s at index %d",
+			common.Hash(root), output.L2BlockNumber, common.Hash(output.OutputRoot), index)
+	}
+
+	data, err := w.portalABI.Pack("proveWithdrawalTransaction", state.Withdrawal.WithdrawalTransaction(),
+		params.L2OutputIndex, params.OutputRootProof, params.WithdrawalProof)
+	if err != nil {
+		return fmt.Errorf("failed to encode proveWithdrawalTransaction call: %w", err)
+	}
+	w.log.Info("Proving withdrawal", "withdrawal", state.WithdrawalHash, "output_index", index, "l2_block", output.L2BlockNumber)
+	receipt, err := w.send(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to prove withdrawal: %w", err)
+	}
+	w.log.Info("Proved withdrawal", "withdrawal", state.WithdrawalHash, "tx", receipt.TxHash, "l1_block", receipt.BlockNumber)
+	outputIndex := index.Uint64()
+	state.L2OutputIndex = &outputIndex
+	state.ProveTxHash = &receipt.TxHash
+	return state.Save(w.cfg.StatePath)
+}
+
+// finalize finalizes the proven withdrawal, and records whether the call to the withdrawal target succeeded
+func (w *Withdrawer) finalize(ctx context.Context, state *State) error {
+	data, err := w.portalABI.Pack("finalizeWithdrawalTransaction", state.Withdrawal.WithdrawalTransaction())
+	if err != nil {
+		return fmt.Errorf("failed to encode finalizeWithdrawalTransaction call: %w", err)
+	}
+	w.log.Info("Finalizing withdrawal", "withdrawal", state.WithdrawalHash)
+	receipt, err := w.send(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to finalize withdrawal: %w", err)
+	}
+	state.FinalizeTxHash = &receipt.TxHash
+	success, err := w.finalizedSuccess(receipt)
+	if err != nil {
+		return err
+	}
+	state.Success = &success
+	if err := state.Save(w.cfg.StatePath); err != nil {
+		return err
+	}
+	if !success {
+		// The withdrawal cannot be finalized again, the target call failed for good.
+		w.log.Error("Finalized withdrawal, but the call to the withdrawal target failed", "withdrawal", state.WithdrawalHash, "tx", receipt.TxHash)
+		return nil
+	}
+	w.log.Info("Finalized withdrawal", "withdrawal", state.WithdrawalHash, "tx", receipt.TxHash, "l1_block", receipt.BlockNumber)
+	return nil
+}
+
+// finalizedSuccess reads the success flag of the WithdrawalFinalized event of a finalization receipt
+func (w *Withdrawer) finalizedSuccess(receipt *types.Receipt) (bool, error) {
+	for _, l := range receipt.Logs {
+		if l.Address != w.cfg.PortalAddress || len(l.Topics) == 0 || l.Topics[0] != bindings.OptimismPortalWithdrawalFinalizedTopic {
+			continue
+		}
+		ev, err := bindings.DecodeContractLog("OptimismPortal", *l)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse WithdrawalFinalized event: %w", err)
+		}
+		return ev.(*bindings.OptimismPortalWithdrawalFinalized).Success, nil
+	}
+	return false, fmt.Errorf("no WithdrawalFinalized event in tx %s", receipt.TxHash)
+}
+
+func (w *Withdrawer) send(ctx context.Context, data []byte) (*types.Receipt, error) {
+	receipt, err := w.txMgr.Send(ctx, txmgr.TxCandidate{
+		TxData: data,
+		To:     &w.cfg.PortalAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("tx %s reverted", receipt.TxHash)
+	}
+	return receipt, nil
+}
+
+// outputProposed checks that the output at the given index is still the given output root,
+// and was not deleted or replaced.
+func outputProposed(opts *bind.CallOpts, l2oo *bindings.L2OutputOracleCaller, index *big.Int, root [32]byte) (bool, error) {
+	next, err := l2oo.NextOutputIndex(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch next output index: %w", err)
+	}
+	if index.Cmp(next) >= 0 {
+		return false, nil
+	}
+	output, err := l2oo.GetL2Output(opts, index)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch output %d: %w", index, err)
+	}
+	return output.OutputRoot == root, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
@@ -44,12 +43,10 @@ type Challenger struct {
 	// l2 Output Oracle contract
 	l2ooContract     *bindings.L2OutputOracleCaller
 	l2ooContractAddr common.Address
-	l2ooABI          *abi.ABI
 
 	// dispute game factory contract
 	dgfContract     *bindings.DisputeGameFactoryCaller
 	dgfContractAddr common.Address
-	dgfABI          *abi.ABI
 
 	networkTimeout time.Duration
 }
@@ -65,7 +62,7 @@ func (c *Challenger) Client() *ethclient.Client {
 }
 
 func (c *Challenger) NewOracleSubscription() (*Subscription, error) {
-	query, err := BuildOutputLogFilter(c.l2ooABI)
+	query, err := BuildOutputLogFilter(c.l2ooContractAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +71,7 @@ func (c *Challenger) NewOracleSubscription() (*Subscription, error) {
 
 // NewFactorySubscription creates a new [Subscription] listening to the DisputeGameFactory contract.
 func (c *Challenger) NewFactorySubscription() (*Subscription, error) {
-	query, err := BuildDisputeGameLogFilter(c.dgfABI)
+	query, err := BuildDisputeGameLogFilter(c.dgfContractAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -125,18 +122,6 @@ func NewChallenger(cfg config.Config, l log.Logger, m metrics.Metricer) (*Challe
 	}
 	l.Info("Connected to L2OutputOracle", "address", cfg.L2OOAddress, "version", version)
 
-	parsedL2oo, err := bindings.L2OutputOracleMetaData.GetAbi()
-	if err != nil {
-		cancel()
-		return nil, err
-	}
-
-	parsedDgf, err := bindings.DisputeGameFactoryMetaData.GetAbi()
-	if err != nil {
-		cancel()
-		return nil, err
-	}
-
 	return &Challenger{
 		txMgr: txManager,
 		done:  make(chan struct{}),
@@ -153,11 +138,9 @@ func NewChallenger(cfg config.Config, l log.Logger, m metrics.Metricer) (*Challe
 
 		l2ooContract:     l2ooContract,
 		l2ooContractAddr: cfg.L2OOAddress,
-		l2ooABI:          parsedL2oo,
 
 		dgfContract:     dgfContract,
 		dgfContractAddr: cfg.DGFAddress,
-		dgfABI:          parsedDgf,
 
 		networkTimeout: cfg.NetworkTimeout,
 	}, nil
@@ -5,42 +5,21 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	eth "github.com/ethereum/go-ethereum"
-	abi "github.com/ethereum/go-ethereum/accounts/abi"
 	common "github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 )
 
 // TestBuildDisputeGameLogFilter_Succeeds tests that the DisputeGame
 // Log Filter is built correctly.
 func TestBuildDisputeGameLogFilter_Succeeds(t *testing.T) {
-	event := abi.Event{
-		ID: [32]byte{0x01},
-	}
+	dgfAddr := common.Address{0xbb}
 
-	filterQuery := eth.FilterQuery{
-		Topics: [][]common.Hash{
-			{event.ID},
-		},
-	}
-
-	dgfABI := abi.ABI{
-		Events: map[string]abi.Event{
-			"DisputeGameCreated": event,
-		},
-	}
-
-	query, err := BuildDisputeGameLogFilter(&dgfABI)
-	require.Equal(t, filterQuery, query)
+	query, err := BuildDisputeGameLogFilter(dgfAddr)
 	require.NoError(t, err)
-}
-
-// TestBuildDisputeGameLogFilter_Fails tests that the DisputeGame
-// Log Filter fails when the event definition is missing.
-func TestBuildDisputeGameLogFilter_Fails(t *testing.T) {
-	dgfABI := abi.ABI{
-		Events: map[string]abi.Event{},
+	require.Equal(t, []common.Address{dgfAddr}, query.Addresses)
+	require.Equal(t, []common.Hash{bindings.DisputeGameFactoryDisputeGameCreatedTopic}, query.Topics[0])
+	for _, topic := range query.Topics[1:] {
+		require.Empty(t, topic)
 	}
-
-	_, err := BuildDisputeGameLogFilter(&dgfABI)
-	require.ErrorIs(t, ErrMissingFactoryEvent, err)
 }
@@ -5,48 +5,22 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	eth "github.com/ethereum/go-ethereum"
-	abi "github.com/ethereum/go-ethereum/accounts/abi"
 	common "github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 )
 
 // TestBuildOutputLogFilter_Succeeds tests that the Output
 // Log Filter is built correctly.
 func TestBuildOutputLogFilter_Succeeds(t *testing.T) {
-	// Create a mock event id
-	event := abi.Event{
-		ID: [32]byte{0x01},
-	}
-
-	filterQuery := eth.FilterQuery{
-		Topics: [][]common.Hash{
-			{event.ID},
-		},
-	}
-
-	// Mock the ABI
-	l2ooABI := abi.ABI{
-		Events: map[string]abi.Event{
-			"OutputProposed": event,
-		},
-	}
+	l2ooAddr := common.Address{0xaa}
 
 	// Build the filter
-	query, err := BuildOutputLogFilter(&l2ooABI)
-	require.Equal(t, filterQuery, query)
+	query, err := BuildOutputLogFilter(l2ooAddr)
 	require.NoError(t, err)
-}
-
-// TestBuildOutputLogFilter_Fails tests that the Output
-// Log Filter fails when the event definition is missing.
-func TestBuildOutputLogFilter_Fails(t *testing.T) {
-	// Mock the ABI
-	l2ooABI := abi.ABI{
-		Events: map[string]abi.Event{},
+	require.Equal(t, []common.Address{l2ooAddr}, query.Addresses)
+	require.Equal(t, []common.Hash{bindings.L2OutputOracleOutputProposedTopic}, query.Topics[0])
+	for _, topic := range query.Topics[1:] {
+		require.Empty(t, topic)
 	}
-
-	// Build the filter
-	_, err := BuildOutputLogFilter(&l2ooABI)
-	require.Error(t, err)
-	require.ErrorIs(t, err, ErrMissingEvent)
 }
@@ -3,7 +3,7 @@ package challenger
 import (
 	"context"
 	"errors"
-	"math/big"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -31,14 +31,18 @@ func (c *Challenger) ParseOutputLog(log *types.Log) (*bindings.TypesOutputPropos
 		return nil, ErrInvalidOutputTopicLength
 	}
 	// Validate the first topic is the output log topic
-	if log.Topics[0] != c.l2ooABI.Events["OutputProposed"].ID {
+	if log.Topics[0] != bindings.L2OutputOracleOutputProposedTopic {
 		return nil, ErrInvalidOutputLogTopic
 	}
-	l2BlockNumber := new(big.Int).SetBytes(log.Topics[3][:])
-	expected := log.Topics[1]
+	event, err := bindings.DecodeContractLog("L2OutputOracle", *log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OutputProposed event: %w", err)
+	}
+	proposed := event.(*bindings.L2OutputOracleOutputProposed)
 	return &bindings.TypesOutputProposal{
-		L2BlockNumber: l2BlockNumber,
-		OutputRoot:    eth.Bytes32(expected),
+		OutputRoot:    proposed.OutputRoot,
+		Timestamp:     proposed.L1Timestamp,
+		L2BlockNumber: proposed.L2BlockNumber,
 	}, nil
 }
 
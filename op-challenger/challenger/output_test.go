@@ -23,23 +23,24 @@ import (
 
 func TestChallenger_OutputProposed_Signature(t *testing.T) {
 	computed := crypto.Keccak256Hash([]byte("OutputProposed(bytes32,uint256,uint256,uint256)"))
-	challenger := newTestChallenger(t, eth.OutputResponse{}, true)
-	expected := challenger.l2ooABI.Events["OutputProposed"].ID
-	require.Equal(t, expected, computed)
+	require.Equal(t, bindings.L2OutputOracleOutputProposedTopic, computed)
 }
 
 func TestParseOutputLog_Succeeds(t *testing.T) {
 	challenger := newTestChallenger(t, eth.OutputResponse{}, true)
 	expectedBlockNumber := big.NewInt(0x04)
 	expectedOutputRoot := [32]byte{0x02}
-	logTopic := challenger.l2ooABI.Events["OutputProposed"].ID
+	expectedTimestamp := big.NewInt(0x05)
+	logTopic := bindings.L2OutputOracleOutputProposedTopic
 	log := types.Log{
 		Topics: []common.Hash{logTopic, common.Hash(expectedOutputRoot), {0x03}, common.BigToHash(expectedBlockNumber)},
+		Data:   common.BigToHash(expectedTimestamp).Bytes(),
 	}
 	outputProposal, err := challenger.ParseOutputLog(&log)
 	require.NoError(t, err)
 	require.Equal(t, expectedBlockNumber, outputProposal.L2BlockNumber)
 	require.Equal(t, expectedOutputRoot, outputProposal.OutputRoot)
+	require.Equal(t, expectedTimestamp, outputProposal.Timestamp)
 }
 
 func TestParseOutputLog_WrongLogTopic_Errors(t *testing.T) {
@@ -52,7 +53,7 @@ func TestParseOutputLog_WrongLogTopic_Errors(t *testing.T) {
 
 func TestParseOutputLog_WrongTopicLength_Errors(t *testing.T) {
 	challenger := newTestChallenger(t, eth.OutputResponse{}, true)
-	logTopic := challenger.l2ooABI.Events["OutputProposed"].ID
+	logTopic := bindings.L2OutputOracleOutputProposedTopic
 	_, err := challenger.ParseOutputLog(&types.Log{
 		Topics: []common.Hash{logTopic, {0x02}, {0x03}},
 	})
@@ -201,14 +202,11 @@ func newTestChallenger(t *testing.T, output eth.OutputResponse, errors bool) *Ch
 	outputApi := newMockOutputApi(output, errors)
 	log := testlog.Logger(t, log.LvlError)
 	metr := metrics.NewMetrics("test")
-	parsedL2oo, err := bindings.L2OutputOracleMetaData.GetAbi()
-	require.NoError(t, err)
 	challenger := Challenger{
 		rollupClient:   outputApi,
 		log:            log,
 		metr:           metr,
 		networkTimeout: time.Duration(5) * time.Second,
-		l2ooABI:        parsedL2oo,
 	}
 	return &challenger
 }
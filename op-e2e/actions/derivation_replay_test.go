@@ -0,0 +1,92 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/replay"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+func TestDerivationReplay(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	dp := e2eutils.MakeDeployParams(t, defaultRollupTestParams)
+	sd := e2eutils.Setup(t, dp, defaultAlloc)
+	log := testlog.Logger(t, log.LvlDebug)
+	miner, seqEngine, sequencer := setupSequencerTest(t, sd, log)
+	verifEngine, _ := setupVerifier(t, sd, log, miner.L1Client(t, sd.RollupCfg))
+
+	batcher := NewL2Batcher(log, sd.RollupCfg, &BatcherCfg{
+		MinL1TxSize: 0,
+		MaxL1TxSize: 128_000,
+		BatcherKey:  dp.Secrets.Batcher,
+	}, sequencer.RollupClient(), miner.EthClient(), seqEngine.EthClient())
+
+	// build a L2 chain across a few L1 blocks, and submit all of it to L1
+	sequencer.ActL2PipelineFull(t)
+	for i := 0; i < 3; i++ {
+		miner.ActEmptyBlock(t)
+		sequencer.ActL1HeadSignal(t)
+		sequencer.ActBuildToL1Head(t)
+		batcher.ActSubmitAll(t)
+		miner.ActL1StartBlock(12)(t)
+		miner.ActL1IncludeTx(dp.Addresses.Batcher)(t)
+		miner.ActL1EndBlock(t)
+	}
+	l1Head := miner.l1Chain.CurrentBlock().Number.Uint64()
+	l2Head := sequencer.L2Unsafe()
+	require.Greater(t, l2Head.Number, uint64(0))
+
+	var trace bytes.Buffer
+	tracer := replay.NewTracer(&trace)
+
+	recorder := replay.NewRecordingL1(miner.L1Client(t, sd.RollupCfg))
+	l2 := seqEngine.EngineClient(t, sd.RollupCfg)
+	result, err := replay.Replay(t.Ctx(), log, sd.RollupCfg, recorder, l2, tracer, 0, l1Head)
+	require.NoError(t, err)
+	require.Empty(t, result.Mismatch)
+	require.Equal(t, l2Head.Number, result.Derived)
+	require.Equal(t, l2Head, result.SafeHead)
+	require.Equal(t, l1Head, result.L1Origin.Number)
+
+	// every stage is traced, and every derived block matches
+	stages := make(map[string]int)
+	accepted := 0
+	for _, line := range strings.Split(strings.TrimSpace(trace.String()), "\n") {
+		var ev replay.Event
+		require.NoError(t, json.Unmarshal([]byte(line), &ev))
+		stages[ev.Stage]++
+		if ev.Decision == replay.DecisionAccept {
+			accepted++
+		}
+		if ev.Msg == "derived L2 block" {
+			require.Equal(t, true, ev.Fields["match"])
+		}
+	}
+	for _, stage := range []string{replay.StageL1, replay.StageFrame, replay.StageChannel, replay.StageBatch, replay.StageAttributes} {
+		require.NotZero(t, stages[stage], "expected events of stage %s: %v", stage, stages)
+	}
+	require.Equal(t, int(l2Head.Number), accepted, "every L2 block is derived from an accepted batch")
+
+	// the recorded L1 data can be replayed without L1 RPC
+	fixturePath := filepath.Join(t.TempDir(), "l1.json")
+	require.NoError(t, recorder.Fixture().Write(fixturePath))
+	fixture, err := replay.LoadFixture(fixturePath)
+	require.NoError(t, err)
+	fixtureResult, err := replay.Replay(t.Ctx(), log, sd.RollupCfg, replay.NewFixtureL1(fixture), l2, tracer, 0, l1Head)
+	require.NoError(t, err)
+	require.Equal(t, result, fixtureResult)
+
+	// replaying against a L2 chain that does not have the blocks reports the first missing block
+	result, err = replay.Replay(t.Ctx(), log, sd.RollupCfg, replay.NewFixtureL1(fixture), verifEngine.EngineClient(t, sd.RollupCfg), tracer, 0, l1Head)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result.Derived)
+	require.Equal(t, "L2 chain does not have block 1", result.Mismatch)
+}
@@ -0,0 +1,250 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+// FaultGameType is the game type of the FaultDisputeGame, as defined by GameTypes.FAULT in the contracts
+const FaultGameType uint8 = 0
+
+// GameStatus is the status of a dispute game, as defined by the GameStatus enum in the contracts
+type GameStatus uint8
+
+const (
+	GameStatusInProgress GameStatus = iota
+	GameStatusChallengerWins
+	GameStatusDefenderWins
+)
+
+func (s GameStatus) String() string {
+	switch s {
+	case GameStatusInProgress:
+		return "in progress"
+	case GameStatusChallengerWins:
+		return "challenger wins"
+	case GameStatusDefenderWins:
+		return "defender wins"
+	default:
+		return fmt.Sprintf("unknown status %d", uint8(s))
+	}
+}
+
+type FaultGameCfg struct {
+	// MaxDepth is the maximum depth of the game tree, the trace is 2^MaxDepth steps long.
+	// The claims at the maximum depth are made by the proposer if it is even, and by the challenger if it is odd.
+	// They can only be countered by steps, and a correct claim of trace index 0 cannot be stepped against:
+	// the honest player must make the claims at the maximum depth to win against a trace that diverges at index 1.
+	MaxDepth uint64
+	// AbsolutePrestate is the state before the first step of the trace
+	AbsolutePrestate common.Hash
+}
+
+// FaultGameDeployment holds the addresses of the dispute game contracts deployed to the action-test L1
+type FaultGameDeployment struct {
+	DisputeGameFactoryProxy common.Address
+	FaultDisputeGame        common.Address
+	AlphabetVM              common.Address
+}
+
+// DeployFaultDisputeGames deploys a DisputeGameFactory, owned by the deployer, that creates FaultDisputeGames
+// stepping on the AlphabetVM. The deployment txs are included in new L1 blocks.
+func DeployFaultDisputeGames(t Testing, miner *L1Miner, deployer *ecdsa.PrivateKey, cfg *FaultGameCfg) *FaultGameDeployment {
+	l1 := miner.EthClient()
+	from := crypto.PubkeyToAddress(deployer.PublicKey)
+	opts := l1TransactOpts(t, l1, deployer)
+
+	vmTx, err := sendL1Tx(t, l1, deployer, nil, alphabetVMInitCode(cfg.AbsolutePrestate))
+	require.NoError(t, err)
+	vmAddr := crypto.CreateAddress(from, vmTx.Nonce())
+	gameAddr, gameTx, _, err := bindings.DeployFaultDisputeGame(opts, l1, cfg.AbsolutePrestate, new(big.Int).SetUint64(cfg.MaxDepth), vmAddr)
+	require.NoError(t, err)
+	factoryAddr, factoryTx, _, err := bindings.DeployDisputeGameFactory(opts, l1)
+	require.NoError(t, err)
+	proxyAddr, proxyTx, proxy, err := bindings.DeployProxy(opts, l1, from)
+	require.NoError(t, err)
+	includeL1Txs(t, miner, from, vmTx, gameTx, factoryTx, proxyTx)
+
+	factoryABI, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	initialize, err := factoryABI.Pack("initialize", from)
+	require.NoError(t, err)
+	upgradeTx, err := proxy.UpgradeToAndCall(opts, factoryAddr, initialize)
+	require.NoError(t, err)
+	includeL1Txs(t, miner, from, upgradeTx)
+
+	factory, err := bindings.NewDisputeGameFactory(proxyAddr, l1)
+	require.NoError(t, err)
+	implTx, err := factory.SetImplementation(opts, FaultGameType, gameAddr)
+	require.NoError(t, err)
+	includeL1Txs(t, miner, from, implTx)
+
+	return &FaultGameDeployment{
+		DisputeGameFactoryProxy: proxyAddr,
+		FaultDisputeGame:        gameAddr,
+		AlphabetVM:              vmAddr,
+	}
+}
+
+// includeL1Txs includes the pending txs of the account in a new L1 block, and checks that the given txs succeeded.
+func includeL1Txs(t Testing, miner *L1Miner, from common.Address, txs ...*types.Transaction) {
+	miner.ActL1StartBlock(12)(t)
+	miner.ActL1IncludeAllTxs(from)(t)
+	miner.ActL1EndBlock(t)
+	for _, tx := range txs {
+		receipt, err := miner.EthClient().TransactionReceipt(t.Ctx(), tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "tx %s failed", tx.Hash())
+	}
+}
+
+func l1TransactOpts(t Testing, l1 *ethclient.Client, key *ecdsa.PrivateKey) *bind.TransactOpts {
+	chainID, err := l1.ChainID(t.Ctx())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+	opts.Context = t.Ctx()
+	opts.GasTipCap, opts.GasFeeCap = l1Fees(t, l1)
+	return opts
+}
+
+func l1Fees(t Testing, l1 *ethclient.Client) (*big.Int, *big.Int) {
+	gasTipCap := big.NewInt(2 * params.GWei)
+	pendingHeader, err := l1.HeaderByNumber(t.Ctx(), big.NewInt(-1))
+	require.NoError(t, err, "need l1 pending header for gas price estimation")
+	gasFeeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(pendingHeader.BaseFee, big.NewInt(2)))
+	return gasTipCap, gasFeeCap
+}
+
+// sendL1Tx sends a tx to the L1 tx pool, to be included by the miner. A nil recipient creates a contract.
+// Unlike other actions, a failing gas estimation is returned rather than failing the test:
+// it is how dispute game players learn that a move or step is not valid (anymore).
+func sendL1Tx(t Testing, l1 *ethclient.Client, key *ecdsa.PrivateKey, to *common.Address, data []byte) (*types.Transaction, error) {
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	gasTipCap, gasFeeCap := l1Fees(t, l1)
+	chainID, err := l1.ChainID(t.Ctx())
+	require.NoError(t, err)
+	nonce, err := l1.PendingNonceAt(t.Ctx(), from)
+	require.NoError(t, err)
+
+	gasLimit, err := l1.EstimateGas(t.Ctx(), ethereum.CallMsg{
+		From:      from,
+		To:        to,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		Nonce:     nonce,
+		To:        to,
+		Data:      data,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Gas:       gasLimit,
+		ChainID:   chainID,
+	})
+	require.NoError(t, err, "need to sign tx")
+	require.NoError(t, l1.SendTransaction(t.Ctx(), tx), "need to send tx")
+	return tx, nil
+}
+
+// alphabetVMInitCode returns the creation code of the AlphabetVM of the FaultDisputeGame contract tests.
+// Its step(bytes,bytes) decodes (traceIndex, claim) from the state data, or starts at (0, absolutePrestate)
+// if the state data is empty, and returns keccak256(abi.encode(traceIndex + 1, claim + 1)).
+// The contract is assembled by hand, as the test contracts are not part of the bindings.
+func alphabetVMInitCode(absolutePrestate common.Hash) []byte {
+	runtime := []byte{
+		0x60, 0x04, 0x35, // PUSH1 4 CALLDATALOAD: offset of the state data
+		0x60, 0x04, 0x01, // PUSH1 4 ADD: position of the state data length
+		0x80, 0x35, 0x15, // DUP1 CALLDATALOAD ISZERO
+		0x60, 0x1c, 0x57, // PUSH1 empty JUMPI
+		0x80, 0x60, 0x20, 0x01, 0x35, // DUP1 PUSH1 32 ADD CALLDATALOAD: trace index
+		0x60, 0x01, 0x01, // PUSH1 1 ADD: next trace index
+		0x90, 0x60, 0x40, 0x01, 0x35, // SWAP1 PUSH1 64 ADD CALLDATALOAD: claim
+		0x60, 0x41, 0x56, // PUSH1 step JUMP
+		0x5b, 0x50, // empty: JUMPDEST POP
+		0x60, 0x00, // PUSH1 0: trace index
+		0x7f, // PUSH32 absolute prestate: claim
+	}
+	runtime = append(runtime, absolutePrestate.Bytes()...)
+	runtime = append(runtime,
+		0x5b,             // step: JUMPDEST
+		0x60, 0x01, 0x01, // PUSH1 1 ADD: next claim
+		0x60, 0x20, 0x52, // PUSH1 32 MSTORE
+		0x60, 0x00, 0x52, // PUSH1 0 MSTORE
+		0x60, 0x40, 0x60, 0x00, 0x20, // PUSH1 64 PUSH1 0 SHA3
+		0x60, 0x00, 0x52, // PUSH1 0 MSTORE
+		0x60, 0x20, 0x60, 0x00, 0xf3, // PUSH1 32 PUSH1 0 RETURN
+	)
+	// copy the runtime code into memory and return it
+	initCode := []byte{
+		0x60, byte(len(runtime)), 0x80, // PUSH1 len DUP1
+		0x60, 0x0b, 0x60, 0x00, 0x39, // PUSH1 11 PUSH1 0 CODECOPY
+		0x60, 0x00, 0xf3, // PUSH1 0 RETURN
+	}
+	return append(initCode, runtime...)
+}
+
+// AlphabetVMTrace is a deterministic trace of the AlphabetVM, of which each step increments a counter.
+// Each claim commits to the trace index and counter of its state, which form the state data to step from.
+// A trace that diverges from the honest trace keeps stepping from a different counter after the divergence.
+type AlphabetVMTrace struct {
+	prestate  *big.Int
+	maxLen    uint64
+	divergeAt uint64
+}
+
+// NewHonestTrace creates the trace of the AlphabetVM, which steps from the absolute prestate.
+func NewHonestTrace(cfg *FaultGameCfg) *AlphabetVMTrace {
+	return NewDishonestTrace(cfg, 1<<cfg.MaxDepth)
+}
+
+// NewDishonestTrace creates a trace that agrees with the honest trace up to, but excluding, the given trace index.
+func NewDishonestTrace(cfg *FaultGameCfg, divergeAt uint64) *AlphabetVMTrace {
+	return &AlphabetVMTrace{
+		prestate:  cfg.AbsolutePrestate.Big(),
+		maxLen:    1 << cfg.MaxDepth,
+		divergeAt: divergeAt,
+	}
+}
+
+// Get returns the claim of the state after the step at the given trace index.
+func (a *AlphabetVMTrace) Get(i uint64) (common.Hash, error) {
+	preimage, err := a.GetPreimage(i)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(preimage), nil
+}
+
+// GetPreimage returns the state data of the state after the step at the given trace index.
+func (a *AlphabetVMTrace) GetPreimage(i uint64) ([]byte, error) {
+	if i >= a.maxLen {
+		return nil, errors.New("index is larger than the maximum index")
+	}
+	counter := new(big.Int).Add(a.prestate, new(big.Int).SetUint64(i+1))
+	if i >= a.divergeAt {
+		counter.Add(counter, big.NewInt(1000))
+	}
+	return abi.Arguments{{Type: uint256Type}, {Type: uint256Type}}.Pack(new(big.Int).SetUint64(i), counter)
+}
+
+var uint256Type, _ = abi.NewType("uint256", "", nil)
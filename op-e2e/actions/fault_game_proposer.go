@@ -0,0 +1,75 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+type FaultGameProposerCfg struct {
+	DisputeGameFactoryAddr common.Address
+	ProposerKey            *ecdsa.PrivateKey
+	Trace                  FaultTrace
+	MaxDepth               uint64
+}
+
+// FaultGameProposer creates fault dispute games with the final claim of its trace as root claim,
+// and defends them by its trace. An honest proposer plays by the honest trace, a dishonest one does not.
+type FaultGameProposer struct {
+	faultGamePlayer
+	factoryAddr common.Address
+	factory     *bindings.DisputeGameFactoryCaller
+	factoryABI  *abi.ABI
+}
+
+func NewFaultGameProposer(t Testing, log log.Logger, cfg *FaultGameProposerCfg, l1 *ethclient.Client) *FaultGameProposer {
+	factory, err := bindings.NewDisputeGameFactoryCaller(cfg.DisputeGameFactoryAddr, l1)
+	require.NoError(t, err)
+	factoryABI, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	return &FaultGameProposer{
+		faultGamePlayer: newFaultGamePlayer(t, log, l1, cfg.ProposerKey, cfg.Trace, cfg.MaxDepth),
+		factoryAddr:     cfg.DisputeGameFactoryAddr,
+		factory:         factory,
+		factoryABI:      factoryABI,
+	}
+}
+
+// RootClaim returns the claim of the last state of the proposer's trace.
+func (p *FaultGameProposer) RootClaim(t Testing) common.Hash {
+	claim, err := p.trace.Get(1<<p.maxDepth - 1)
+	require.NoError(t, err)
+	return claim
+}
+
+// ActCreateGame creates a game for the output of the L2 block, with the root claim of the proposer.
+func (p *FaultGameProposer) ActCreateGame(l2BlockNumber uint64) Action {
+	return func(t Testing) {
+		data, err := p.factoryABI.Pack("create", FaultGameType, p.RootClaim(t), gameExtraData(l2BlockNumber))
+		require.NoError(t, err)
+		_, err = sendL1Tx(t, p.l1, p.privKey, &p.factoryAddr, data)
+		require.NoError(t, err, "game must not exist yet")
+	}
+}
+
+// GameAddr returns the address of the game that the proposer created for the output of the L2 block,
+// or the zero address if the game was not created (yet).
+func (p *FaultGameProposer) GameAddr(t Testing, l2BlockNumber uint64) common.Address {
+	game, err := p.factory.Games(&bind.CallOpts{Context: t.Ctx()}, FaultGameType, p.RootClaim(t), gameExtraData(l2BlockNumber))
+	require.NoError(t, err)
+	return game.Proxy
+}
+
+// gameExtraData encodes the L2 block number of the output as the extra data of the dispute game,
+// the same way op-proposer does.
+func gameExtraData(l2BlockNumber uint64) []byte {
+	return common.BigToHash(new(big.Int).SetUint64(l2BlockNumber)).Bytes()
+}
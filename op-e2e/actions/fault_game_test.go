@@ -0,0 +1,198 @@
+package actions
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+// gameDuration is the duration of a FaultDisputeGame, each side has half of it on their clock
+const gameDuration = 7 * 24 * 60 * 60
+
+type faultGameTest struct {
+	dp         *e2eutils.DeployParams
+	log        log.Logger
+	miner      *L1Miner
+	cfg        *FaultGameCfg
+	deployment *FaultGameDeployment
+}
+
+func setupFaultGameTest(t Testing, maxDepth uint64) *faultGameTest {
+	dp := e2eutils.MakeDeployParams(t, defaultRollupTestParams)
+	sd := e2eutils.Setup(t, dp, defaultAlloc)
+	log := testlog.Logger(t, log.LvlDebug)
+	miner := NewL1Miner(t, log, sd.L1Cfg)
+	cfg := &FaultGameCfg{
+		MaxDepth:         maxDepth,
+		AbsolutePrestate: common.BigToHash(big.NewInt(100)),
+	}
+	return &faultGameTest{
+		dp:         dp,
+		log:        log,
+		miner:      miner,
+		cfg:        cfg,
+		deployment: DeployFaultDisputeGames(t, miner, dp.Secrets.Deployer, cfg),
+	}
+}
+
+func (f *faultGameTest) proposer(t Testing, trace FaultTrace) *FaultGameProposer {
+	return NewFaultGameProposer(t, f.log, &FaultGameProposerCfg{
+		DisputeGameFactoryAddr: f.deployment.DisputeGameFactoryProxy,
+		ProposerKey:            f.dp.Secrets.Proposer,
+		Trace:                  trace,
+		MaxDepth:               f.cfg.MaxDepth,
+	}, f.miner.EthClient())
+}
+
+func (f *faultGameTest) challenger(t Testing, trace FaultTrace) *L2Challenger {
+	return NewL2Challenger(t, f.log, &ChallengerCfg{
+		DisputeGameFactoryAddr: f.deployment.DisputeGameFactoryProxy,
+		ChallengerKey:          f.dp.Secrets.Alice,
+		Trace:                  trace,
+		MaxDepth:               f.cfg.MaxDepth,
+	}, f.miner.EthClient())
+}
+
+// includeTxs includes the pending txs of the accounts in a new L1 block, timeDelta seconds after the head block
+func (f *faultGameTest) includeTxs(t Testing, timeDelta uint64, from ...common.Address) {
+	f.miner.ActL1StartBlock(timeDelta)(t)
+	for _, addr := range from {
+		f.miner.ActL1IncludeAllTxs(addr)(t)
+	}
+	f.miner.ActL1EndBlock(t)
+}
+
+// createGame creates a game of the proposer, and returns its address
+func (f *faultGameTest) createGame(t Testing, proposer *FaultGameProposer) common.Address {
+	proposer.ActCreateGame(1)(t)
+	f.includeTxs(t, 12, proposer.Address())
+	game := proposer.GameAddr(t, 1)
+	require.NotEqual(t, common.Address{}, game, "game must be created")
+	return game
+}
+
+// playGame lets the challenger and the proposer take turns, until neither of them has anything left to play
+func (f *faultGameTest) playGame(t Testing, game common.Address, proposer *FaultGameProposer, challenger *L2Challenger) {
+	for i := 0; ; i++ {
+		require.Less(t, i, 100, "game must end")
+		before := challenger.GameClaims(t, game)
+		challenger.ActPlayGames(t)
+		f.includeTxs(t, 12, challenger.Address())
+		proposer.ActPlayGame(game)(t)
+		f.includeTxs(t, 12, proposer.Address())
+		if after := challenger.GameClaims(t, game); fmt.Sprint(before) == fmt.Sprint(after) {
+			return
+		}
+	}
+}
+
+func (f *faultGameTest) resolve(t Testing, game common.Address, player *faultGamePlayer) GameStatus {
+	player.ActResolveGame(game)(t)
+	f.includeTxs(t, 12, player.Address())
+	return player.GameStatus(t, game)
+}
+
+func TestFaultGameUnchallenged(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	f := setupFaultGameTest(t, 2)
+	proposer := f.proposer(t, NewHonestTrace(f.cfg))
+	challenger := f.challenger(t, NewHonestTrace(f.cfg))
+
+	game := f.createGame(t, proposer)
+	require.Equal(t, []common.Address{game}, challenger.Games(t))
+	f.playGame(t, game, proposer, challenger)
+	require.Len(t, challenger.GameClaims(t, game), 1, "honest challenger agrees with the root claim")
+	require.Equal(t, GameStatusDefenderWins, f.resolve(t, game, &challenger.faultGamePlayer))
+}
+
+// The FaultDisputeGame marks the parent of every move as countered, and resolves by the left-most uncountered claim.
+// The claims at the maximum depth can only be countered by steps, and are made by the honest player in the games below:
+// a dishonest player that diverges at trace index 1 attacks with the correct claim of trace index 0 at the maximum depth
+// otherwise, which cannot be stepped against. The games of the honest proposer have an even maximum depth of 2,
+// and those of the dishonest proposer an odd maximum depth of 3.
+
+func TestFaultGameHonestProposer(gt *testing.T) {
+	for divergeAt := uint64(0); divergeAt < 4; divergeAt++ {
+		divergeAt := divergeAt
+		gt.Run(fmt.Sprintf("diverge-%d", divergeAt), func(gt *testing.T) {
+			t := NewDefaultTesting(gt)
+			f := setupFaultGameTest(t, 2)
+			proposer := f.proposer(t, NewHonestTrace(f.cfg))
+			challenger := f.challenger(t, NewDishonestTrace(f.cfg, divergeAt))
+
+			game := f.createGame(t, proposer)
+			f.playGame(t, game, proposer, challenger)
+			require.Greater(t, len(challenger.GameClaims(t, game)), 1, "dishonest challenger disputes the root claim")
+			require.Equal(t, GameStatusDefenderWins, f.resolve(t, game, &proposer.faultGamePlayer))
+		})
+	}
+}
+
+func TestFaultGameDishonestProposer(gt *testing.T) {
+	for divergeAt := uint64(0); divergeAt < 8; divergeAt++ {
+		divergeAt := divergeAt
+		gt.Run(fmt.Sprintf("diverge-%d", divergeAt), func(gt *testing.T) {
+			t := NewDefaultTesting(gt)
+			f := setupFaultGameTest(t, 3)
+			proposer := f.proposer(t, NewDishonestTrace(f.cfg, divergeAt))
+			challenger := f.challenger(t, NewHonestTrace(f.cfg))
+
+			game := f.createGame(t, proposer)
+			f.playGame(t, game, proposer, challenger)
+			require.Equal(t, GameStatusChallengerWins, f.resolve(t, game, &challenger.faultGamePlayer))
+		})
+	}
+}
+
+// TestFaultGameClockExpired checks that an honest proposer cannot respond to a challenge anymore once its clock ran out,
+// which leaves the challenge uncountered.
+func TestFaultGameClockExpired(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	f := setupFaultGameTest(t, 2)
+	proposer := f.proposer(t, NewHonestTrace(f.cfg))
+	challenger := f.challenger(t, NewDishonestTrace(f.cfg, 0))
+
+	game := f.createGame(t, proposer)
+	challenger.ActPlayGames(t)
+	f.includeTxs(t, 12, challenger.Address())
+	claims := challenger.GameClaims(t, game)
+	require.Len(t, claims, 2, "challenger must attack the root claim")
+
+	// The proposer stays offline for more than half of the game duration
+	f.includeTxs(t, gameDuration/2+1)
+	proposer.ActPlayGame(game)(t)
+	f.includeTxs(t, 12, proposer.Address())
+	require.Equal(t, claims, proposer.GameClaims(t, game), "proposer must not be able to move after its clock ran out")
+	require.Equal(t, GameStatusChallengerWins, f.resolve(t, game, &proposer.faultGamePlayer))
+}
+
+// TestFaultGameClockNotExpired checks that the time the challenger takes does not count against the clock of the proposer.
+func TestFaultGameClockNotExpired(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	f := setupFaultGameTest(t, 2)
+	proposer := f.proposer(t, NewHonestTrace(f.cfg))
+	challenger := f.challenger(t, NewDishonestTrace(f.cfg, 0))
+
+	game := f.createGame(t, proposer)
+	// The challenger waits almost half of the game duration to attack
+	f.includeTxs(t, gameDuration/2-100)
+	challenger.ActPlayGames(t)
+	f.includeTxs(t, 12, challenger.Address())
+	require.Len(t, challenger.GameClaims(t, game), 2, "challenger must attack the root claim")
+
+	// The proposer also waits almost half of the game duration to respond
+	f.includeTxs(t, gameDuration/2-100)
+	proposer.ActPlayGame(game)(t)
+	f.includeTxs(t, 12, proposer.Address())
+	require.Len(t, proposer.GameClaims(t, game), 3, "proposer must still be able to respond")
+
+	f.playGame(t, game, proposer, challenger)
+	require.Equal(t, GameStatusDefenderWins, f.resolve(t, game, &proposer.faultGamePlayer))
+}
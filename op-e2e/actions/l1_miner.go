@@ -106,6 +106,25 @@ func (s *L1Miner) ActL1IncludeTx(from common.Address) Action {
 	}
 }
 
+// ActL1IncludeAllTxs includes all pending txs from the given account in the L1 tx pool.
+// Txs that the lagging tx pool still holds, but were already included, are skipped.
+func (s *L1Miner) ActL1IncludeAllTxs(from common.Address) Action {
+	return func(t Testing) {
+		if !s.l1Building {
+			t.InvalidAction("no tx inclusion when not building l1 block")
+			return
+		}
+		txs, _ := s.eth.TxPool().ContentFrom(from)
+		for _, tx := range txs {
+			if tx.Nonce() < s.l1BuildingState.GetNonce(from) {
+				continue
+			}
+			s.IncludeTx(t, tx)
+		}
+		s.pendingIndices[from] = uint64(len(txs))
+	}
+}
+
 func (s *L1Miner) IncludeTx(t Testing, tx *types.Transaction) {
 	from, err := s.l1Signer.Sender(tx)
 	require.NoError(t, err)
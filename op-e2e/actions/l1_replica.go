@@ -43,6 +43,8 @@ type L1Replica struct {
 	l1Signer   types.Signer
 
 	failL1RPC func() error // mock error
+
+	rpcRecorder *rpcRecorder
 }
 
 // NewL1Replica constructs a L1Replica starting at the given genesis.
@@ -78,14 +80,15 @@ func NewL1Replica(t Testing, log log.Logger, genesis *core.Genesis) *L1Replica {
 
 	require.NoError(t, n.Start(), "failed to start L1 geth node")
 	return &L1Replica{
-		log:        log,
-		node:       n,
-		eth:        backend,
-		l1Chain:    backend.BlockChain(),
-		l1Database: backend.ChainDb(),
-		l1Cfg:      genesis,
-		l1Signer:   types.LatestSigner(genesis.Config),
-		failL1RPC:  nil,
+		log:         log,
+		rpcRecorder: newRPCRecorder(t, "l1"),
+		node:        n,
+		eth:         backend,
+		l1Chain:     backend.BlockChain(),
+		l1Database:  backend.ChainDb(),
+		l1Cfg:       genesis,
+		l1Signer:    types.LatestSigner(genesis.Config),
+		failL1RPC:   nil,
 	}
 }
 
@@ -168,7 +171,7 @@ func (s *L1Replica) EthClient() *ethclient.Client {
 func (s *L1Replica) RPCClient() client.RPC {
 	cl, _ := s.node.Attach() // never errors
 	return testutils.RPCErrFaker{
-		RPC: client.NewBaseRPCClient(cl),
+		RPC: s.rpcRecorder.wrap(client.NewBaseRPCClient(cl)),
 		ErrFn: func() error {
 			if s.failL1RPC != nil {
 				return s.failL1RPC()
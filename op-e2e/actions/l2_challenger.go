@@ -0,0 +1,276 @@
+package actions
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+)
+
+// FaultTrace is the trace a fault dispute game player plays by.
+// The pre-images of its claims are the state data that the VM steps from.
+type FaultTrace interface {
+	fault.TraceProvider
+	GetPreimage(i uint64) ([]byte, error)
+}
+
+// FaultGameClaim is a claim of a fault dispute game, as stored in the game contract
+type FaultGameClaim struct {
+	fault.Claim
+	Countered bool
+}
+
+// faultGamePlayer plays fault dispute games by its trace: it moves with the op-challenger solver,
+// and steps on the claims at the maximum depth of the game.
+type faultGamePlayer struct {
+	log      log.Logger
+	l1       *ethclient.Client
+	privKey  *ecdsa.PrivateKey
+	address  common.Address
+	trace    FaultTrace
+	maxDepth int
+	gameABI  *abi.ABI
+}
+
+func newFaultGamePlayer(t Testing, log log.Logger, l1 *ethclient.Client, key *ecdsa.PrivateKey, trace FaultTrace, maxDepth uint64) faultGamePlayer {
+	gameABI, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(t, err)
+	return faultGamePlayer{
+		log:      log,
+		l1:       l1,
+		privKey:  key,
+		address:  crypto.PubkeyToAddress(key.PublicKey),
+		trace:    trace,
+		maxDepth: int(maxDepth),
+		gameABI:  gameABI,
+	}
+}
+
+func (p *faultGamePlayer) Address() common.Address {
+	return p.address
+}
+
+// GameClaims returns the claims of the game, in the order they were made.
+func (p *faultGamePlayer) GameClaims(t Testing, game common.Address) []FaultGameClaim {
+	caller, err := bindings.NewFaultDisputeGameCaller(game, p.l1)
+	require.NoError(t, err)
+	opts := &bind.CallOpts{Context: t.Ctx()}
+	count, err := caller.ClaimDataLen(opts)
+	require.NoError(t, err)
+	claims := make([]FaultGameClaim, 0, count.Uint64())
+	for i := uint64(0); i < count.Uint64(); i++ {
+		data, err := caller.ClaimData(opts, new(big.Int).SetUint64(i))
+		require.NoError(t, err)
+		claim := FaultGameClaim{
+			Claim: fault.Claim{
+				ClaimData: fault.ClaimData{
+					Value:    data.Claim,
+					Position: fault.NewPositionFromGIndex(data.Position.Uint64()),
+				},
+				ContractIndex: int(i),
+			},
+			Countered: data.Countered,
+		}
+		if i > 0 {
+			claim.Parent = claims[data.ParentIndex].ClaimData
+			claim.ParentContractIndex = int(data.ParentIndex)
+		}
+		claims = append(claims, claim)
+	}
+	return claims
+}
+
+// GameStatus returns the status of the game.
+func (p *faultGamePlayer) GameStatus(t Testing, game common.Address) GameStatus {
+	caller, err := bindings.NewFaultDisputeGameCaller(game, p.l1)
+	require.NoError(t, err)
+	status, err := caller.Status(&bind.CallOpts{Context: t.Ctx()})
+	require.NoError(t, err)
+	return GameStatus(status)
+}
+
+// ActPlayGame makes all the moves and steps that the player's trace calls for in the current state of the game.
+// Moves and steps that the game rejects, e.g. because the clock of the parent claim ran out, are skipped.
+func (p *faultGamePlayer) ActPlayGame(game common.Address) Action {
+	return func(t Testing) {
+		claims := p.GameClaims(t, game)
+		state := fault.NewGameState(claims[0].Claim)
+		indices := map[fault.ClaimData]int{claims[0].ClaimData: 0}
+		for i, claim := range claims[1:] {
+			require.NoError(t, state.Put(claim.Claim))
+			indices[claim.ClaimData] = i + 1
+		}
+		responder := &faultGameResponder{t: t, player: p, game: game, indices: indices}
+		agent := fault.NewAgent(state, p.maxDepth, p.trace, responder, p.log)
+		agent.PerformActions()
+		p.step(t, game, claims)
+	}
+}
+
+// ActResolveGame resolves the game.
+func (p *faultGamePlayer) ActResolveGame(game common.Address) Action {
+	return func(t Testing) {
+		data, err := p.gameABI.Pack("resolve")
+		require.NoError(t, err)
+		_, err = sendL1Tx(t, p.l1, p.privKey, &game, data)
+		require.NoError(t, err, "game must be resolvable")
+	}
+}
+
+// step counters the claims at the maximum depth of the game, which cannot be countered by moves anymore.
+// As with moves, claims we disagree with are attacked, and claims we agree with are defended if we also agree
+// with their parent. The VM then steps from our pre-image of the claim before the step.
+func (p *faultGamePlayer) step(t Testing, game common.Address, claims []FaultGameClaim) {
+	for i, claim := range claims {
+		if claim.Depth() != p.maxDepth || claim.Countered {
+			continue
+		}
+		parentCorrect, err := p.agree(claim.Parent)
+		require.NoError(t, err)
+		claimCorrect, err := p.agree(claim.ClaimData)
+		require.NoError(t, err)
+		index := claim.TraceIndex(p.maxDepth)
+
+		var stateIndex int
+		var stateData []byte
+		isAttack := !claimCorrect
+		switch {
+		case isAttack:
+			// The first step starts at the absolute prestate, which is not a claim
+			if index == 0 {
+				break
+			}
+			// Otherwise the pre-state must be a claim of the previous trace index, which we agree with
+			stateIndex = p.findClaim(t, claims, index-1, true)
+			if stateIndex < 0 {
+				continue
+			}
+			stateData, err = p.trace.GetPreimage(index - 1)
+			require.NoError(t, err)
+		case parentCorrect:
+			// The post-state must be a claim of the next trace index, which we disagree with
+			stateIndex = p.findClaim(t, claims, index+1, false)
+			if stateIndex < 0 {
+				continue
+			}
+			stateData, err = p.trace.GetPreimage(index)
+			require.NoError(t, err)
+		default:
+			continue
+		}
+
+		data, err := p.gameABI.Pack("step", big.NewInt(int64(stateIndex)), big.NewInt(int64(i)), isAttack, stateData, []byte{})
+		require.NoError(t, err)
+		log := p.log.New("claim_index", i, "trace_index", index, "is_attack", isAttack, "state_index", stateIndex)
+		if _, err := sendL1Tx(t, p.l1, p.privKey, &game, data); err != nil {
+			log.Info("Step rejected", "err", err)
+			continue
+		}
+		log.Info("Performing step")
+	}
+}
+
+// findClaim returns the index of a claim of the given trace index that we agree or disagree with, or -1 if none.
+func (p *faultGamePlayer) findClaim(t Testing, claims []FaultGameClaim, traceIndex uint64, agree bool) int {
+	if traceIndex >= 1<<p.maxDepth {
+		return -1
+	}
+	for i, claim := range claims {
+		if claim.TraceIndex(p.maxDepth) != traceIndex {
+			continue
+		}
+		ok, err := p.agree(claim.ClaimData)
+		require.NoError(t, err)
+		if ok == agree {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *faultGamePlayer) agree(claim fault.ClaimData) (bool, error) {
+	value, err := p.trace.Get(claim.TraceIndex(p.maxDepth))
+	return value == claim.Value, err
+}
+
+// faultGameResponder makes the moves of the solver in the game contract
+type faultGameResponder struct {
+	t      Testing
+	player *faultGamePlayer
+	game   common.Address
+	// indices of the claims in the contract
+	indices map[fault.ClaimData]int
+}
+
+func (r *faultGameResponder) Respond(_ context.Context, response fault.Claim) error {
+	parentIndex, ok := r.indices[response.Parent]
+	if !ok {
+		return fmt.Errorf("unknown parent claim %v", response.Parent)
+	}
+	method := "attack"
+	if response.DefendsParent() {
+		method = "defend"
+	}
+	data, err := r.player.gameABI.Pack(method, big.NewInt(int64(parentIndex)), response.Value)
+	if err != nil {
+		return err
+	}
+	_, err = sendL1Tx(r.t, r.player.l1, r.player.privKey, &r.game, data)
+	return err
+}
+
+type ChallengerCfg struct {
+	DisputeGameFactoryAddr common.Address
+	ChallengerKey          *ecdsa.PrivateKey
+	Trace                  FaultTrace
+	MaxDepth               uint64
+}
+
+// L2Challenger plays all fault dispute games created by the DisputeGameFactory, by its trace.
+type L2Challenger struct {
+	faultGamePlayer
+	factory *bindings.DisputeGameFactoryCaller
+}
+
+func NewL2Challenger(t Testing, log log.Logger, cfg *ChallengerCfg, l1 *ethclient.Client) *L2Challenger {
+	factory, err := bindings.NewDisputeGameFactoryCaller(cfg.DisputeGameFactoryAddr, l1)
+	require.NoError(t, err)
+	return &L2Challenger{
+		faultGamePlayer: newFaultGamePlayer(t, log, l1, cfg.ChallengerKey, cfg.Trace, cfg.MaxDepth),
+		factory:         factory,
+	}
+}
+
+// Games returns the games created by the DisputeGameFactory.
+func (c *L2Challenger) Games(t Testing) []common.Address {
+	opts := &bind.CallOpts{Context: t.Ctx()}
+	count, err := c.factory.GameCount(opts)
+	require.NoError(t, err)
+	games := make([]common.Address, 0, count.Uint64())
+	for i := uint64(0); i < count.Uint64(); i++ {
+		game, err := c.factory.GameAtIndex(opts, new(big.Int).SetUint64(i))
+		require.NoError(t, err)
+		games = append(games, game.Proxy)
+	}
+	return games
+}
+
+// ActPlayGames plays all the fault dispute games that are in progress.
+func (c *L2Challenger) ActPlayGames(t Testing) {
+	for _, game := range c.Games(t) {
+		if c.GameStatus(t, game) == GameStatusInProgress {
+			c.ActPlayGame(game)(t)
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 
 // L2Engine is an in-memory implementation of the Engine API,
 // without support for snap-sync, and no concurrency or background processes.
+// Execution-layer sync can be emulated with ActL2SyncFrom.
 type L2Engine struct {
 	log log.Logger
 
@@ -44,6 +45,8 @@ type L2Engine struct {
 	engineApi *engineapi.L2EngineAPI
 
 	failL2RPC error // mock error
+
+	rpcRecorder *rpcRecorder
 }
 
 type EngineOption func(ethCfg *ethconfig.Config, nodeCfg *node.Config) error
@@ -62,9 +65,10 @@ func NewL2Engine(t Testing, log log.Logger, genesis *core.Genesis, rollupGenesis
 			L2:     eth.BlockID{Hash: genesisBlock.Hash(), Number: genesisBlock.NumberU64()},
 			L2Time: genesis.Timestamp,
 		},
-		l2Chain:   chain,
-		l2Signer:  types.LatestSigner(genesis.Config),
-		engineApi: engineApi,
+		l2Chain:     chain,
+		l2Signer:    types.LatestSigner(genesis.Config),
+		engineApi:   engineApi,
+		rpcRecorder: newRPCRecorder(t, "l2"),
 	}
 	// register the custom engine API, so we can serve engine requests while having more control
 	// over sequencing of individual txs.
@@ -144,7 +148,7 @@ func (s *L2Engine) GethClient() *gethclient.Client {
 func (e *L2Engine) RPCClient() client.RPC {
 	cl, _ := e.node.Attach() // never errors
 	return testutils.RPCErrFaker{
-		RPC: client.NewBaseRPCClient(cl),
+		RPC: e.rpcRecorder.wrap(client.NewBaseRPCClient(cl)),
 		ErrFn: func() error {
 			err := e.failL2RPC
 			e.failL2RPC = nil // reset back, only error once.
@@ -193,6 +197,30 @@ func (e *L2Engine) ActL2IncludeTx(from common.Address) Action {
 	}
 }
 
+// ActL2SyncFrom emulates the engine syncing by itself, like snap-sync, towards the given head:
+// the blocks of the source engine up to and including the head, that this engine is missing, are imported.
+func (e *L2Engine) ActL2SyncFrom(source *L2Engine, head common.Hash) Action {
+	return func(t Testing) {
+		var blocks types.Blocks
+		for h := head; e.l2Chain.GetBlockByHash(h) == nil; {
+			block := source.l2Chain.GetBlockByHash(h)
+			if block == nil {
+				t.InvalidAction("source engine does not have block %s to sync", h)
+				return
+			}
+			blocks = append(types.Blocks{block}, blocks...)
+			h = block.ParentHash()
+		}
+		if len(blocks) == 0 {
+			t.InvalidAction("already synced to %s", head)
+			return
+		}
+		_, err := e.l2Chain.InsertChain(blocks)
+		require.NoError(t, err, "failed to import synced blocks")
+		e.log.Info("Synced blocks from source engine", "count", len(blocks), "head", head)
+	}
+}
+
 func (e *L2Engine) Close() error {
 	return e.node.Close()
 }
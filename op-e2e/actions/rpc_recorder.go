@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/client"
+)
+
+// recordRPCDir is the directory that the RPC calls of the L1 and L2 clients of the actors are recorded to,
+// as fixtures to replay with a client.ReplayRPC. Recording is disabled if it is empty.
+var recordRPCDir = os.Getenv("OP_E2E_RECORD_RPC_DIR")
+
+// rpcRecorder records the calls of all the RPC clients of an actor,
+// and saves them as a single fixture when the test ends.
+type rpcRecorder struct {
+	mu         sync.Mutex
+	recordings []*client.RecordingRPC
+}
+
+// newRPCRecorder creates the recorder of the RPC clients of the named actor,
+// or returns nil if recording is disabled.
+func newRPCRecorder(t Testing, name string) *rpcRecorder {
+	if recordRPCDir == "" {
+		return nil
+	}
+	r := &rpcRecorder{}
+	t.Cleanup(func() {
+		if err := r.save(filepath.Join(recordRPCDir, strings.ReplaceAll(t.Name(), "/", "_")), name); err != nil {
+			t.Errorf("failed to save RPC fixture of %s: %v", name, err)
+		}
+	})
+	return r
+}
+
+// wrap records the calls of the RPC client. A nil recorder returns the client as is.
+func (r *rpcRecorder) wrap(rpc client.RPC) client.RPC {
+	if r == nil {
+		return rpc
+	}
+	rec := client.NewRecordingRPC(rpc)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recordings = append(r.recordings, rec)
+	return rec
+}
+
+// save writes the fixture to the directory. Actors of the same name are numbered, as a test may use several.
+func (r *rpcRecorder) save(dir string, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fixture := &client.RPCFixture{}
+	for _, rec := range r.recordings {
+		fixture.Calls = append(fixture.Calls, rec.Fixture().Calls...)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, name+".json.gz")
+	for i := 1; ; i++ {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			break
+		} else if err != nil {
+			return err
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s-%d.json.gz", name, i))
+	}
+	return fixture.Save(path)
+}
@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
@@ -92,3 +95,62 @@ func TestFinalizeWhileSyncing(gt *testing.T) {
 	// Verify the verifier finalized something new
 	require.Less(t, verifierStartStatus.FinalizedL2.Number, verifier.SyncStatus().FinalizedL2.Number, "verifier finalized L2 blocks during sync")
 }
+
+func TestELSync(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	p := &e2eutils.TestParams{
+		MaxSequencerDrift:   40,
+		SequencerWindowSize: 4,
+		ChannelTimeout:      10,
+		L1BlockTime:         12,
+	}
+	dp := e2eutils.MakeDeployParams(t, p)
+	sd := e2eutils.Setup(t, dp, defaultAlloc)
+	log := testlog.Logger(t, log.LvlInfo)
+	_, _, miner, sequencer, seqEngine, _, _, batcher := setupReorgTestActors(t, dp, sd, log)
+
+	// build a L1 chain, with the L2 chain batch-submitted to it
+	miner.ActEmptyBlock(t)
+	for i := 0; i < 20; i++ {
+		sequencer.ActL1HeadSignal(t)
+		sequencer.ActL2PipelineFull(t)
+		sequencer.ActBuildToL1Head(t)
+		batcher.ActSubmitAll(t)
+		miner.ActL1StartBlock(12)(t)
+		miner.ActL1IncludeTx(batcher.batcherAddr)(t)
+		miner.ActL1EndBlock(t)
+	}
+	sequencer.ActL1HeadSignal(t)
+	sequencer.ActL2PipelineFull(t)
+
+	// a new verifier that syncs with its execution engine
+	verifEngine := NewL2Engine(t, log, sd.L2Cfg, sd.RollupCfg.Genesis.L1, e2eutils.WriteDefaultJWT(t))
+	verifier := NewL2Verifier(t, log, miner.L1Client(t, sd.RollupCfg), verifEngine.EngineClient(t, sd.RollupCfg), sd.RollupCfg, &sync.Config{SyncMode: sync.ELSync}, safedb.Disabled)
+	verifier.ActL1HeadSignal(t)
+	verifier.ActL2PipelineFull(t)
+	require.Equal(t, sd.RollupCfg.Genesis.L2, verifier.L2Safe().ID(), "no derivation while waiting for a sync target")
+
+	// the latest unsafe block is the sync target, the engine syncs to it by itself
+	head, err := seqEngine.EngineClient(t, sd.RollupCfg).PayloadByLabel(t.Ctx(), eth.Unsafe)
+	require.NoError(t, err)
+	verifier.ActL2UnsafeGossipReceive(head)(t)
+	verifier.ActL2PipelineFull(t)
+	require.Equal(t, sd.RollupCfg.Genesis.L2, verifier.L2Unsafe().ID(), "engine is still syncing")
+	require.Equal(t, sd.RollupCfg.Genesis.L2, verifier.L2Safe().ID(), "no derivation while the engine is syncing")
+
+	verifEngine.ActL2SyncFrom(seqEngine, head.BlockHash)(t)
+	verifier.ActL2PipelineFull(t)
+
+	// the synced chain is verified by deriving it from L1, and derivation continues as usual
+	require.Equal(t, sequencer.L2Unsafe(), verifier.L2Unsafe(), "verifier has the synced chain")
+	require.Equal(t, sequencer.L2Safe(), verifier.L2Safe(), "verifier derived the safe chain")
+	require.Greater(t, verifier.L2Safe().Number, uint64(0))
+
+	sequencer.ActL2StartBlock(t)
+	sequencer.ActL2EndBlock(t)
+	next, err := seqEngine.EngineClient(t, sd.RollupCfg).PayloadByLabel(t.Ctx(), eth.Unsafe)
+	require.NoError(t, err)
+	verifier.ActL2UnsafeGossipReceive(next)(t)
+	verifier.ActL2PipelineFull(t)
+	require.Equal(t, sequencer.L2Unsafe(), verifier.L2Unsafe(), "verifier follows unsafe blocks after EL sync")
+}
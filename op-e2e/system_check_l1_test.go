@@ -0,0 +1,82 @@
+package op_e2e
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+// TestCheckL1 checks a set of L1 contracts deployed with the L1 deployer against its deploy and rollup config.
+func TestCheckL1(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	sys, err := cfg.Start()
+	require.Nil(t, err, "Error starting up system")
+	defer sys.Close()
+
+	logger := testlog.Logger(t, log.LvlInfo)
+	l1Client := sys.Clients["l1"]
+
+	deployConfig := *cfg.DeployConfig
+	l1StartingBlockTag := genesis.MarshalableRPCBlockNumberOrHash(rpc.BlockNumberOrHashWithNumber(0))
+	deployConfig.L1StartingBlockTag = &l1StartingBlockTag
+	deployConfig.L2OutputOracleChallenger = cfg.Secrets.Addresses().Mallory
+	deployConfig.PortalGuardian = cfg.Secrets.Addresses().SysCfgOwner
+
+	txMgr, err := txmgr.NewSimpleTxManager("deploy-l1", logger, &txmetrics.NoopTxMetrics{},
+		newTxMgrConfig(sys.Nodes["l1"].WSEndpoint(), cfg.Secrets.Bob))
+	require.Nil(t, err)
+	deployer, err := genesis.NewL1Deployer(logger, &deployConfig, filepath.Join(t.TempDir(), "deployments.json"), l1Client, txMgr)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	deployments, err := deployer.Run(ctx)
+	require.Nil(t, err)
+
+	require.Nil(t, deployments.Apply(&deployConfig))
+	l1Genesis, err := l1Client.BlockByNumber(ctx, common.Big0)
+	require.Nil(t, err)
+	rollupConfig, err := deployConfig.RollupConfig(l1Genesis, common.Hash{}, 0)
+	require.Nil(t, err)
+
+	report, err := genesis.CheckL1(ctx, l1Client, rollupConfig, &deployConfig, deployments)
+	require.Nil(t, err)
+	require.Empty(t, report.Failed())
+	require.True(t, report.Passed)
+
+	// A deploy config that does not match the deployment fails the check, without stopping the other checks
+	mismatched := deployConfig
+	mismatched.PortalGuardian = cfg.Secrets.Addresses().Mallory
+	mismatchedReport, err := genesis.CheckL1(ctx, l1Client, rollupConfig, &mismatched, deployments)
+	require.Nil(t, err)
+	require.False(t, mismatchedReport.Passed)
+	require.Len(t, mismatchedReport.Checks, len(report.Checks))
+	failed := mismatchedReport.Failed()
+	require.Len(t, failed, 1)
+	require.Equal(t, "OptimismPortal", failed[0].Contract)
+	require.Equal(t, "GUARDIAN", failed[0].Check)
+	require.Equal(t, mismatched.PortalGuardian.String(), failed[0].Expected)
+	require.Equal(t, deployConfig.PortalGuardian.String(), failed[0].Actual)
+
+	// A proxy pointing to another implementation fails the check
+	deployments["L1StandardBridge"] = deployments["L1ERC721Bridge"]
+	mismatchedReport, err = genesis.CheckL1(ctx, l1Client, rollupConfig, &deployConfig, deployments)
+	require.Nil(t, err)
+	failed = mismatchedReport.Failed()
+	require.Len(t, failed, 1)
+	require.Equal(t, "L1StandardBridgeProxy", failed[0].Contract)
+	require.Equal(t, "EIP-1967 implementation", failed[0].Check)
+}
@@ -0,0 +1,148 @@
+package op_e2e
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/hardhat"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+// interruptedTxMgr interrupts a deployment after the first few transactions.
+// The interrupting transaction is still confirmed, but its receipt is lost.
+type interruptedTxMgr struct {
+	txmgr.TxManager
+	remaining int
+}
+
+func (m *interruptedTxMgr) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	if m.remaining == 0 {
+		if _, err := m.TxManager.Send(ctx, candidate); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("interrupted")
+	}
+	m.remaining--
+	return m.TxManager.Send(ctx, candidate)
+}
+
+// TestDeployL1 deploys a second set of L1 contracts to the L1 chain with the L1 deployer,
+// interrupting the first attempt and resuming it.
+func TestDeployL1(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	sys, err := cfg.Start()
+	require.Nil(t, err, "Error starting up system")
+	defer sys.Close()
+
+	logger := testlog.Logger(t, log.LvlInfo)
+	l1Client := sys.Clients["l1"]
+
+	deployConfig := *cfg.DeployConfig
+	l1StartingBlockTag := genesis.MarshalableRPCBlockNumberOrHash(rpc.BlockNumberOrHashWithNumber(0))
+	deployConfig.L1StartingBlockTag = &l1StartingBlockTag
+	deployConfig.L2OutputOracleChallenger = cfg.Secrets.Addresses().Mallory
+	deployConfig.PortalGuardian = cfg.Secrets.Addresses().SysCfgOwner
+	deploymentsPath := filepath.Join(t.TempDir(), "deployments.json")
+
+	txMgr, err := txmgr.NewSimpleTxManager("deploy-l1", logger, &txmetrics.NoopTxMetrics{},
+		newTxMgrConfig(sys.Nodes["l1"].WSEndpoint(), cfg.Secrets.Bob))
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// The first attempt is interrupted after deploying the ProxyAdmin and a few proxies,
+	// while the next deployment is confirmed but still pending in the deployments.
+	deployer, err := genesis.NewL1Deployer(logger, &deployConfig, deploymentsPath, l1Client, &interruptedTxMgr{TxManager: txMgr, remaining: 4})
+	require.Nil(t, err)
+	_, err = deployer.Run(ctx)
+	require.ErrorContains(t, err, "interrupted")
+	partial, err := genesis.LoadL1Deployments(deploymentsPath)
+	require.Nil(t, err)
+	require.Len(t, partial, 5)
+	pending := 0
+	for _, deployment := range partial {
+		if deployment.Pending {
+			pending++
+		}
+	}
+	require.Equal(t, 1, pending)
+	nonce, err := l1Client.NonceAt(ctx, txMgr.From(), nil)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), nonce, "the pending deployment is confirmed")
+
+	deployer, err = genesis.NewL1Deployer(logger, &deployConfig, deploymentsPath, l1Client, txMgr)
+	require.Nil(t, err)
+	deployments, err := deployer.Run(ctx)
+	require.Nil(t, err)
+	for name, deployment := range partial {
+		if deployment.Pending {
+			require.Equal(t, deployment.Address, deployments[name].Address, "resumed deployment must find pending %s", name)
+			require.False(t, deployments[name].Pending)
+			continue
+		}
+		require.Equal(t, deployment, deployments[name], "resumed deployment must keep %s", name)
+	}
+	stored, err := genesis.LoadL1Deployments(deploymentsPath)
+	require.Nil(t, err)
+	require.Equal(t, deployments, stored)
+
+	proxyAdmin, err := bindings.NewProxyAdminCaller(deployments["ProxyAdmin"].Address, l1Client)
+	require.Nil(t, err)
+	owner, err := proxyAdmin.Owner(&bind.CallOpts{})
+	require.Nil(t, err)
+	require.Equal(t, deployConfig.FinalSystemOwner, owner)
+
+	systemConfig, err := bindings.NewSystemConfigCaller(deployments["SystemConfigProxy"].Address, l1Client)
+	require.Nil(t, err)
+	batcherHash, err := systemConfig.BatcherHash(&bind.CallOpts{})
+	require.Nil(t, err)
+	require.Equal(t, deployConfig.BatchSenderAddress.Hash(), common.Hash(batcherHash))
+
+	portal, err := bindings.NewOptimismPortalCaller(deployments["OptimismPortalProxy"].Address, l1Client)
+	require.Nil(t, err)
+	l2Oracle, err := portal.L2ORACLE(&bind.CallOpts{})
+	require.Nil(t, err)
+	require.Equal(t, deployments["L2OutputOracleProxy"].Address, l2Oracle)
+
+	// A completed deployment is not deployed again
+	nonce, err = l1Client.NonceAt(ctx, txMgr.From(), nil)
+	require.Nil(t, err)
+	deployments, err = deployer.Run(ctx)
+	require.Nil(t, err)
+	require.Equal(t, stored, deployments)
+	nonceAfter, err := l1Client.NonceAt(ctx, txMgr.From(), nil)
+	require.Nil(t, err)
+	require.Equal(t, nonce, nonceAfter)
+
+	// The hardhat deployments can be used to generate the L2 genesis
+	deploymentDir := filepath.Join(t.TempDir(), "devnet")
+	require.Nil(t, deployments.WriteHardhatDeployments(deploymentDir))
+	hh, err := hardhat.New("devnet", nil, []string{filepath.Dir(deploymentDir)})
+	require.Nil(t, err)
+	fromHardhat := deployConfig
+	fromHardhat.L1StandardBridgeProxy = common.Address{}
+	fromHardhat.L1CrossDomainMessengerProxy = common.Address{}
+	fromHardhat.L1ERC721BridgeProxy = common.Address{}
+	fromHardhat.SystemConfigProxy = common.Address{}
+	fromHardhat.OptimismPortalProxy = common.Address{}
+	require.Nil(t, fromHardhat.GetDeployedAddresses(hh))
+	require.Nil(t, deployments.Apply(&deployConfig))
+	require.Equal(t, deployConfig, fromHardhat)
+}
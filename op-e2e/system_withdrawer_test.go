@@ -0,0 +1,99 @@
+package op_e2e
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/withdrawer"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+// TestWithdrawer proves and finalizes a withdrawal with the withdrawal tool, on behalf of the withdrawing user.
+func TestWithdrawer(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	cfg.DeployConfig.FinalizationPeriodSeconds = 2 // 2s finalization period
+
+	sys, err := cfg.Start()
+	require.Nil(t, err, "Error starting up system")
+	defer sys.Close()
+
+	logger := testlog.Logger(t, log.LvlInfo)
+	l1Client := sys.Clients["l1"]
+	l2Seq := sys.Clients["sequencer"]
+	l2Verif := sys.Clients["verifier"]
+
+	// Deposit, so that the portal holds the ETH to withdraw
+	opts, err := bind.NewKeyedTransactorWithChainID(cfg.Secrets.Alice, cfg.L1ChainIDBig())
+	require.Nil(t, err)
+	opts.Value = big.NewInt(1_000_000_000_000)
+	SendDepositTx(t, cfg, l1Client, l2Verif, opts, func(l2Opts *DepositTxOpts) {
+		l2Opts.Value = common.Big0
+	})
+
+	// Alice withdraws, Bob proves and finalizes the withdrawal
+	fromAddr := crypto.PubkeyToAddress(cfg.Secrets.Alice.PublicKey)
+	withdrawAmount := big.NewInt(500_000_000_000)
+	_, receipt := SendWithdrawal(t, cfg, l2Seq, cfg.Secrets.Alice, func(opts *WithdrawalTxOpts) {
+		opts.Value = withdrawAmount
+		opts.VerifyOnClients(l2Verif)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	startBalance, err := l1Client.BalanceAt(ctx, fromAddr, nil)
+	require.Nil(t, err)
+
+	txMgr, err := txmgr.NewSimpleTxManager("withdrawal", logger, &txmetrics.NoopTxMetrics{},
+		newTxMgrConfig(sys.Nodes["l1"].WSEndpoint(), cfg.Secrets.Bob))
+	require.Nil(t, err)
+	l2RPC, err := rpc.Dial(sys.Nodes["verifier"].WSEndpoint())
+	require.Nil(t, err)
+	defer l2RPC.Close()
+
+	wCfg := withdrawer.Config{
+		PortalAddress: predeploys.DevOptimismPortalAddr,
+		StatePath:     filepath.Join(t.TempDir(), "withdrawal.json"),
+		PollInterval:  time.Second,
+	}
+	w, err := withdrawer.NewWithdrawer(logger, wCfg, l1Client, ethclient.NewClient(l2RPC), gethclient.New(l2RPC), txMgr)
+	require.Nil(t, err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 60*time.Duration(cfg.DeployConfig.L1BlockTime)*time.Second)
+	defer cancel()
+	state, err := w.Run(ctx, receipt.TxHash)
+	require.Nil(t, err)
+	require.NotNil(t, state.ProveTxHash)
+	require.NotNil(t, state.FinalizeTxHash)
+	require.True(t, *state.Success)
+	require.Equal(t, receipt.BlockNumber.Uint64(), state.L2BlockNumber)
+
+	// Bob paid the fees, so Alice receives the full withdrawal amount
+	endBalance, err := l1Client.BalanceAt(ctx, fromAddr, nil)
+	require.Nil(t, err)
+	require.Equal(t, withdrawAmount, new(big.Int).Sub(endBalance, startBalance))
+
+	// Running again resumes from the persisted state, and finds the withdrawal already finalized
+	stored, err := withdrawer.LoadState(wCfg.StatePath)
+	require.Nil(t, err)
+	require.Equal(t, state, stored)
+	state, err = w.Run(ctx, receipt.TxHash)
+	require.Nil(t, err)
+	require.Equal(t, stored, state)
+}
@@ -0,0 +1,62 @@
+package op_heartbeat
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// AllowList is the set of chain IDs and versions that heartbeats are labeled with in metrics.
+// Heartbeats of other chains and versions are labeled as unknown, to bound the number of metrics.
+type AllowList struct {
+	chainIDs map[uint64]bool
+	versions map[string]bool
+}
+
+// allowListConfig is the JSON encoding of an allow-list file
+type allowListConfig struct {
+	ChainIDs []uint64 `json:"chainIDs"`
+	Versions []string `json:"versions"`
+}
+
+func NewAllowList(chainIDs []uint64, versions []string) *AllowList {
+	a := &AllowList{
+		chainIDs: make(map[uint64]bool),
+		versions: make(map[string]bool),
+	}
+	for _, id := range chainIDs {
+		a.chainIDs[id] = true
+	}
+	for _, v := range versions {
+		a.versions[v] = true
+	}
+	return a
+}
+
+// DefaultAllowList returns the allow-list of the chains and versions in AllowedChainIDs and AllowedVersions.
+func DefaultAllowList() *AllowList {
+	return &AllowList{chainIDs: AllowedChainIDs, versions: AllowedVersions}
+}
+
+// LoadAllowList reads an allow-list from a JSON file of the form:
+//
+//	{"chainIDs": [10, 420], "versions": ["v1.0.0", "v1.0.1"]}
+func LoadAllowList(path string) (*AllowList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allow-list: %w", err)
+	}
+	var cfg allowListConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode allow-list %s: %w", path, err)
+	}
+	return NewAllowList(cfg.ChainIDs, cfg.Versions), nil
+}
+
+func (a *AllowList) ChainIDAllowed(chainID uint64) bool {
+	return a.chainIDs[chainID]
+}
+
+func (a *AllowList) VersionAllowed(version string) bool {
+	return a.versions[version]
+}
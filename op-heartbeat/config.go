@@ -14,6 +14,9 @@ type Config struct {
 	HTTPAddr string
 	HTTPPort int
 
+	// AllowListPath is the path of the allow-list file. The default allow-list is used if it is empty.
+	AllowListPath string
+
 	Log oplog.CLIConfig
 
 	Metrics opmetrics.CLIConfig
@@ -42,10 +45,11 @@ func (c Config) Check() error {
 
 func NewConfig(ctx *cli.Context) Config {
 	return Config{
-		HTTPAddr: ctx.String(flags.HTTPAddrFlag.Name),
-		HTTPPort: ctx.Int(flags.HTTPPortFlag.Name),
-		Log:      oplog.ReadCLIConfig(ctx),
-		Metrics:  opmetrics.ReadCLIConfig(ctx),
-		Pprof:    oppprof.ReadCLIConfig(ctx),
+		HTTPAddr:      ctx.String(flags.HTTPAddrFlag.Name),
+		HTTPPort:      ctx.Int(flags.HTTPPortFlag.Name),
+		AllowListPath: ctx.String(flags.AllowListFlag.Name),
+		Log:           oplog.ReadCLIConfig(ctx),
+		Metrics:       opmetrics.ReadCLIConfig(ctx),
+		Pprof:         oppprof.ReadCLIConfig(ctx),
 	}
 }
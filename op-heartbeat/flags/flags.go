@@ -14,8 +14,9 @@ func prefixEnvVars(name string) []string {
 }
 
 const (
-	HTTPAddrFlagName = "http.addr"
-	HTTPPortFlagName = "http.port"
+	HTTPAddrFlagName  = "http.addr"
+	HTTPPortFlagName  = "http.port"
+	AllowListFlagName = "allowlist.path"
 )
 
 var (
@@ -31,6 +32,12 @@ var (
 		Value:   8080,
 		EnvVars: prefixEnvVars("HTTP_PORT"),
 	}
+	AllowListFlag = &cli.StringFlag{
+		Name:      AllowListFlagName,
+		Usage:     "Path to a JSON file of the chain IDs and versions to label heartbeats with. Defaults to the built-in allow-list",
+		TakesFile: true,
+		EnvVars:   prefixEnvVars("ALLOWLIST_PATH"),
+	}
 )
 
 var Flags []cli.Flag
@@ -39,6 +46,7 @@ func init() {
 	Flags = []cli.Flag{
 		HTTPAddrFlag,
 		HTTPPortFlag,
+		AllowListFlag,
 	}
 
 	Flags = append(Flags, oplog.CLIFlags(envPrefix)...)
@@ -3,10 +3,12 @@ package op_heartbeat
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/hashicorp/golang-lru/simplelru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
@@ -17,21 +19,42 @@ const (
 	MetricsNamespace     = "op_heartbeat"
 	MinHeartbeatInterval = 10*time.Minute - 10*time.Second
 	UsersCacheSize       = 10_000
+	// NodeExpiry is the time after which a node that stopped sending signed heartbeats is no longer counted.
+	// It spans multiple heartbeat intervals, to not drop nodes of which a single heartbeat got lost.
+	NodeExpiry = 3 * heartbeat.SendInterval
+	// NodesCacheSize is the maximum number of unique nodes that are tracked
+	NodesCacheSize = 100_000
 )
 
 type Metrics interface {
 	RecordHeartbeat(payload heartbeat.Payload, ip string)
+	RecordSignedHeartbeat(payload heartbeat.Payload)
+	RecordInvalidHeartbeat(reason string)
 	RecordVersion(version string)
+	// PruneNodes stops counting the nodes of which the last signed heartbeat is older than NodeExpiry.
+	PruneNodes(now time.Time)
 }
 
 type metrics struct {
+	allowList *AllowList
+
 	heartbeats *prometheus.CounterVec
 	version    *prometheus.GaugeVec
 	sameIP     *prometheus.HistogramVec
 
+	signedHeartbeats  *prometheus.CounterVec
+	invalidHeartbeats *prometheus.CounterVec
+	uniqueNodes       *prometheus.GaugeVec
+
 	// Groups heartbeats per unique IP, version and chain ID combination.
 	// string(IP ++ version ++ chainID) -> *heartbeatEntry
 	heartbeatUsers *lru.Cache
+
+	// Tracks the latest signed heartbeat per peer ID, guarded by nodesLock.
+	// Evicted nodes are no longer counted in uniqueNodes.
+	// string(peerID) -> *nodeEntry
+	nodes     *simplelru.LRU
+	nodesLock sync.Mutex
 }
 
 type heartbeatEntry struct {
@@ -41,9 +64,16 @@ type heartbeatEntry struct {
 	Time time.Time
 }
 
-func NewMetrics(r *prometheus.Registry) Metrics {
+type nodeEntry struct {
+	ChainID string
+	Version string
+	Time    time.Time
+}
+
+func NewMetrics(r *prometheus.Registry, allowList *AllowList) Metrics {
 	lruCache, _ := lru.New(UsersCacheSize)
 	m := &metrics{
+		allowList: allowList,
 		heartbeats: promauto.With(r).NewCounterVec(prometheus.CounterOpts{
 			Namespace: MetricsNamespace,
 			Name:      "heartbeats",
@@ -68,24 +98,53 @@ func NewMetrics(r *prometheus.Registry) Metrics {
 			"chain_id",
 			"version",
 		}),
+		signedHeartbeats: promauto.With(r).NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "signed_heartbeats",
+			Help:      "Counts number of heartbeats with a valid signature of their peer ID, by chain ID and version",
+		}, []string{
+			"chain_id",
+			"version",
+		}),
+		invalidHeartbeats: promauto.With(r).NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "invalid_heartbeats",
+			Help:      "Counts number of rejected heartbeats, by reason",
+		}, []string{
+			"reason",
+		}),
+		uniqueNodes: promauto.With(r).NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "unique_nodes",
+			Help:      "Number of unique peer IDs that sent a signed heartbeat recently, by chain ID and version",
+		}, []string{
+			"chain_id",
+			"version",
+		}),
 		heartbeatUsers: lruCache,
 	}
+	// The eviction callback runs while nodesLock is held by the caller of the cache
+	m.nodes, _ = simplelru.NewLRU(NodesCacheSize, func(_ interface{}, value interface{}) {
+		entry := value.(*nodeEntry)
+		m.uniqueNodes.WithLabelValues(entry.ChainID, entry.Version).Dec()
+	})
 	return m
 }
 
-func (m *metrics) RecordHeartbeat(payload heartbeat.Payload, ip string) {
-	var chainID string
-	if AllowedChainIDs[payload.ChainID] {
+// labels returns the chain ID and version labels of a heartbeat, which are unknown if they are not allowed.
+func (m *metrics) labels(payload heartbeat.Payload) (chainID string, version string) {
+	chainID, version = "unknown", "unknown"
+	if m.allowList.ChainIDAllowed(payload.ChainID) {
 		chainID = strconv.FormatUint(payload.ChainID, 10)
-	} else {
-		chainID = "unknown"
 	}
-	var version string
-	if AllowedVersions[payload.Version] {
+	if m.allowList.VersionAllowed(payload.Version) {
 		version = payload.Version
-	} else {
-		version = "unknown"
 	}
+	return chainID, version
+}
+
+func (m *metrics) RecordHeartbeat(payload heartbeat.Payload, ip string) {
+	chainID, version := m.labels(payload)
 
 	key := fmt.Sprintf("%s;%s;%s", ip, version, chainID)
 	now := time.Now()
@@ -114,6 +173,47 @@ func (m *metrics) RecordHeartbeat(payload heartbeat.Payload, ip string) {
 	m.heartbeatUsers.Add(key, entry)
 }
 
+// RecordSignedHeartbeat counts the node of a verified heartbeat once, by its peer ID.
+// A node that changes its version or chain is moved to the new labels.
+func (m *metrics) RecordSignedHeartbeat(payload heartbeat.Payload) {
+	chainID, version := m.labels(payload)
+	m.signedHeartbeats.WithLabelValues(chainID, version).Inc()
+
+	m.nodesLock.Lock()
+	defer m.nodesLock.Unlock()
+	now := time.Now()
+	if previous, ok := m.nodes.Get(payload.PeerID); ok {
+		entry := previous.(*nodeEntry)
+		if entry.ChainID == chainID && entry.Version == version {
+			entry.Time = now
+			return
+		}
+		m.uniqueNodes.WithLabelValues(entry.ChainID, entry.Version).Dec()
+	}
+	// replacing an existing entry does not call the eviction callback
+	m.nodes.Add(payload.PeerID, &nodeEntry{ChainID: chainID, Version: version, Time: now})
+	m.uniqueNodes.WithLabelValues(chainID, version).Inc()
+}
+
+func (m *metrics) RecordInvalidHeartbeat(reason string) {
+	m.invalidHeartbeats.WithLabelValues(reason).Inc()
+}
+
+func (m *metrics) PruneNodes(now time.Time) {
+	m.nodesLock.Lock()
+	defer m.nodesLock.Unlock()
+	for _, key := range m.nodes.Keys() {
+		value, ok := m.nodes.Peek(key)
+		if !ok {
+			continue
+		}
+		if now.Sub(value.(*nodeEntry).Time) > NodeExpiry {
+			// removing calls the eviction callback, which stops counting the node
+			m.nodes.Remove(key)
+		}
+	}
+}
+
 func (m *metrics) RecordVersion(version string) {
 	m.version.WithLabelValues(version).Set(1)
 }
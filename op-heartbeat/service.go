@@ -28,6 +28,13 @@ import (
 const (
 	HTTPMaxHeaderSize = 10 * 1024
 	HTTPMaxBodySize   = 1024 * 1024
+	// MaxHeartbeatAge is the maximum age of the timestamp of a signed heartbeat,
+	// to not accept replays of heartbeats of nodes that are no longer running.
+	MaxHeartbeatAge = 5 * time.Minute
+	// MaxHeartbeatClockDrift is how far the timestamp of a signed heartbeat may be in the future
+	MaxHeartbeatClockDrift = time.Minute
+	// PruneInterval is the interval at which expired nodes are no longer counted
+	PruneInterval = time.Minute
 )
 
 func Main(version string) func(ctx *cli.Context) error {
@@ -83,8 +90,19 @@ func Start(ctx context.Context, l log.Logger, cfg Config, version string) error
 		}()
 	}
 
-	metrics := NewMetrics(registry)
+	allowList := DefaultAllowList()
+	if cfg.AllowListPath != "" {
+		var err error
+		allowList, err = LoadAllowList(cfg.AllowListPath)
+		if err != nil {
+			return err
+		}
+		l.Info("loaded allow-list", "path", cfg.AllowListPath)
+	}
+
+	metrics := NewMetrics(registry, allowList)
 	metrics.RecordVersion(version)
+	go pruneNodes(ctx, metrics)
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", HealthzHandler)
 	mux.Handle("/", Handler(l, metrics))
@@ -117,9 +135,38 @@ func Handler(l log.Logger, metrics Metrics) http.HandlerFunc {
 			"remote_addr", r.RemoteAddr,
 		)
 
+		body, err := io.ReadAll(io.LimitReader(r.Body, int64(HTTPMaxBodySize)))
+		if err != nil {
+			innerL.Info("error reading request payload", "err", err)
+			w.WriteHeader(400)
+			return
+		}
+		// Unsigned heartbeats of older nodes are the plain payload, which has no signature
+		var signed heartbeat.SignedPayload
+		if err := json.Unmarshal(body, &signed); err != nil {
+			innerL.Info("error decoding request payload", "err", err)
+			w.WriteHeader(400)
+			return
+		}
+		isSigned := len(signed.Signature) > 0
+
 		var payload heartbeat.Payload
-		dec := json.NewDecoder(io.LimitReader(r.Body, int64(HTTPMaxBodySize)))
-		if err := dec.Decode(&payload); err != nil {
+		if isSigned {
+			verified, err := signed.Verify()
+			if err != nil {
+				innerL.Info("invalid heartbeat signature", "err", err)
+				metrics.RecordInvalidHeartbeat("signature")
+				w.WriteHeader(401)
+				return
+			}
+			if err := checkTimestamp(verified.Timestamp, time.Now()); err != nil {
+				innerL.Info("invalid heartbeat timestamp", "err", err, "peer_id", verified.PeerID)
+				metrics.RecordInvalidHeartbeat("timestamp")
+				w.WriteHeader(400)
+				return
+			}
+			payload = *verified
+		} else if err := json.Unmarshal(body, &payload); err != nil {
 			innerL.Info("error decoding request payload", "err", err)
 			w.WriteHeader(400)
 			return
@@ -132,14 +179,43 @@ func Handler(l log.Logger, metrics Metrics) http.HandlerFunc {
 			"moniker", payload.Moniker,
 			"peer_id", payload.PeerID,
 			"chain_id", payload.ChainID,
+			"signed", isSigned,
 		)
 
 		metrics.RecordHeartbeat(payload, ipStr)
+		if isSigned {
+			metrics.RecordSignedHeartbeat(payload)
+		}
 
 		w.WriteHeader(204)
 	}
 }
 
+// checkTimestamp checks that a signed heartbeat was sent recently
+func checkTimestamp(timestamp uint64, now time.Time) error {
+	sent := time.Unix(int64(timestamp), 0)
+	if age := now.Sub(sent); age > MaxHeartbeatAge {
+		return fmt.Errorf("heartbeat sent %s ago", age)
+	}
+	if drift := sent.Sub(now); drift > MaxHeartbeatClockDrift {
+		return fmt.Errorf("heartbeat sent %s in the future", drift)
+	}
+	return nil
+}
+
+func pruneNodes(ctx context.Context, metrics Metrics) {
+	tick := time.NewTicker(PruneInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case now := <-tick.C:
+			metrics.PruneNodes(now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func HealthzHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(204)
 }
@@ -3,15 +3,21 @@ package op_heartbeat
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
@@ -145,3 +151,119 @@ func freePort(t *testing.T) int {
 	defer l.Close()
 	return l.Addr().(*net.TCPAddr).Port
 }
+
+func TestSignedHeartbeats(t *testing.T) {
+	allowListPath := filepath.Join(t.TempDir(), "allowlist.json")
+	require.NoError(t, os.WriteFile(allowListPath, []byte(`{"chainIDs": [10], "versions": ["v1.0.0", "v1.1.0"]}`), 0o644))
+	httpPort := freePort(t)
+	metricsPort := freePort(t)
+	cfg := Config{
+		HTTPAddr:      "127.0.0.1",
+		HTTPPort:      httpPort,
+		AllowListPath: allowListPath,
+		Metrics: opmetrics.CLIConfig{
+			Enabled:    true,
+			ListenAddr: "127.0.0.1",
+			ListenPort: metricsPort,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	exitC := make(chan error, 1)
+	go func() {
+		exitC <- Start(ctx, log.New(), cfg, "foobar")
+	}()
+	select {
+	case <-time.NewTimer(100 * time.Millisecond).C:
+	case err := <-exitC:
+		t.Fatalf("unexpected error on startup: %v", err)
+	}
+
+	newPeer := func() (crypto.PrivKey, string) {
+		priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPrivateKey(priv)
+		require.NoError(t, err)
+		return priv, id.String()
+	}
+	send := func(body any) int {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("http://127.0.0.1:%d", httpPort), bytes.NewReader(data))
+		require.NoError(t, err)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+	sign := func(priv crypto.PrivKey, payload heartbeat.Payload) *heartbeat.SignedPayload {
+		if payload.Timestamp == 0 {
+			payload.Timestamp = uint64(time.Now().Unix())
+		}
+		signed, err := heartbeat.SignPayload(&payload, priv)
+		require.NoError(t, err)
+		return signed
+	}
+	metricsBody := func() string {
+		res, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d", metricsPort))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	alice, aliceID := newPeer()
+	bob, bobID := newPeer()
+	// repeated heartbeats of a node are counted once
+	for i := 0; i < 3; i++ {
+		require.Equal(t, 204, send(sign(alice, heartbeat.Payload{Version: "v1.0.0", PeerID: aliceID, ChainID: 10})))
+	}
+	require.Equal(t, 204, send(sign(bob, heartbeat.Payload{Version: "v1.0.0", PeerID: bobID, ChainID: 10})))
+	body := metricsBody()
+	require.Contains(t, body, `op_heartbeat_unique_nodes{chain_id="10",version="v1.0.0"} 2`)
+	require.Contains(t, body, `op_heartbeat_signed_heartbeats{chain_id="10",version="v1.0.0"} 4`)
+
+	// an upgraded node moves to its new version
+	require.Equal(t, 204, send(sign(bob, heartbeat.Payload{Version: "v1.1.0", PeerID: bobID, ChainID: 10})))
+	body = metricsBody()
+	require.Contains(t, body, `op_heartbeat_unique_nodes{chain_id="10",version="v1.0.0"} 1`)
+	require.Contains(t, body, `op_heartbeat_unique_nodes{chain_id="10",version="v1.1.0"} 1`)
+
+	// versions and chains that are not in the allow-list file are unknown
+	require.Equal(t, 204, send(sign(alice, heartbeat.Payload{Version: "v0.10.9", PeerID: aliceID, ChainID: 420})))
+	require.Contains(t, metricsBody(), `op_heartbeat_unique_nodes{chain_id="unknown",version="unknown"} 1`)
+
+	// heartbeats signed by another node are rejected
+	require.Equal(t, 401, send(sign(alice, heartbeat.Payload{Version: "v1.0.0", PeerID: bobID, ChainID: 10})))
+	// old heartbeats cannot be replayed
+	stale := uint64(time.Now().Add(-2 * MaxHeartbeatAge).Unix())
+	require.Equal(t, 400, send(sign(bob, heartbeat.Payload{Version: "v1.0.0", PeerID: bobID, ChainID: 10, Timestamp: stale})))
+	body = metricsBody()
+	require.Contains(t, body, `op_heartbeat_invalid_heartbeats{reason="signature"} 1`)
+	require.Contains(t, body, `op_heartbeat_invalid_heartbeats{reason="timestamp"} 1`)
+	require.Contains(t, body, `op_heartbeat_unique_nodes{chain_id="10",version="v1.1.0"} 1`)
+
+	// unsigned heartbeats are not counted as unique nodes
+	require.Equal(t, 204, send(heartbeat.Payload{Version: "v1.1.0", PeerID: "forged", ChainID: 10}))
+	require.Contains(t, metricsBody(), `op_heartbeat_unique_nodes{chain_id="10",version="v1.1.0"} 1`)
+
+	cancel()
+	require.NoError(t, <-exitC)
+}
+
+func TestPruneNodes(t *testing.T) {
+	registry := opmetrics.NewRegistry()
+	m := NewMetrics(registry, NewAllowList([]uint64{10}, []string{"v1.0.0"}))
+	m.RecordSignedHeartbeat(heartbeat.Payload{Version: "v1.0.0", PeerID: "a", ChainID: 10})
+	m.RecordSignedHeartbeat(heartbeat.Payload{Version: "v1.0.0", PeerID: "b", ChainID: 10})
+	uniqueNodes := func() float64 {
+		return testutil.ToFloat64(m.(*metrics).uniqueNodes.WithLabelValues("10", "v1.0.0"))
+	}
+	require.Equal(t, float64(2), uniqueNodes())
+
+	m.PruneNodes(time.Now())
+	require.Equal(t, float64(2), uniqueNodes())
+	m.PruneNodes(time.Now().Add(NodeExpiry + time.Second))
+	require.Equal(t, float64(0), uniqueNodes())
+}
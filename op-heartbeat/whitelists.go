@@ -1,5 +1,6 @@
 package op_heartbeat
 
+// AllowedChainIDs and AllowedVersions are the default allow-list, used when no allow-list file is configured.
 var AllowedChainIDs = map[uint64]bool{
 	420: true,
 	902: true,
@@ -0,0 +1,77 @@
+package op_monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Alert statuses
+const (
+	AlertFiring   = "firing"
+	AlertResolved = "resolved"
+)
+
+// Alert is the JSON body posted to the alert webhook
+type Alert struct {
+	Status  string    `json:"status"`
+	Check   string    `json:"check"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// Alerter posts alerts to a webhook. Alerts are always logged, also if no webhook is configured.
+type Alerter struct {
+	log     log.Logger
+	m       Metricer
+	client  *http.Client
+	webhook string
+}
+
+func NewAlerter(log log.Logger, m Metricer, webhook string, timeout time.Duration) *Alerter {
+	return &Alerter{log: log, m: m, client: &http.Client{Timeout: timeout}, webhook: webhook}
+}
+
+func (a *Alerter) Alert(ctx context.Context, alert Alert) {
+	if alert.Status == AlertFiring {
+		a.log.Error("Invariant violated", "check", alert.Check, "msg", alert.Message)
+	} else {
+		a.log.Info("Invariant recovered", "check", alert.Check, "msg", alert.Message)
+	}
+	if a.webhook == "" {
+		a.m.RecordAlert(alert.Status, false)
+		return
+	}
+	if err := a.post(ctx, alert); err != nil {
+		a.log.Error("Failed to send alert", "check", alert.Check, "status", alert.Status, "err", err)
+		a.m.RecordAlert(alert.Status, false)
+		return
+	}
+	a.m.RecordAlert(alert.Status, true)
+}
+
+func (a *Alerter) post(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
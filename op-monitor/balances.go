@@ -0,0 +1,50 @@
+package op_monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type BalanceSource interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// BalancesCheck verifies that the L1 balances of accounts, like the batcher, proposer and challenger,
+// stay above their thresholds.
+type BalancesCheck struct {
+	m          Metricer
+	source     BalanceSource
+	thresholds []BalanceThreshold
+}
+
+var _ Check = (*BalancesCheck)(nil)
+
+func NewBalancesCheck(m Metricer, source BalanceSource, thresholds []BalanceThreshold) *BalancesCheck {
+	return &BalancesCheck{m: m, source: source, thresholds: thresholds}
+}
+
+func (c *BalancesCheck) Name() string {
+	return "balances"
+}
+
+func (c *BalancesCheck) Check(ctx context.Context) error {
+	var low []string
+	for _, t := range c.thresholds {
+		bal, err := c.source.BalanceAt(ctx, t.Address, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fetch balance of %s (%s): %w", t.Name, t.Address, err)
+		}
+		c.m.RecordBalance(t.Name, bal)
+		if bal.Cmp(t.Min) < 0 {
+			low = append(low, fmt.Sprintf("%s (%s) has %s wei, less than %s wei", t.Name, t.Address, bal, t.Min))
+		}
+	}
+	if len(low) > 0 {
+		return violationf("balance too low: %s", strings.Join(low, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package op_monitor
+
+import (
+	"context"
+	"fmt"
+)
+
+// Check verifies an invariant of the chain.
+type Check interface {
+	// Name identifies the check in logs, metrics and alerts
+	Name() string
+	// Check returns a *Violation if the invariant does not hold,
+	// or any other error if the invariant could not be checked.
+	Check(ctx context.Context) error
+}
+
+// Violation is returned by a check when the invariant it verifies does not hold
+type Violation struct {
+	Msg string
+}
+
+func (v *Violation) Error() string {
+	return v.Msg
+}
+
+func violationf(format string, args ...any) *Violation {
+	return &Violation{Msg: fmt.Sprintf(format, args...)}
+}
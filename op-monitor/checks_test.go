@@ -0,0 +1,210 @@
+package op_monitor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+type stubOracle struct {
+	outputs []bindings.TypesOutputProposal
+}
+
+func (s *stubOracle) LatestOutputIndex(opts *bind.CallOpts) (*big.Int, error) {
+	if len(s.outputs) == 0 {
+		return nil, errors.New("no outputs")
+	}
+	return big.NewInt(int64(len(s.outputs) - 1)), nil
+}
+
+func (s *stubOracle) GetL2Output(opts *bind.CallOpts, index *big.Int) (bindings.TypesOutputProposal, error) {
+	return s.outputs[index.Uint64()], nil
+}
+
+func (s *stubOracle) propose(blockNum uint64, root eth.Bytes32) {
+	s.outputs = append(s.outputs, bindings.TypesOutputProposal{OutputRoot: root, L2BlockNumber: new(big.Int).SetUint64(blockNum)})
+}
+
+type stubRollupNode struct {
+	status eth.SyncStatus
+}
+
+func (s *stubRollupNode) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if blockNum > s.status.SafeL2.Number {
+		return nil, errors.New("not found")
+	}
+	return &eth.OutputResponse{OutputRoot: outputRoot(blockNum), BlockRef: eth.L2BlockRef{Number: blockNum}}, nil
+}
+
+func (s *stubRollupNode) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	return &s.status, nil
+}
+
+func outputRoot(blockNum uint64) eth.Bytes32 {
+	return eth.Bytes32(common.BigToHash(new(big.Int).SetUint64(blockNum + 1000)))
+}
+
+func requireViolation(t *testing.T, err error) {
+	var v *Violation
+	require.ErrorAs(t, err, &v)
+}
+
+func TestOutputsCheck(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	oracle := &stubOracle{}
+	node := &stubRollupNode{}
+	node.status.SafeL2.Number = 100
+	check := NewOutputsCheck(logger, NoopMetrics, oracle, node)
+	ctx := context.Background()
+
+	require.Error(t, check.Check(ctx), "no outputs yet")
+	oracle.propose(10, outputRoot(10))
+	require.NoError(t, check.Check(ctx))
+
+	oracle.propose(20, outputRoot(20))
+	oracle.propose(200, outputRoot(200))
+	err := check.Check(ctx)
+	require.Error(t, err, "rollup node is not synced to the output yet")
+	var v *Violation
+	require.False(t, errors.As(err, &v), "failing to check is not a violation")
+	node.status.SafeL2.Number = 200
+	require.NoError(t, check.Check(ctx))
+
+	// an invalid output stays a violation, also when it is not checked again
+	oracle.propose(30, outputRoot(31))
+	requireViolation(t, check.Check(ctx))
+	oracle.propose(40, outputRoot(40))
+	requireViolation(t, check.Check(ctx))
+
+	// the challenger deletes the invalid output, and the proposer proposes a valid one
+	oracle.outputs = oracle.outputs[:3]
+	require.NoError(t, check.Check(ctx))
+	oracle.propose(30, outputRoot(30))
+	require.NoError(t, check.Check(ctx))
+
+	// an invalid output that replaces a deleted output is detected
+	oracle.outputs = oracle.outputs[:3]
+	oracle.propose(30, outputRoot(29))
+	requireViolation(t, check.Check(ctx))
+}
+
+func TestSafeHeadLagCheck(t *testing.T) {
+	node := &stubRollupNode{}
+	check := NewSafeHeadLagCheck(NoopMetrics, node, 10)
+	ctx := context.Background()
+
+	node.status.UnsafeL2.Number = 110
+	node.status.SafeL2.Number = 100
+	require.NoError(t, check.Check(ctx))
+	node.status.UnsafeL2.Number = 111
+	requireViolation(t, check.Check(ctx))
+	node.status.SafeL2.Number = 111
+	require.NoError(t, check.Check(ctx))
+}
+
+type stubL1 struct {
+	head     uint64
+	logs     []types.Log
+	balances map[common.Address]*big.Int
+	queries  []ethereum.FilterQuery
+}
+
+func (s *stubL1) BlockNumber(ctx context.Context) (uint64, error) {
+	return s.head, nil
+}
+
+func (s *stubL1) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	s.queries = append(s.queries, q)
+	var out []types.Log
+	for _, l := range s.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubL1) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return s.balances[account], nil
+}
+
+type stubMessagePasser map[common.Hash]bool
+
+func (s stubMessagePasser) SentMessages(opts *bind.CallOpts, withdrawalHash [32]byte) (bool, error) {
+	return s[withdrawalHash], nil
+}
+
+func TestWithdrawalsCheck(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	require.NoError(t, err)
+	portal := common.Address{0xaa}
+	proven := func(blockNum uint64, withdrawal common.Hash) types.Log {
+		return types.Log{
+			Address:     portal,
+			Topics:      []common.Hash{portalABI.Events["WithdrawalProven"].ID, withdrawal, {}, {}},
+			BlockNumber: blockNum,
+		}
+	}
+	l1 := &stubL1{head: 100}
+	sent := stubMessagePasser{{0x01}: true, {0x02}: true}
+	check := NewWithdrawalsCheck(logger, NoopMetrics, l1, portal, sent, 50)
+	ctx := context.Background()
+
+	l1.logs = append(l1.logs, proven(60, common.Hash{0x01}))
+	require.NoError(t, check.Check(ctx))
+	require.Len(t, l1.queries, 1)
+	require.Equal(t, uint64(50), l1.queries[0].FromBlock.Uint64())
+	require.Equal(t, uint64(100), l1.queries[0].ToBlock.Uint64())
+
+	// blocks are checked in bounded ranges, and only once
+	l1.head = 100 + maxWithdrawalsRange + 10
+	l1.logs = append(l1.logs, proven(105, common.Hash{0x02}))
+	require.NoError(t, check.Check(ctx))
+	require.Len(t, l1.queries, 3)
+	require.Equal(t, uint64(101), l1.queries[1].FromBlock.Uint64())
+	require.Equal(t, uint64(100+maxWithdrawalsRange), l1.queries[1].ToBlock.Uint64())
+	require.Equal(t, l1.head, l1.queries[2].ToBlock.Uint64())
+
+	// a forged withdrawal stays a violation
+	l1.head++
+	l1.logs = append(l1.logs, proven(l1.head, common.Hash{0x03}))
+	requireViolation(t, check.Check(ctx))
+	l1.head++
+	requireViolation(t, check.Check(ctx))
+}
+
+func TestBalancesCheck(t *testing.T) {
+	batcher, err := ParseBalanceThreshold("batcher:0x6887246668a3b87F54DeB3b94Ba47a6f63F32985:1.5")
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Mul(big.NewInt(15), big.NewInt(params.Ether/10)), batcher.Min)
+	proposer, err := ParseBalanceThreshold("proposer:0x473300df21D047806A082244b417f96b32f13A33:0")
+	require.NoError(t, err)
+	_, err = ParseBalanceThreshold("batcher:0x6887246668a3b87F54DeB3b94Ba47a6f63F32985")
+	require.Error(t, err)
+	_, err = ParseBalanceThreshold("batcher:0x123:1")
+	require.Error(t, err)
+
+	l1 := &stubL1{balances: map[common.Address]*big.Int{
+		batcher.Address:  big.NewInt(params.Ether * 2),
+		proposer.Address: big.NewInt(0),
+	}}
+	check := NewBalancesCheck(NoopMetrics, l1, []BalanceThreshold{batcher, proposer})
+	ctx := context.Background()
+	require.NoError(t, check.Check(ctx))
+	l1.balances[batcher.Address] = big.NewInt(params.Ether)
+	requireViolation(t, check.Check(ctx))
+}
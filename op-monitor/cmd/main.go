@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	monitor "github.com/ethereum-optimism/optimism/op-monitor"
+	"github.com/ethereum-optimism/optimism/op-monitor/flags"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+var (
+	Version   = ""
+	GitCommit = ""
+	GitDate   = ""
+)
+
+func main() {
+	oplog.SetupDefaults()
+
+	app := cli.NewApp()
+	app.Flags = flags.Flags
+	app.Version = fmt.Sprintf("%s-%s-%s", Version, GitCommit, GitDate)
+	app.Name = "op-monitor"
+	app.Usage = "Rollup invariant monitor"
+	app.Description = "Service that monitors a chain for OP-stack specific invariants, and alerts when they are violated"
+	app.Action = monitor.Main(app.Version)
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Crit("Application failed", "message", err)
+	}
+}
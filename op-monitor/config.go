@@ -0,0 +1,159 @@
+package op_monitor
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-monitor/flags"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+)
+
+// BalanceThreshold is the minimum balance an account must hold
+type BalanceThreshold struct {
+	Name    string
+	Address common.Address
+	// Min is the minimum balance, in wei
+	Min *big.Int
+}
+
+// ParseBalanceThreshold parses a threshold formatted as <name>:<address>:<min balance in ether>
+func ParseBalanceThreshold(s string) (BalanceThreshold, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return BalanceThreshold{}, fmt.Errorf("balance threshold %q is not formatted as <name>:<address>:<min balance in ether>", s)
+	}
+	if parts[0] == "" {
+		return BalanceThreshold{}, fmt.Errorf("balance threshold %q has no name", s)
+	}
+	if !common.IsHexAddress(parts[1]) {
+		return BalanceThreshold{}, fmt.Errorf("balance threshold %q has invalid address %q", s, parts[1])
+	}
+	ether, ok := new(big.Float).SetString(parts[2])
+	if !ok || ether.Sign() < 0 {
+		return BalanceThreshold{}, fmt.Errorf("balance threshold %q has invalid minimum balance %q", s, parts[2])
+	}
+	wei, _ := ether.Mul(ether, new(big.Float).SetInt64(params.Ether)).Int(nil)
+	return BalanceThreshold{
+		Name:    parts[0],
+		Address: common.HexToAddress(parts[1]),
+		Min:     wei,
+	}, nil
+}
+
+type Config struct {
+	L1EthRpc  string
+	L2EthRpc  string
+	RollupRpc string
+
+	// L2OOAddress is the L2OutputOracle to check the proposed outputs of, the check is disabled if nil
+	L2OOAddress *common.Address
+	// OptimismPortalAddress is the OptimismPortal to check the proven withdrawals of, the check is disabled if nil
+	OptimismPortalAddress *common.Address
+	// WithdrawalsStartBlock is the L1 block to start checking proven withdrawals from, the L1 head if 0
+	WithdrawalsStartBlock uint64
+
+	// MaxSafeHeadLag is the maximum number of blocks the safe head may lag behind the unsafe head, the check is disabled if 0
+	MaxSafeHeadLag uint64
+
+	BalanceThresholds []BalanceThreshold
+
+	CheckInterval  time.Duration
+	NetworkTimeout time.Duration
+
+	// AlertWebhook is the URL to post alerts to, alerts are only logged if empty
+	AlertWebhook string
+
+	Log oplog.CLIConfig
+
+	Metrics opmetrics.CLIConfig
+
+	Pprof oppprof.CLIConfig
+}
+
+func (c Config) Check() error {
+	if c.L1EthRpc == "" {
+		return errors.New("must specify a L1 RPC")
+	}
+	if c.L2EthRpc == "" {
+		return errors.New("must specify a L2 RPC")
+	}
+	if c.RollupRpc == "" {
+		return errors.New("must specify a rollup node RPC")
+	}
+	if c.CheckInterval <= 0 {
+		return errors.New("check interval must be positive")
+	}
+	if c.NetworkTimeout <= 0 {
+		return errors.New("network timeout must be positive")
+	}
+	names := make(map[string]struct{})
+	for _, t := range c.BalanceThresholds {
+		if _, ok := names[t.Name]; ok {
+			return fmt.Errorf("duplicate balance threshold name %q", t.Name)
+		}
+		names[t.Name] = struct{}{}
+	}
+	if err := c.Log.Check(); err != nil {
+		return err
+	}
+	if err := c.Metrics.Check(); err != nil {
+		return err
+	}
+	if err := c.Pprof.Check(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewConfig(ctx *cli.Context) (Config, error) {
+	cfg := Config{
+		L1EthRpc:              ctx.String(flags.L1EthRpcFlag.Name),
+		L2EthRpc:              ctx.String(flags.L2EthRpcFlag.Name),
+		RollupRpc:             ctx.String(flags.RollupRpcFlag.Name),
+		WithdrawalsStartBlock: ctx.Uint64(flags.WithdrawalsStartBlockFlag.Name),
+		MaxSafeHeadLag:        ctx.Uint64(flags.MaxSafeHeadLagFlag.Name),
+		CheckInterval:         ctx.Duration(flags.CheckIntervalFlag.Name),
+		NetworkTimeout:        ctx.Duration(flags.NetworkTimeoutFlag.Name),
+		AlertWebhook:          ctx.String(flags.AlertWebhookFlag.Name),
+		Log:                   oplog.ReadCLIConfig(ctx),
+		Metrics:               opmetrics.ReadCLIConfig(ctx),
+		Pprof:                 oppprof.ReadCLIConfig(ctx),
+	}
+	var err error
+	if cfg.L2OOAddress, err = parseAddress(ctx, flags.L2OOAddressFlag.Name); err != nil {
+		return Config{}, err
+	}
+	if cfg.OptimismPortalAddress, err = parseAddress(ctx, flags.OptimismPortalAddressFlag.Name); err != nil {
+		return Config{}, err
+	}
+	for _, s := range ctx.StringSlice(flags.BalanceThresholdsFlag.Name) {
+		t, err := ParseBalanceThreshold(s)
+		if err != nil {
+			return Config{}, err
+		}
+		cfg.BalanceThresholds = append(cfg.BalanceThresholds, t)
+	}
+	return cfg, nil
+}
+
+// parseAddress parses the address of the named flag, or returns nil if the flag is not set
+func parseAddress(ctx *cli.Context, name string) (*common.Address, error) {
+	s := ctx.String(name)
+	if s == "" {
+		return nil, nil
+	}
+	if !common.IsHexAddress(s) {
+		return nil, fmt.Errorf("invalid %s: %q", name, s)
+	}
+	addr := common.HexToAddress(s)
+	return &addr, nil
+}
@@ -0,0 +1,113 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+)
+
+const envPrefix = "OP_MONITOR"
+
+func prefixEnvVars(name string) []string {
+	return opservice.PrefixEnvVar(envPrefix, name)
+}
+
+var (
+	// Required Flags
+	L1EthRpcFlag = &cli.StringFlag{
+		Name:     "l1-eth-rpc",
+		Usage:    "HTTP provider URL for L1",
+		EnvVars:  prefixEnvVars("L1_ETH_RPC"),
+		Required: true,
+	}
+	L2EthRpcFlag = &cli.StringFlag{
+		Name:     "l2-eth-rpc",
+		Usage:    "HTTP provider URL for L2",
+		EnvVars:  prefixEnvVars("L2_ETH_RPC"),
+		Required: true,
+	}
+	RollupRpcFlag = &cli.StringFlag{
+		Name:     "rollup-rpc",
+		Usage:    "HTTP provider URL for the rollup node",
+		EnvVars:  prefixEnvVars("ROLLUP_RPC"),
+		Required: true,
+	}
+	// Optional Flags
+	L2OOAddressFlag = &cli.StringFlag{
+		Name:    "l2oo-address",
+		Usage:   "Address of the L2OutputOracle contract. Proposed outputs are checked against the rollup node if set.",
+		EnvVars: prefixEnvVars("L2OO_ADDRESS"),
+	}
+	OptimismPortalAddressFlag = &cli.StringFlag{
+		Name:    "optimism-portal-address",
+		Usage:   "Address of the OptimismPortal contract. Proven withdrawals are checked against the messages sent on L2 if set.",
+		EnvVars: prefixEnvVars("OPTIMISM_PORTAL_ADDRESS"),
+	}
+	WithdrawalsStartBlockFlag = &cli.Uint64Flag{
+		Name:    "withdrawals-start-block",
+		Usage:   "L1 block number to start checking proven withdrawals from. Starts at the L1 head if not set.",
+		EnvVars: prefixEnvVars("WITHDRAWALS_START_BLOCK"),
+	}
+	MaxSafeHeadLagFlag = &cli.Uint64Flag{
+		Name:    "max-safe-head-lag",
+		Usage:   "Maximum number of L2 blocks that the safe head may lag behind the unsafe head, before the batcher is considered to be falling behind. Disabled if 0.",
+		Value:   1800,
+		EnvVars: prefixEnvVars("MAX_SAFE_HEAD_LAG"),
+	}
+	BalanceThresholdsFlag = &cli.StringSliceFlag{
+		Name: "balance-threshold",
+		Usage: "Minimum L1 balance of an account, formatted as <name>:<address>:<min balance in ether>, " +
+			"e.g. batcher:0x6887246668a3b87F54DeB3b94Ba47a6f63F32985:1.5. May be repeated.",
+		EnvVars: prefixEnvVars("BALANCE_THRESHOLDS"),
+	}
+	CheckIntervalFlag = &cli.DurationFlag{
+		Name:    "check-interval",
+		Usage:   "Interval between checks of the invariants",
+		Value:   30 * time.Second,
+		EnvVars: prefixEnvVars("CHECK_INTERVAL"),
+	}
+	NetworkTimeoutFlag = &cli.DurationFlag{
+		Name:    "network-timeout",
+		Usage:   "Timeout of RPC requests, and of webhook alert requests",
+		Value:   10 * time.Second,
+		EnvVars: prefixEnvVars("NETWORK_TIMEOUT"),
+	}
+	AlertWebhookFlag = &cli.StringFlag{
+		Name:    "alert-webhook",
+		Usage:   "URL to POST JSON alerts to when an invariant is violated, and when it recovers. Alerts are only logged if not set.",
+		EnvVars: prefixEnvVars("ALERT_WEBHOOK"),
+	}
+)
+
+var requiredFlags = []cli.Flag{
+	L1EthRpcFlag,
+	L2EthRpcFlag,
+	RollupRpcFlag,
+}
+
+var optionalFlags = []cli.Flag{
+	L2OOAddressFlag,
+	OptimismPortalAddressFlag,
+	WithdrawalsStartBlockFlag,
+	MaxSafeHeadLagFlag,
+	BalanceThresholdsFlag,
+	CheckIntervalFlag,
+	NetworkTimeoutFlag,
+	AlertWebhookFlag,
+}
+
+// Flags contains the list of configuration options available to the binary.
+var Flags []cli.Flag
+
+func init() {
+	optionalFlags = append(optionalFlags, oplog.CLIFlags(envPrefix)...)
+	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(envPrefix)...)
+	optionalFlags = append(optionalFlags, oppprof.CLIFlags(envPrefix)...)
+
+	Flags = append(requiredFlags, optionalFlags...)
+}
@@ -0,0 +1,206 @@
+package op_monitor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prometheus/client_golang/prometheus"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+const Namespace = "op_monitor"
+
+// Results of a check, as recorded in metrics
+const (
+	CheckOK       = "ok"
+	CheckViolated = "violated"
+	CheckError    = "error"
+)
+
+type Metricer interface {
+	RecordInfo(version string)
+	RecordUp()
+
+	// RecordCheck records the result of a check: one of CheckOK, CheckViolated or CheckError
+	RecordCheck(check string, result string)
+	RecordAlert(status string, sent bool)
+
+	RecordSafeHeadLag(lag uint64)
+	RecordOutputChecked(index uint64, l2BlockNum uint64)
+	RecordWithdrawalsChecked(l1BlockNum uint64, proven int)
+	RecordBalance(name string, wei *big.Int)
+}
+
+type Metrics struct {
+	ns       string
+	registry *prometheus.Registry
+	factory  opmetrics.Factory
+
+	info prometheus.GaugeVec
+	up   prometheus.Gauge
+
+	violated          *prometheus.GaugeVec
+	checks            *prometheus.CounterVec
+	alerts            *prometheus.CounterVec
+	safeHeadLag       prometheus.Gauge
+	outputIndex       prometheus.Gauge
+	outputBlock       prometheus.Gauge
+	withdrawalsBlock  prometheus.Gauge
+	withdrawalsProven prometheus.Counter
+	balances          *prometheus.GaugeVec
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics() *Metrics {
+	ns := Namespace
+	registry := opmetrics.NewRegistry()
+	factory := opmetrics.With(registry)
+
+	return &Metrics{
+		ns:       ns,
+		registry: registry,
+		factory:  factory,
+
+		info: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "info",
+			Help:      "Pseudo-metric tracking version and config info",
+		}, []string{
+			"version",
+		}),
+		up: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "up",
+			Help:      "1 if the op-monitor has finished starting up",
+		}),
+		violated: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "invariant_violated",
+			Help:      "1 if the last completed check found the invariant to be violated, 0 otherwise",
+		}, []string{
+			"check",
+		}),
+		checks: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "checks_total",
+			Help:      "Number of checks, by result: ok, violated, or error if the invariant could not be checked",
+		}, []string{
+			"check",
+			"result",
+		}),
+		alerts: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "alerts_total",
+			Help:      "Number of alerts, firing or resolved, and whether sending the alert to the webhook succeeded",
+		}, []string{
+			"status",
+			"sent",
+		}),
+		safeHeadLag: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "safe_head_lag",
+			Help:      "Number of L2 blocks the safe head lags behind the unsafe head",
+		}),
+		outputIndex: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "output_checked_index",
+			Help:      "Index of the last L2OutputOracle output that was checked",
+		}),
+		outputBlock: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "output_checked_block",
+			Help:      "L2 block number of the last L2OutputOracle output that was checked",
+		}),
+		withdrawalsBlock: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "withdrawals_checked_block",
+			Help:      "Last L1 block of which the proven withdrawals were checked",
+		}),
+		withdrawalsProven: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "withdrawals_proven_total",
+			Help:      "Number of proven withdrawals that were checked",
+		}),
+		balances: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "balance",
+			Help:      "L1 balance (in ether) of a monitored account",
+		}, []string{
+			"name",
+		}),
+	}
+}
+
+func (m *Metrics) Serve(ctx context.Context, host string, port int) error {
+	return opmetrics.ListenAndServe(ctx, m.registry, host, port)
+}
+
+// RecordInfo sets a pseudo-metric that contains versioning and
+// config info for the op-monitor.
+func (m *Metrics) RecordInfo(version string) {
+	m.info.WithLabelValues(version).Set(1)
+}
+
+// RecordUp sets the up metric to 1.
+func (m *Metrics) RecordUp() {
+	m.up.Set(1)
+}
+
+func (m *Metrics) RecordCheck(check string, result string) {
+	m.checks.WithLabelValues(check, result).Inc()
+	switch result {
+	case CheckOK:
+		m.violated.WithLabelValues(check).Set(0)
+	case CheckViolated:
+		m.violated.WithLabelValues(check).Set(1)
+	}
+}
+
+func (m *Metrics) RecordAlert(status string, sent bool) {
+	if sent {
+		m.alerts.WithLabelValues(status, "true").Inc()
+	} else {
+		m.alerts.WithLabelValues(status, "false").Inc()
+	}
+}
+
+func (m *Metrics) RecordSafeHeadLag(lag uint64) {
+	m.safeHeadLag.Set(float64(lag))
+}
+
+func (m *Metrics) RecordOutputChecked(index uint64, l2BlockNum uint64) {
+	m.outputIndex.Set(float64(index))
+	m.outputBlock.Set(float64(l2BlockNum))
+}
+
+func (m *Metrics) RecordWithdrawalsChecked(l1BlockNum uint64, proven int) {
+	m.withdrawalsBlock.Set(float64(l1BlockNum))
+	m.withdrawalsProven.Add(float64(proven))
+}
+
+func (m *Metrics) RecordBalance(name string, wei *big.Int) {
+	ether, _ := new(big.Rat).SetFrac(wei, big.NewInt(params.Ether)).Float64()
+	m.balances.WithLabelValues(name).Set(ether)
+}
+
+func (m *Metrics) Document() []opmetrics.DocumentedMetric {
+	return m.factory.Document()
+}
+
+type noopMetrics struct{}
+
+var NoopMetrics Metricer = new(noopMetrics)
+
+func (*noopMetrics) RecordInfo(version string) {}
+func (*noopMetrics) RecordUp()                 {}
+
+func (*noopMetrics) RecordCheck(check string, result string) {}
+func (*noopMetrics) RecordAlert(status string, sent bool)    {}
+
+func (*noopMetrics) RecordSafeHeadLag(lag uint64)                           {}
+func (*noopMetrics) RecordOutputChecked(index uint64, l2BlockNum uint64)    {}
+func (*noopMetrics) RecordWithdrawalsChecked(l1BlockNum uint64, proven int) {}
+func (*noopMetrics) RecordBalance(name string, wei *big.Int)                {}
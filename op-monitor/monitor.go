@@ -0,0 +1,198 @@
+package op_monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+)
+
+func Main(version string) func(ctx *cli.Context) error {
+	return func(cliCtx *cli.Context) error {
+		cfg, err := NewConfig(cliCtx)
+		if err != nil {
+			return fmt.Errorf("invalid CLI flags: %w", err)
+		}
+		if err := cfg.Check(); err != nil {
+			return fmt.Errorf("invalid CLI flags: %w", err)
+		}
+
+		l := oplog.NewLogger(cfg.Log)
+		l.Info("starting monitor", "version", version)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Start(ctx, l, cfg, version)
+		}()
+
+		doneCh := make(chan os.Signal, 1)
+		signal.Notify(doneCh, []os.Signal{
+			os.Interrupt,
+			os.Kill,
+			syscall.SIGTERM,
+			syscall.SIGQUIT,
+		}...)
+		select {
+		case <-doneCh:
+			cancel()
+			return nil
+		case err := <-errCh:
+			return err
+		}
+	}
+}
+
+// Start connects to the L1, L2 and rollup node RPCs, and runs the configured checks until the context is canceled.
+func Start(ctx context.Context, l log.Logger, cfg Config, version string) error {
+	m := NewMetrics()
+	m.RecordInfo(version)
+
+	metricsCfg := cfg.Metrics
+	if metricsCfg.Enabled {
+		l.Info("starting metrics server", "addr", metricsCfg.ListenAddr, "port", metricsCfg.ListenPort)
+		go func() {
+			if err := m.Serve(ctx, metricsCfg.ListenAddr, metricsCfg.ListenPort); err != nil {
+				l.Error("error starting metrics server", "err", err)
+			}
+		}()
+	}
+
+	pprofCfg := cfg.Pprof
+	if pprofCfg.Enabled {
+		l.Info("starting pprof server", "addr", pprofCfg.ListenAddr, "port", pprofCfg.ListenPort)
+		go func() {
+			if err := oppprof.ListenAndServe(ctx, pprofCfg.ListenAddr, pprofCfg.ListenPort); err != nil {
+				l.Error("error starting pprof server", "err", err)
+			}
+		}()
+	}
+
+	l1Client, err := opclient.DialEthClientWithTimeout(ctx, cfg.L1EthRpc, cfg.NetworkTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 RPC: %w", err)
+	}
+	defer l1Client.Close()
+	l2Client, err := opclient.DialEthClientWithTimeout(ctx, cfg.L2EthRpc, cfg.NetworkTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer l2Client.Close()
+	rollupClient, err := opclient.DialRollupClientWithTimeout(ctx, cfg.RollupRpc, cfg.NetworkTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup node RPC: %w", err)
+	}
+
+	var checks []Check
+	if cfg.L2OOAddress != nil {
+		l2oo, err := bindings.NewL2OutputOracleCaller(*cfg.L2OOAddress, l1Client)
+		if err != nil {
+			return fmt.Errorf("failed to bind L2OutputOracle: %w", err)
+		}
+		checks = append(checks, NewOutputsCheck(l, m, l2oo, rollupClient))
+	}
+	if cfg.MaxSafeHeadLag != 0 {
+		checks = append(checks, NewSafeHeadLagCheck(m, rollupClient, cfg.MaxSafeHeadLag))
+	}
+	if cfg.OptimismPortalAddress != nil {
+		messagePasser, err := bindings.NewL2ToL1MessagePasserCaller(predeploys.L2ToL1MessagePasserAddr, l2Client)
+		if err != nil {
+			return fmt.Errorf("failed to bind L2ToL1MessagePasser: %w", err)
+		}
+		checks = append(checks, NewWithdrawalsCheck(l, m, l1Client, *cfg.OptimismPortalAddress, messagePasser, cfg.WithdrawalsStartBlock))
+	}
+	if len(cfg.BalanceThresholds) > 0 {
+		checks = append(checks, NewBalancesCheck(m, l1Client, cfg.BalanceThresholds))
+	}
+	if len(checks) == 0 {
+		return errors.New("no checks are enabled")
+	}
+
+	alerter := NewAlerter(l, m, cfg.AlertWebhook, cfg.NetworkTimeout)
+	monitor := NewMonitor(l, m, alerter, checks, cfg.NetworkTimeout)
+	m.RecordUp()
+	monitor.Run(ctx, cfg.CheckInterval)
+	return nil
+}
+
+type checkState struct {
+	Check
+	violated bool
+}
+
+// Monitor runs checks periodically, and alerts when an invariant becomes violated and when it recovers.
+type Monitor struct {
+	log     log.Logger
+	m       Metricer
+	alerter *Alerter
+	checks  []*checkState
+	timeout time.Duration
+}
+
+func NewMonitor(log log.Logger, m Metricer, alerter *Alerter, checks []Check, timeout time.Duration) *Monitor {
+	states := make([]*checkState, len(checks))
+	for i, c := range checks {
+		states[i] = &checkState{Check: c}
+	}
+	return &Monitor{log: log, m: m, alerter: alerter, checks: states, timeout: timeout}
+}
+
+// Run runs all checks at the given interval, until the context is canceled.
+func (mon *Monitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		mon.RunChecks(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunChecks runs all checks once.
+func (mon *Monitor) RunChecks(ctx context.Context) {
+	for _, c := range mon.checks {
+		mon.runCheck(ctx, c)
+	}
+}
+
+func (mon *Monitor) runCheck(ctx context.Context, c *checkState) {
+	checkCtx, cancel := context.WithTimeout(ctx, mon.timeout)
+	defer cancel()
+	err := c.Check.Check(checkCtx)
+	var violation *Violation
+	switch {
+	case err == nil:
+		mon.m.RecordCheck(c.Name(), CheckOK)
+		if c.violated {
+			c.violated = false
+			mon.alerter.Alert(ctx, Alert{Status: AlertResolved, Check: c.Name(), Message: "invariant holds again", Time: time.Now()})
+		}
+	case errors.As(err, &violation):
+		mon.m.RecordCheck(c.Name(), CheckViolated)
+		if !c.violated {
+			c.violated = true
+			mon.alerter.Alert(ctx, Alert{Status: AlertFiring, Check: c.Name(), Message: violation.Msg, Time: time.Now()})
+		}
+	default:
+		// The invariant could not be checked: the alert state is left as-is, and the check is retried on the next run.
+		mon.m.RecordCheck(c.Name(), CheckError)
+		mon.log.Warn("Failed to run check", "check", c.Name(), "err", err)
+	}
+}
@@ -0,0 +1,67 @@
+package op_monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+type stubCheck struct {
+	err error
+}
+
+func (s *stubCheck) Name() string {
+	return "stub"
+}
+
+func (s *stubCheck) Check(ctx context.Context) error {
+	return s.err
+}
+
+func TestMonitorAlerts(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	var alerts []Alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert Alert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts = append(alerts, alert)
+	}))
+	defer srv.Close()
+
+	check := &stubCheck{}
+	alerter := NewAlerter(logger, NoopMetrics, srv.URL, time.Second)
+	mon := NewMonitor(logger, NoopMetrics, alerter, []Check{check}, time.Second)
+	ctx := context.Background()
+
+	mon.RunChecks(ctx)
+	require.Empty(t, alerts)
+
+	// a violation alerts once
+	check.err = violationf("broken")
+	mon.RunChecks(ctx)
+	mon.RunChecks(ctx)
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertFiring, alerts[0].Status)
+	require.Equal(t, "stub", alerts[0].Check)
+	require.Equal(t, "broken", alerts[0].Message)
+
+	// failing to check does not resolve the alert
+	check.err = errors.New("rpc down")
+	mon.RunChecks(ctx)
+	require.Len(t, alerts, 1)
+
+	check.err = nil
+	mon.RunChecks(ctx)
+	mon.RunChecks(ctx)
+	require.Len(t, alerts, 2)
+	require.Equal(t, AlertResolved, alerts[1].Status)
+}
@@ -0,0 +1,153 @@
+package op_monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+// supportedL2OutputVersion is the version of the L2 output that can be checked
+var supportedL2OutputVersion = eth.Bytes32{}
+
+// OutputOracle is the subset of the L2OutputOracle bindings to read proposed outputs with
+type OutputOracle interface {
+	LatestOutputIndex(opts *bind.CallOpts) (*big.Int, error)
+	GetL2Output(opts *bind.CallOpts, l2OutputIndex *big.Int) (bindings.TypesOutputProposal, error)
+}
+
+type OutputSource interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// invalidOutput is a proposed output that does not match the output of the rollup node
+type invalidOutput struct {
+	index    *big.Int
+	blockNum uint64
+	root     eth.Bytes32
+	expected eth.Bytes32
+}
+
+// maxRecheckOutputs is the number of most recently checked outputs that are remembered,
+// to detect when they are deleted and replaced by other outputs.
+const maxRecheckOutputs = 64
+
+// OutputsCheck verifies that every output proposed to the L2OutputOracle matches the output of the rollup node.
+// Outputs are checked once, in order of their index, starting at the latest output when the monitor starts.
+// Outputs that replace deleted outputs are checked again.
+// An invalid output remains a violation until it is deleted from the L2OutputOracle.
+type OutputsCheck struct {
+	log     log.Logger
+	m       Metricer
+	oracle  OutputOracle
+	outputs OutputSource
+
+	// next is the index of the next output to check, nil until the first check
+	next *big.Int
+	// checked are the roots of the most recently checked outputs, by index
+	checked map[uint64]eth.Bytes32
+	// invalid is the first invalid output that was found, nil if none
+	invalid *invalidOutput
+}
+
+var _ Check = (*OutputsCheck)(nil)
+
+func NewOutputsCheck(log log.Logger, m Metricer, oracle OutputOracle, outputs OutputSource) *OutputsCheck {
+	return &OutputsCheck{log: log, m: m, oracle: oracle, outputs: outputs, checked: make(map[uint64]eth.Bytes32)}
+}
+
+func (c *OutputsCheck) Name() string {
+	return "outputs"
+}
+
+func (c *OutputsCheck) Check(ctx context.Context) error {
+	latest, err := c.oracle.LatestOutputIndex(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		// the oracle reverts if there are no outputs yet
+		return fmt.Errorf("failed to fetch latest output index: %w", err)
+	}
+	if c.next == nil {
+		c.next = new(big.Int).Set(latest)
+	}
+	if err := c.rewindReplaced(ctx, latest); err != nil {
+		return err
+	}
+	for ; c.next.Cmp(latest) <= 0; c.next.Add(c.next, one) {
+		proposal, err := c.oracle.GetL2Output(&bind.CallOpts{Context: ctx}, c.next)
+		if err != nil {
+			return fmt.Errorf("failed to fetch output %d: %w", c.next, err)
+		}
+		blockNum := proposal.L2BlockNumber.Uint64()
+		expected, err := c.expectedOutput(ctx, blockNum)
+		if err != nil {
+			return err
+		}
+		c.m.RecordOutputChecked(c.next.Uint64(), blockNum)
+		root := eth.Bytes32(proposal.OutputRoot)
+		c.checked[c.next.Uint64()] = root
+		delete(c.checked, c.next.Uint64()-maxRecheckOutputs)
+		if root != expected {
+			c.log.Error("Found invalid output", "index", c.next, "l2_block", blockNum, "output_root", root, "expected", expected)
+			if c.invalid == nil {
+				c.invalid = &invalidOutput{index: new(big.Int).Set(c.next), blockNum: blockNum, root: root, expected: expected}
+			}
+			continue
+		}
+		c.log.Debug("Checked output", "index", c.next, "l2_block", blockNum, "output_root", expected)
+	}
+	if c.invalid != nil {
+		return violationf("output %d of L2 block %d is %s, but the rollup node computed %s",
+			c.invalid.index, c.invalid.blockNum, c.invalid.root, c.invalid.expected)
+	}
+	return nil
+}
+
+// rewindReplaced moves the next output to check back to the first checked output that was deleted,
+// since outputs are deleted by the challenger, after which new outputs are proposed at the same indices.
+func (c *OutputsCheck) rewindReplaced(ctx context.Context, latest *big.Int) error {
+	for c.next.Sign() > 0 {
+		prev := new(big.Int).Sub(c.next, one)
+		root, ok := c.checked[prev.Uint64()]
+		if !ok {
+			return nil
+		}
+		if prev.Cmp(latest) <= 0 {
+			proposal, err := c.oracle.GetL2Output(&bind.CallOpts{Context: ctx}, prev)
+			if err != nil {
+				return fmt.Errorf("failed to fetch output %d: %w", prev, err)
+			}
+			if eth.Bytes32(proposal.OutputRoot) == root {
+				return nil
+			}
+		}
+		c.log.Warn("Output was deleted", "index", prev, "output_root", root)
+		delete(c.checked, prev.Uint64())
+		if c.invalid != nil && c.invalid.index.Cmp(prev) == 0 {
+			c.invalid = nil
+		}
+		c.next = prev
+	}
+	return nil
+}
+
+// expectedOutput fetches the output root of the L2 block from the rollup node
+func (c *OutputsCheck) expectedOutput(ctx context.Context, blockNum uint64) (eth.Bytes32, error) {
+	output, err := c.outputs.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to fetch output of L2 block %d from the rollup node: %w", blockNum, err)
+	}
+	if output.Version != supportedL2OutputVersion {
+		return eth.Bytes32{}, fmt.Errorf("unsupported output version %s of L2 block %d", output.Version, blockNum)
+	}
+	if output.BlockRef.Number != blockNum {
+		return eth.Bytes32{}, fmt.Errorf("rollup node returned output of L2 block %d, but requested block %d", output.BlockRef.Number, blockNum)
+	}
+	return output.OutputRoot, nil
+}
+
+var one = big.NewInt(1)
@@ -0,0 +1,47 @@
+package op_monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+type SyncStatusSource interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+}
+
+// SafeHeadLagCheck verifies that the safe head does not lag too far behind the unsafe head,
+// i.e. that the batcher submits the L2 blocks to L1 in time.
+type SafeHeadLagCheck struct {
+	m      Metricer
+	source SyncStatusSource
+	maxLag uint64
+}
+
+var _ Check = (*SafeHeadLagCheck)(nil)
+
+func NewSafeHeadLagCheck(m Metricer, source SyncStatusSource, maxLag uint64) *SafeHeadLagCheck {
+	return &SafeHeadLagCheck{m: m, source: source, maxLag: maxLag}
+}
+
+func (c *SafeHeadLagCheck) Name() string {
+	return "safe_head_lag"
+}
+
+func (c *SafeHeadLagCheck) Check(ctx context.Context) error {
+	status, err := c.source.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sync status: %w", err)
+	}
+	var lag uint64
+	if status.UnsafeL2.Number > status.SafeL2.Number {
+		lag = status.UnsafeL2.Number - status.SafeL2.Number
+	}
+	c.m.RecordSafeHeadLag(lag)
+	if lag > c.maxLag {
+		return violationf("safe head %s lags %d blocks behind unsafe head %s, more than the maximum of %d",
+			status.SafeL2.ID(), lag, status.UnsafeL2.ID(), c.maxLag)
+	}
+	return nil
+}
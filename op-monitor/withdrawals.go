@@ -0,0 +1,111 @@
+package op_monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+// maxWithdrawalsRange is the maximum number of L1 blocks to fetch proven withdrawals of in a single check
+const maxWithdrawalsRange = 1000
+
+type L1Logs interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// SentMessages is the subset of the L2ToL1MessagePasser bindings to look up sent withdrawals with
+type SentMessages interface {
+	SentMessages(opts *bind.CallOpts, withdrawalHash [32]byte) (bool, error)
+}
+
+// WithdrawalsCheck verifies that every withdrawal that is proven on the OptimismPortal was sent on L2.
+// A proven withdrawal that was never sent indicates a forged proof, which must be stopped before it is finalized.
+type WithdrawalsCheck struct {
+	log      log.Logger
+	m        Metricer
+	l1       L1Logs
+	portal   common.Address
+	messages SentMessages
+
+	// next is the next L1 block to check the proven withdrawals of, the L1 head at the first check if 0
+	next uint64
+	// forged are the proven withdrawals that were never sent, remembered to keep alerting about them
+	forged []common.Hash
+}
+
+var _ Check = (*WithdrawalsCheck)(nil)
+
+func NewWithdrawalsCheck(log log.Logger, m Metricer, l1 L1Logs, portal common.Address, messages SentMessages, startBlock uint64) *WithdrawalsCheck {
+	return &WithdrawalsCheck{log: log, m: m, l1: l1, portal: portal, messages: messages, next: startBlock}
+}
+
+func (c *WithdrawalsCheck) Name() string {
+	return "withdrawals"
+}
+
+func (c *WithdrawalsCheck) Check(ctx context.Context) error {
+	head, err := c.l1.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	if c.next == 0 {
+		c.next = head
+	}
+	for c.next <= head {
+		end := head
+		if end-c.next >= maxWithdrawalsRange {
+			end = c.next + maxWithdrawalsRange - 1
+		}
+		if err := c.checkRange(ctx, c.next, end); err != nil {
+			return err
+		}
+		c.next = end + 1
+	}
+	if len(c.forged) > 0 {
+		return violationf("withdrawals %v were proven on L1, but never sent on L2", c.forged)
+	}
+	return nil
+}
+
+func (c *WithdrawalsCheck) checkRange(ctx context.Context, start, end uint64) error {
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("failed to load OptimismPortal ABI: %w", err)
+	}
+	logs, err := c.l1.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(start),
+		ToBlock:   new(big.Int).SetUint64(end),
+		Addresses: []common.Address{c.portal},
+		Topics:    [][]common.Hash{{portalABI.Events["WithdrawalProven"].ID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch proven withdrawals of L1 blocks %d to %d: %w", start, end, err)
+	}
+	for _, l := range logs {
+		if len(l.Topics) < 2 {
+			return fmt.Errorf("invalid WithdrawalProven event in L1 tx %s", l.TxHash)
+		}
+		withdrawalHash := l.Topics[1]
+		sent, err := c.messages.SentMessages(&bind.CallOpts{Context: ctx}, withdrawalHash)
+		if err != nil {
+			return fmt.Errorf("failed to look up withdrawal %s on L2: %w", withdrawalHash, err)
+		}
+		if !sent {
+			c.log.Error("Proven withdrawal was never sent on L2", "withdrawal", withdrawalHash, "l1_block", l.BlockNumber, "tx", l.TxHash)
+			c.forged = append(c.forged, withdrawalHash)
+			continue
+		}
+		c.log.Debug("Checked proven withdrawal", "withdrawal", withdrawalHash, "l1_block", l.BlockNumber)
+	}
+	c.m.RecordWithdrawalsChecked(end, len(logs))
+	return nil
+}
@@ -1,7 +1,6 @@
 package chaincfg
 
 import (
-	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -70,34 +69,56 @@ var Goerli = rollup.Config{
 	RegolithTime:           u64Ptr(1679079600),
 }
 
-var NetworksByName = map[string]rollup.Config{
-	"goerli":  Goerli,
-	"mainnet": Mainnet,
+// predefinedChains are the chains of every registry, with the L1 contract addresses of their deployments.
+var predefinedChains = []*ChainDefinition{
+	{
+		Name:   "mainnet",
+		Rollup: Mainnet,
+		Addresses: map[string]common.Address{
+			L2OutputOracleProxy:         common.HexToAddress("0xdfe97868233d1aa22e815a266982f2cf17685a27"),
+			OptimismPortalProxy:         common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed"),
+			SystemConfigProxy:           common.HexToAddress("0x229047fed2591dbec1eF1118d64F7aF3dB9EB290"),
+			L1StandardBridgeProxy:       common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"),
+			L1CrossDomainMessengerProxy: common.HexToAddress("0x25ace71c97B33Cc4729CF772ae268934F7ab5fA1"),
+		},
+	},
+	{
+		Name:   "goerli",
+		Rollup: Goerli,
+		Addresses: map[string]common.Address{
+			L2OutputOracleProxy:         common.HexToAddress("0xE6Dfba0953616Bacab0c9A8ecb3a9BBa77FC15c0"),
+			OptimismPortalProxy:         common.HexToAddress("0x5b47E1A08Ea6d985D6649300584e6722Ec4B1383"),
+			SystemConfigProxy:           common.HexToAddress("0xAe851f927Ee40dE99aaBb7461C00f9622ab91d60"),
+			L1StandardBridgeProxy:       common.HexToAddress("0x636Af16bf2f682dD3109e60102b8E1A089FedAa8"),
+			L1CrossDomainMessengerProxy: common.HexToAddress("0x5086d1eEF304eb5284A0f6720f79403b4e9bE294"),
+		},
+	},
 }
 
-var L2ChainIDToNetworkName = func() map[string]string {
-	out := make(map[string]string)
-	for name, netCfg := range NetworksByName {
-		out[netCfg.L2ChainID.String()] = name
+// predefinedRegistry is the registry of the predefined networks, that the network helpers below are derived from.
+var predefinedRegistry = NewRegistry()
+
+var NetworksByName = func() map[string]rollup.Config {
+	out := make(map[string]rollup.Config)
+	for _, name := range predefinedRegistry.Names() {
+		chain, _ := predefinedRegistry.Chain(name)
+		out[name] = chain.Rollup
 	}
 	return out
 }()
 
+var L2ChainIDToNetworkName = predefinedRegistry.L2ChainIDToNetworkName()
+
 func AvailableNetworks() []string {
-	var networks []string
-	for name := range NetworksByName {
-		networks = append(networks, name)
-	}
-	return networks
+	return predefinedRegistry.Names()
 }
 
 func GetRollupConfig(name string) (rollup.Config, error) {
-	network, ok := NetworksByName[name]
-	if !ok {
-		return rollup.Config{}, fmt.Errorf("invalid network %s", name)
+	chain, err := predefinedRegistry.Chain(name)
+	if err != nil {
+		return rollup.Config{}, err
 	}
-
-	return network, nil
+	return chain.Rollup, nil
 }
 
 func u64Ptr(v uint64) *uint64 {
@@ -0,0 +1,270 @@
+package chaincfg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// Names of the L1 contracts in the addresses of a chain definition.
+const (
+	L2OutputOracleProxy         = "L2OutputOracleProxy"
+	OptimismPortalProxy         = "OptimismPortalProxy"
+	SystemConfigProxy           = "SystemConfigProxy"
+	L1StandardBridgeProxy       = "L1StandardBridgeProxy"
+	L1CrossDomainMessengerProxy = "L1CrossDomainMessengerProxy"
+	DisputeGameFactoryProxy     = "DisputeGameFactoryProxy"
+)
+
+// ChainDefinition defines a chain of the registry.
+// The fork times of the chain are part of its rollup config, see rollup.Config.ForkSchedule.
+type ChainDefinition struct {
+	Name   string        `json:"name"`
+	Rollup rollup.Config `json:"rollup"`
+	// L2Genesis is the path to the L2 genesis file of the chain, if any.
+	// When loaded from a file, relative paths are resolved against the directory of the chain definition.
+	L2Genesis string `json:"l2_genesis,omitempty"`
+	// Addresses of the L1 contracts of the chain, by contract name
+	Addresses map[string]common.Address `json:"addresses,omitempty"`
+}
+
+// Check verifies that the chain definition is valid, and that its addresses match the rollup config.
+func (d *ChainDefinition) Check() error {
+	if d.Name == "" {
+		return errors.New("missing chain name")
+	}
+	if err := d.Rollup.Check(); err != nil {
+		return fmt.Errorf("invalid rollup config of chain %s: %w", d.Name, err)
+	}
+	for name, addr := range d.Addresses {
+		if addr == (common.Address{}) {
+			return fmt.Errorf("chain %s has zero address for %s", d.Name, name)
+		}
+	}
+	if addr, ok := d.Addresses[OptimismPortalProxy]; ok && addr != d.Rollup.DepositContractAddress {
+		return fmt.Errorf("chain %s has %s %s, but deposit contract %s", d.Name, OptimismPortalProxy, addr, d.Rollup.DepositContractAddress)
+	}
+	if addr, ok := d.Addresses[SystemConfigProxy]; ok && addr != d.Rollup.L1SystemConfigAddress {
+		return fmt.Errorf("chain %s has %s %s, but system config %s", d.Name, SystemConfigProxy, addr, d.Rollup.L1SystemConfigAddress)
+	}
+	return nil
+}
+
+// Address returns the address of the L1 contract with the given name, or an error if the chain does not define it.
+func (d *ChainDefinition) Address(name string) (common.Address, error) {
+	addr, ok := d.Addresses[name]
+	if !ok {
+		return common.Address{}, fmt.Errorf("chain %s does not define the %s address", d.Name, name)
+	}
+	return addr, nil
+}
+
+// L1Client is the L1 RPC used to cross-check chain definitions against L1
+type L1Client interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// CheckL1 cross-checks the chain definition against L1: the L1 chain ID, the L1 genesis block,
+// and that contracts are deployed at the deposit contract, system config and chain addresses.
+func (d *ChainDefinition) CheckL1(ctx context.Context, client L1Client) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 chain ID: %w", err)
+	}
+	if chainID.Cmp(d.Rollup.L1ChainID) != 0 {
+		return fmt.Errorf("chain %s expects L1 chain ID %s, but L1 has chain ID %s", d.Name, d.Rollup.L1ChainID, chainID)
+	}
+	genesis, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(d.Rollup.Genesis.L1.Number))
+	if err != nil {
+		return fmt.Errorf("failed to get L1 genesis block %d: %w", d.Rollup.Genesis.L1.Number, err)
+	}
+	if genesis.Hash() != d.Rollup.Genesis.L1.Hash {
+		return fmt.Errorf("chain %s expects L1 genesis block %s, but L1 has block %s", d.Name, d.Rollup.Genesis.L1, genesis.Hash())
+	}
+	contracts := map[string]common.Address{
+		"deposit contract": d.Rollup.DepositContractAddress,
+		"system config":    d.Rollup.L1SystemConfigAddress,
+	}
+	for name, addr := range d.Addresses {
+		contracts[name] = addr
+	}
+	for _, name := range sortedKeys(contracts) {
+		addr := contracts[name]
+		if addr == (common.Address{}) {
+			continue
+		}
+		code, err := client.CodeAt(ctx, addr, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get code of %s at %s: %w", name, addr, err)
+		}
+		if len(code) == 0 {
+			return fmt.Errorf("chain %s has no %s contract deployed at %s", d.Name, name, addr)
+		}
+	}
+	return nil
+}
+
+// CheckRollupConfig verifies that the rollup config, e.g. as served by a rollup node, is the config of the chain.
+func (d *ChainDefinition) CheckRollupConfig(cfg *rollup.Config) error {
+	if cfg.L1ChainID == nil || cfg.L1ChainID.Cmp(d.Rollup.L1ChainID) != 0 {
+		return fmt.Errorf("chain %s expects L1 chain ID %s, but got %v", d.Name, d.Rollup.L1ChainID, cfg.L1ChainID)
+	}
+	if cfg.L2ChainID == nil || cfg.L2ChainID.Cmp(d.Rollup.L2ChainID) != 0 {
+		return fmt.Errorf("chain %s expects L2 chain ID %s, but got %v", d.Name, d.Rollup.L2ChainID, cfg.L2ChainID)
+	}
+	if cfg.Genesis.L1 != d.Rollup.Genesis.L1 || cfg.Genesis.L2 != d.Rollup.Genesis.L2 {
+		return fmt.Errorf("chain %s expects genesis L1 %s and L2 %s, but got L1 %s and L2 %s",
+			d.Name, d.Rollup.Genesis.L1, d.Rollup.Genesis.L2, cfg.Genesis.L1, cfg.Genesis.L2)
+	}
+	if cfg.BatchInboxAddress != d.Rollup.BatchInboxAddress {
+		return fmt.Errorf("chain %s expects batch inbox %s, but got %s", d.Name, d.Rollup.BatchInboxAddress, cfg.BatchInboxAddress)
+	}
+	if cfg.DepositContractAddress != d.Rollup.DepositContractAddress {
+		return fmt.Errorf("chain %s expects deposit contract %s, but got %s", d.Name, d.Rollup.DepositContractAddress, cfg.DepositContractAddress)
+	}
+	if cfg.L1SystemConfigAddress != d.Rollup.L1SystemConfigAddress {
+		return fmt.Errorf("chain %s expects system config %s, but got %s", d.Name, d.Rollup.L1SystemConfigAddress, cfg.L1SystemConfigAddress)
+	}
+	return nil
+}
+
+// ChainDefinitionFile is the file name of the chain definitions in a registry directory
+const ChainDefinitionFile = "chain.json"
+
+// LoadChainDefinition reads a chain definition from a JSON file.
+// The name of the chain defaults to the name of the directory of the file.
+func LoadChainDefinition(path string) (*ChainDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain definition: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var def ChainDefinition
+	if err := dec.Decode(&def); err != nil {
+		return nil, fmt.Errorf("failed to decode chain definition %s: %w", path, err)
+	}
+	if def.Name == "" {
+		def.Name = filepath.Base(filepath.Dir(path))
+	}
+	if def.L2Genesis != "" && !filepath.IsAbs(def.L2Genesis) {
+		def.L2Genesis = filepath.Join(filepath.Dir(path), def.L2Genesis)
+	}
+	return &def, nil
+}
+
+// Registry holds the chain definitions that can be selected by name.
+type Registry struct {
+	chains map[string]*ChainDefinition
+}
+
+// NewRegistry creates a registry with the predefined networks.
+func NewRegistry() *Registry {
+	r := &Registry{chains: make(map[string]*ChainDefinition)}
+	for _, def := range predefinedChains {
+		if err := r.Add(def); err != nil {
+			panic(fmt.Errorf("invalid predefined chain %s: %w", def.Name, err))
+		}
+	}
+	return r
+}
+
+// LoadRegistry creates a registry with the predefined networks, and the chain definitions in the given directory.
+// Each chain has its own sub-directory, with its definition in a ChainDefinitionFile, next to e.g. its L2 genesis.
+// An empty directory path loads just the predefined networks.
+func LoadRegistry(dir string) (*Registry, error) {
+	r := NewRegistry()
+	if dir == "" {
+		return r, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*", ChainDefinitionFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chain definitions: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no chain definitions found in %s", dir)
+	}
+	for _, path := range paths {
+		def, err := LoadChainDefinition(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.Add(def); err != nil {
+			return nil, fmt.Errorf("invalid chain definition %s: %w", path, err)
+		}
+	}
+	return r, nil
+}
+
+// Add checks the chain definition, and adds it to the registry.
+// Both the name and the L2 chain ID of the chain must be unique in the registry.
+func (r *Registry) Add(def *ChainDefinition) error {
+	if err := def.Check(); err != nil {
+		return err
+	}
+	if _, ok := r.chains[def.Name]; ok {
+		return fmt.Errorf("duplicate chain %s", def.Name)
+	}
+	for _, other := range r.chains {
+		if other.Rollup.L2ChainID.Cmp(def.Rollup.L2ChainID) == 0 {
+			return fmt.Errorf("chain %s has the same L2 chain ID %s as chain %s", def.Name, def.Rollup.L2ChainID, other.Name)
+		}
+	}
+	r.chains[def.Name] = def
+	return nil
+}
+
+// Chain returns the chain definition with the given name.
+func (r *Registry) Chain(name string) (*ChainDefinition, error) {
+	def, ok := r.chains[name]
+	if !ok {
+		return nil, fmt.Errorf("invalid network %s", name)
+	}
+	return def, nil
+}
+
+// Names returns the names of the chains in the registry, in alphabetical order.
+func (r *Registry) Names() []string {
+	return sortedKeys(r.chains)
+}
+
+// L2ChainIDToNetworkName maps the L2 chain IDs of the chains in the registry to their names.
+func (r *Registry) L2ChainIDToNetworkName() map[string]string {
+	out := make(map[string]string)
+	for name, def := range r.chains {
+		out[def.Rollup.L2ChainID.String()] = name
+	}
+	return out
+}
+
+// LoadChain returns the chain definition of the network, from the predefined networks
+// and the chain definitions in the registry directory, if any.
+func LoadChain(registryDir string, name string) (*ChainDefinition, error) {
+	r, err := LoadRegistry(registryDir)
+	if err != nil {
+		return nil, err
+	}
+	return r.Chain(name)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,190 @@
+package chaincfg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+func customChain() *ChainDefinition {
+	rollupCfg := Goerli
+	rollupCfg.L2ChainID = big.NewInt(1234)
+	return &ChainDefinition{
+		Rollup:    rollupCfg,
+		L2Genesis: "genesis.json",
+		Addresses: map[string]common.Address{
+			L2OutputOracleProxy: common.HexToAddress("0x1234"),
+			OptimismPortalProxy: rollupCfg.DepositContractAddress,
+		},
+	}
+}
+
+func writeChain(t *testing.T, dir string, name string, def any) {
+	data, err := json.Marshal(def)
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name, ChainDefinitionFile), data, 0644))
+}
+
+func TestPredefinedChains(t *testing.T) {
+	r := NewRegistry()
+	require.Equal(t, []string{"goerli", "mainnet"}, r.Names())
+	require.Equal(t, r.Names(), AvailableNetworks())
+	require.Equal(t, map[string]rollup.Config{"goerli": Goerli, "mainnet": Mainnet}, NetworksByName)
+	require.Equal(t, map[string]string{"10": "mainnet", "420": "goerli"}, L2ChainIDToNetworkName)
+	cfg, err := GetRollupConfig("goerli")
+	require.NoError(t, err)
+	require.Equal(t, Goerli, cfg)
+	_, err = GetRollupConfig("bar")
+	require.ErrorContains(t, err, "invalid network bar")
+	_, err = r.Chain("bar")
+	require.ErrorContains(t, err, "invalid network bar")
+}
+
+func TestLoadRegistry(t *testing.T) {
+	t.Run("NoDirectory", func(t *testing.T) {
+		r, err := LoadRegistry("")
+		require.NoError(t, err)
+		require.Equal(t, NewRegistry(), r)
+	})
+
+	t.Run("EmptyDirectory", func(t *testing.T) {
+		_, err := LoadRegistry(t.TempDir())
+		require.ErrorContains(t, err, "no chain definitions")
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		dir := t.TempDir()
+		writeChain(t, dir, "custom", customChain())
+		chain, err := LoadChain(dir, "custom")
+		require.NoError(t, err)
+		require.Equal(t, "custom", chain.Name, "name defaults to the directory name")
+		require.Equal(t, filepath.Join(dir, "custom", "genesis.json"), chain.L2Genesis, "genesis is relative to the definition")
+		require.Equal(t, customChain().Rollup, chain.Rollup)
+		addr, err := chain.Address(L2OutputOracleProxy)
+		require.NoError(t, err)
+		require.Equal(t, common.HexToAddress("0x1234"), addr)
+		_, err = chain.Address(DisputeGameFactoryProxy)
+		require.ErrorContains(t, err, "does not define")
+
+		chain, err = LoadChain(dir, "goerli")
+		require.NoError(t, err)
+		require.Equal(t, Goerli, chain.Rollup, "predefined networks are available too")
+	})
+
+	t.Run("InvalidRollupConfig", func(t *testing.T) {
+		dir := t.TempDir()
+		def := customChain()
+		def.Rollup.BlockTime = 0
+		writeChain(t, dir, "custom", def)
+		_, err := LoadRegistry(dir)
+		require.ErrorContains(t, err, "invalid rollup config of chain custom")
+	})
+
+	t.Run("DuplicateL2ChainID", func(t *testing.T) {
+		dir := t.TempDir()
+		def := customChain()
+		def.Rollup.L2ChainID = Goerli.L2ChainID
+		writeChain(t, dir, "custom", def)
+		_, err := LoadRegistry(dir)
+		require.ErrorContains(t, err, "same L2 chain ID")
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		dir := t.TempDir()
+		writeChain(t, dir, "goerli", customChain())
+		_, err := LoadRegistry(dir)
+		require.ErrorContains(t, err, "duplicate chain goerli")
+	})
+
+	t.Run("InconsistentAddresses", func(t *testing.T) {
+		dir := t.TempDir()
+		def := customChain()
+		def.Addresses[SystemConfigProxy] = common.HexToAddress("0x5678")
+		writeChain(t, dir, "custom", def)
+		_, err := LoadRegistry(dir)
+		require.ErrorContains(t, err, "SystemConfigProxy")
+	})
+
+	t.Run("UnknownField", func(t *testing.T) {
+		dir := t.TempDir()
+		writeChain(t, dir, "custom", map[string]any{"rollup": customChain().Rollup, "adresses": map[string]string{}})
+		_, err := LoadRegistry(dir)
+		require.ErrorContains(t, err, "unknown field")
+	})
+}
+
+func TestCheckRollupConfig(t *testing.T) {
+	chain, err := NewRegistry().Chain("goerli")
+	require.NoError(t, err)
+	cfg := Goerli
+	require.NoError(t, chain.CheckRollupConfig(&cfg))
+	require.ErrorContains(t, chain.CheckRollupConfig(&Mainnet), "chain ID")
+	cfg.BatchInboxAddress = common.HexToAddress("0x1234")
+	require.ErrorContains(t, chain.CheckRollupConfig(&cfg), "batch inbox")
+}
+
+type mockL1 struct {
+	chainID *big.Int
+	headers map[uint64]*types.Header
+	code    map[common.Address][]byte
+}
+
+func (m *mockL1) ChainID(ctx context.Context) (*big.Int, error) {
+	return m.chainID, nil
+}
+
+func (m *mockL1) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	header, ok := m.headers[number.Uint64()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return header, nil
+}
+
+func (m *mockL1) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return m.code[account], nil
+}
+
+func TestCheckL1(t *testing.T) {
+	genesis := &types.Header{Number: big.NewInt(100), Extra: []byte("genesis")}
+	chain := customChain()
+	chain.Name = "custom"
+	chain.Rollup.Genesis.L1 = eth.BlockID{Hash: genesis.Hash(), Number: 100}
+	l1 := func() *mockL1 {
+		return &mockL1{
+			chainID: chain.Rollup.L1ChainID,
+			headers: map[uint64]*types.Header{100: genesis},
+			code: map[common.Address][]byte{
+				chain.Rollup.DepositContractAddress: {1},
+				chain.Rollup.L1SystemConfigAddress:  {1},
+				common.HexToAddress("0x1234"):       {1},
+			},
+		}
+	}
+	ctx := context.Background()
+	require.NoError(t, chain.CheckL1(ctx, l1()))
+
+	wrongChainID := l1()
+	wrongChainID.chainID = big.NewInt(1)
+	require.ErrorContains(t, chain.CheckL1(ctx, wrongChainID), "L1 chain ID")
+
+	wrongGenesis := l1()
+	wrongGenesis.headers[100] = &types.Header{Number: big.NewInt(100)}
+	require.ErrorContains(t, chain.CheckL1(ctx, wrongGenesis), "L1 genesis block")
+
+	missingCode := l1()
+	delete(missingCode.code, common.HexToAddress("0x1234"))
+	require.ErrorContains(t, chain.CheckL1(ctx, missingCode), "no L2OutputOracleProxy contract")
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RPCFixture is a list of RPC calls and their responses, recorded by a RecordingRPC,
+// to be served again by a ReplayRPC.
+type RPCFixture struct {
+	Calls []RecordedCall `json:"calls"`
+}
+
+// RecordedCall is a single recorded RPC request and response. Either Result or Error is set.
+type RecordedCall struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *RecordedError  `json:"error,omitempty"`
+}
+
+// RecordedError is an error response of the RPC server.
+// It implements rpc.Error and rpc.DataError, like the error the server originally returned.
+type RecordedError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+func (e *RecordedError) Error() string {
+	return e.Message
+}
+
+func (e *RecordedError) ErrorCode() int {
+	return e.Code
+}
+
+func (e *RecordedError) ErrorData() any {
+	return e.Data
+}
+
+// LoadRPCFixture reads a fixture from a JSON file. Files with a ".gz" extension are gzip-decompressed.
+func LoadRPCFixture(path string) (*RPCFixture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open fixture: %w", err)
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress fixture: %w", err)
+		}
+		defer gr.Close()
+		r = gr
+	}
+	var fixture RPCFixture
+	if err := json.NewDecoder(r).Decode(&fixture); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture: %w", err)
+	}
+	return &fixture, nil
+}
+
+// Save writes the fixture to a JSON file, replacing the file atomically.
+// Files with a ".gz" extension are gzip-compressed.
+func (f *RPCFixture) Save(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create fixture file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := f.write(tmp, strings.HasSuffix(path, ".gz")); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close fixture file: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *RPCFixture) write(w io.Writer, compress bool) error {
+	if compress {
+		gw := gzip.NewWriter(w)
+		if err := f.write(gw, false); err != nil {
+			return err
+		}
+		if err := gw.Close(); err != nil {
+			return fmt.Errorf("failed to compress fixture: %w", err)
+		}
+		return nil
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RecordingRPC is a wrapper around an RPC that records all calls and their responses,
+// to be saved as fixture and replayed with a ReplayRPC.
+// Only responses of the RPC server are recorded: calls that fail without a response,
+// e.g. due to a timeout, are not. Subscriptions are passed through and not recorded.
+type RecordingRPC struct {
+	c RPC
+
+	mu    sync.Mutex
+	calls []RecordedCall
+}
+
+var _ RPC = (*RecordingRPC)(nil)
+
+func NewRecordingRPC(c RPC) *RecordingRPC {
+	return &RecordingRPC{c: c}
+}
+
+func (r *RecordingRPC) Close() {
+	r.c.Close()
+}
+
+func (r *RecordingRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	var raw json.RawMessage
+	err := r.c.CallContext(ctx, &raw, method, args...)
+	if err := r.record(method, args, raw, err); err != nil {
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	return unmarshalResult(raw, result)
+}
+
+func (r *RecordingRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	raw := make([]json.RawMessage, len(b))
+	batch := make([]rpc.BatchElem, len(b))
+	for i, elem := range b {
+		batch[i] = rpc.BatchElem{Method: elem.Method, Args: elem.Args, Result: &raw[i]}
+	}
+	if err := r.c.BatchCallContext(ctx, batch); err != nil {
+		return err
+	}
+	for i, elem := range batch {
+		if err := r.record(elem.Method, elem.Args, raw[i], elem.Error); err != nil {
+			return err
+		}
+		b[i].Error = elem.Error
+		if elem.Error == nil {
+			b[i].Error = unmarshalResult(raw[i], b[i].Result)
+		}
+	}
+	return nil
+}
+
+func (r *RecordingRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return r.c.EthSubscribe(ctx, channel, args...)
+}
+
+// Fixture returns the calls recorded so far.
+func (r *RecordingRPC) Fixture() *RPCFixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &RPCFixture{Calls: append([]RecordedCall(nil), r.calls...)}
+}
+
+func (r *RecordingRPC) record(method string, args []any, result json.RawMessage, callErr error) error {
+	params, err := encodeParams(args)
+	if err != nil {
+		return err
+	}
+	call := RecordedCall{Method: method, Params: params}
+	if callErr != nil {
+		var rpcErr rpc.Error
+		if !errors.As(callErr, &rpcErr) {
+			return nil
+		}
+		call.Error = &RecordedError{Code: rpcErr.ErrorCode(), Message: rpcErr.Error()}
+		var dataErr rpc.DataError
+		if errors.As(callErr, &dataErr) {
+			call.Error.Data = dataErr.ErrorData()
+		}
+	} else {
+		call.Result = append(json.RawMessage(nil), result...)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+	return nil
+}
+
+// encodeParams encodes the arguments of a call the same way the RPC client does,
+// so that calls with equal arguments can be matched with their recordings.
+func encodeParams(args []any) (json.RawMessage, error) {
+	if args == nil {
+		args = []any{}
+	}
+	params, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode call params: %w", err)
+	}
+	return params, nil
+}
+
+func unmarshalResult(raw json.RawMessage, result any) error {
+	if result == nil || len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	ErrNotRecorded           = errors.New("call was not recorded")
+	ErrSubscriptionsReplayed = errors.New("subscriptions cannot be replayed")
+)
+
+// ReplayRPC is an RPC that serves the responses of a recorded fixture, without any network access.
+// Calls are matched by method and params. Repeated calls are served the recorded responses in
+// the order they were recorded, and once all are served, the last response is served again.
+// Calls that were not recorded fail with ErrNotRecorded.
+type ReplayRPC struct {
+	mu    sync.Mutex
+	calls map[string][]RecordedCall
+	// served counts the responses that were served, per call
+	served map[string]int
+}
+
+var _ RPC = (*ReplayRPC)(nil)
+
+func NewReplayRPC(fixture *RPCFixture) (*ReplayRPC, error) {
+	calls := make(map[string][]RecordedCall)
+	for i, call := range fixture.Calls {
+		// Params may have been re-formatted in the fixture file
+		var params bytes.Buffer
+		if err := json.Compact(&params, call.Params); err != nil {
+			return nil, fmt.Errorf("invalid params of recorded call %d (%s): %w", i, call.Method, err)
+		}
+		key := callKey(call.Method, params.Bytes())
+		calls[key] = append(calls[key], call)
+	}
+	return &ReplayRPC{calls: calls, served: make(map[string]int)}, nil
+}
+
+func (r *ReplayRPC) Close() {}
+
+func (r *ReplayRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	call, err := r.next(method, args)
+	if err != nil {
+		return err
+	}
+	if call.Error != nil {
+		return call.Error
+	}
+	return unmarshalResult(call.Result, result)
+}
+
+func (r *ReplayRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for i, elem := range b {
+		call, err := r.next(elem.Method, elem.Args)
+		if err != nil {
+			return err
+		}
+		if call.Error != nil {
+			b[i].Error = call.Error
+		} else {
+			b[i].Error = unmarshalResult(call.Result, elem.Result)
+		}
+	}
+	return nil
+}
+
+func (r *ReplayRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return nil, ErrSubscriptionsReplayed
+}
+
+func (r *ReplayRPC) next(method string, args []any) (RecordedCall, error) {
+	params, err := encodeParams(args)
+	if err != nil {
+		return RecordedCall{}, err
+	}
+	key := callKey(method, params)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := r.calls[key]
+	if len(calls) == 0 {
+		return RecordedCall{}, fmt.Errorf("%w: %s %s", ErrNotRecorded, method, params)
+	}
+	i := r.served[key]
+	if i < len(calls)-1 {
+		r.served[key] = i + 1
+	} else {
+		i = len(calls) - 1
+	}
+	return calls[i], nil
+}
+
+func callKey(method string, params []byte) string {
+	return method + string(params)
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type counterAPI struct {
+	count uint64
+}
+
+func (c *counterAPI) Next() hexutil.Uint64 {
+	c.count++
+	return hexutil.Uint64(c.count)
+}
+
+func (c *counterAPI) Add(a hexutil.Uint64, b hexutil.Uint64) hexutil.Uint64 {
+	return a + b
+}
+
+type dataError struct{}
+
+func (dataError) Error() string  { return "execution reverted" }
+func (dataError) ErrorCode() int { return 3 }
+func (dataError) ErrorData() any { return "0x1234" }
+
+func (c *counterAPI) Revert() (hexutil.Uint64, error) {
+	return 0, dataError{}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", new(counterAPI)))
+	t.Cleanup(srv.Stop)
+	ctx := context.Background()
+
+	recorder := NewRecordingRPC(NewBaseRPCClient(rpc.DialInProc(srv)))
+	var out hexutil.Uint64
+	require.NoError(t, recorder.CallContext(ctx, &out, "test_next"))
+	require.Equal(t, hexutil.Uint64(1), out)
+	require.NoError(t, recorder.CallContext(ctx, &out, "test_next"))
+	require.Equal(t, hexutil.Uint64(2), out)
+	require.NoError(t, recorder.CallContext(ctx, &out, "test_add", hexutil.Uint64(3), hexutil.Uint64(4)))
+	require.Equal(t, hexutil.Uint64(7), out)
+
+	var sum, next hexutil.Uint64
+	batch := []rpc.BatchElem{
+		{Method: "test_add", Args: []any{hexutil.Uint64(1), hexutil.Uint64(2)}, Result: &sum},
+		{Method: "test_next", Result: &next},
+		{Method: "test_revert", Result: new(hexutil.Uint64)},
+	}
+	require.NoError(t, recorder.BatchCallContext(ctx, batch))
+	require.Equal(t, hexutil.Uint64(3), sum)
+	require.Equal(t, hexutil.Uint64(3), next)
+	require.Error(t, batch[2].Error)
+
+	err := recorder.CallContext(ctx, &out, "test_revert")
+	var dataErr rpc.DataError
+	require.True(t, errors.As(err, &dataErr))
+	require.Equal(t, "0x1234", dataErr.ErrorData())
+	recorder.Close()
+
+	for _, name := range []string{"fixture.json", "fixture.json.gz"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, recorder.Fixture().Save(path))
+			fixture, err := LoadRPCFixture(path)
+			require.NoError(t, err)
+			require.Len(t, fixture.Calls, 7)
+			replay, err := NewReplayRPC(fixture)
+			require.NoError(t, err)
+
+			// Repeated calls are served in recorded order, and then repeat the last response
+			for _, expected := range []hexutil.Uint64{1, 2, 3, 3} {
+				require.NoError(t, replay.CallContext(ctx, &out, "test_next"))
+				require.Equal(t, expected, out)
+			}
+			require.NoError(t, replay.CallContext(ctx, &out, "test_add", hexutil.Uint64(3), hexutil.Uint64(4)))
+			require.Equal(t, hexutil.Uint64(7), out)
+
+			var sum hexutil.Uint64
+			batch := []rpc.BatchElem{
+				{Method: "test_add", Args: []any{hexutil.Uint64(1), hexutil.Uint64(2)}, Result: &sum},
+				{Method: "test_revert", Result: new(hexutil.Uint64)},
+			}
+			require.NoError(t, replay.BatchCallContext(ctx, batch))
+			require.Equal(t, hexutil.Uint64(3), sum)
+			require.NoError(t, batch[0].Error)
+			var rpcErr rpc.Error
+			require.True(t, errors.As(batch[1].Error, &rpcErr))
+			require.Equal(t, 3, rpcErr.ErrorCode())
+			require.Equal(t, "execution reverted", rpcErr.Error())
+
+			err = replay.CallContext(ctx, &out, "test_add", hexutil.Uint64(1), hexutil.Uint64(1))
+			require.ErrorIs(t, err, ErrNotRecorded)
+			_, err = replay.EthSubscribe(ctx, make(chan any), "newHeads")
+			require.ErrorIs(t, err, ErrSubscriptionsReplayed)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/doc"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -16,6 +17,7 @@ import (
 	opnode "github.com/ethereum-optimism/optimism/op-node"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/genesis"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/replay"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
@@ -68,6 +70,10 @@ func main() {
 			Name:        "genesis",
 			Subcommands: genesis.Subcommands,
 		},
+		{
+			Name:        "replay",
+			Subcommands: replay.Subcommands,
+		},
 		{
 			Name:        "doc",
 			Subcommands: doc.Subcommands,
@@ -128,10 +134,14 @@ func RollupNodeMain(ctx *cli.Context) error {
 
 	if cfg.Heartbeat.Enabled {
 		var peerID string
+		// heartbeats are signed with the p2p identity of the node, if p2p is enabled
+		var priv crypto.PrivKey
 		if cfg.P2P.Disabled() {
 			peerID = "disabled"
 		} else {
-			peerID = n.P2P().Host().ID().String()
+			h := n.P2P().Host()
+			peerID = h.ID().String()
+			priv = h.Peerstore().PrivKey(h.ID())
 		}
 
 		beatCtx, beatCtxCancel := context.WithCancel(context.Background())
@@ -143,7 +153,7 @@ func RollupNodeMain(ctx *cli.Context) error {
 			ChainID: cfg.Rollup.L2ChainID.Uint64(),
 		}
 		go func() {
-			if err := heartbeat.Beat(beatCtx, log, cfg.Heartbeat.URL, payload); err != nil {
+			if err := heartbeat.Beat(beatCtx, log, cfg.Heartbeat.URL, payload, priv); err != nil {
 				log.Error("heartbeat goroutine crashed", "err", err)
 			}
 		}()
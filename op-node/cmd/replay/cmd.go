@@ -0,0 +1,212 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/sources"
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
+)
+
+var (
+	L1Flag = &cli.StringFlag{
+		Name:  "l1",
+		Usage: "L1 RPC URL to read L1 data from. Not used if --l1.fixture is set.",
+	}
+	L1FixtureFlag = &cli.StringFlag{
+		Name:  "l1.fixture",
+		Usage: "Path of a recorded L1 fixture to read L1 data from, instead of L1 RPC.",
+	}
+	L1RecordFlag = &cli.StringFlag{
+		Name:  "l1.record",
+		Usage: "Path to write a fixture of the L1 data that was read from L1 RPC, to replay without L1 RPC later.",
+	}
+	L1RPCKindFlag = &cli.GenericFlag{
+		Name: "l1.rpckind",
+		Usage: "The kind of RPC provider, used to inform optimal transactions receipts fetching, and thus reduce costs. Valid options: " +
+			openum.EnumString(sources.RPCProviderKinds),
+		Value: func() *sources.RPCProviderKind {
+			out := sources.RPCKindBasic
+			return &out
+		}(),
+	}
+	L2Flag = &cli.StringFlag{
+		Name:     "l2",
+		Usage:    "L2 RPC URL, of an execution engine or node that serves the eth namespace, to compare the derived blocks against.",
+		Required: true,
+	}
+	RollupConfigFlag = &cli.StringFlag{
+		Name:  "rollup.config",
+		Usage: "Rollup chain parameters",
+	}
+	NetworkFlag = &cli.StringFlag{
+		Name:  "network",
+		Usage: fmt.Sprintf("Predefined network selection. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
+	}
+	L2StartFlag = &cli.Uint64Flag{
+		Name:     "l2.start",
+		Usage:    "L2 block number to derive on top of. The L1 range starts at the L1 origin of this block, minus the channel timeout.",
+		Required: true,
+	}
+	L1EndFlag = &cli.Uint64Flag{
+		Name:     "l1.end",
+		Usage:    "L1 block number to stop the replay at, inclusive.",
+		Required: true,
+	}
+	TraceOutFlag = &cli.StringFlag{
+		Name:  "trace.out",
+		Usage: "Path to write the JSON-lines trace of derivation events to. Written to stdout if not set.",
+	}
+	LogLevelFlag = &cli.StringFlag{
+		Name:  "log.level",
+		Usage: "The lowest log level that will be output to stderr. The trace always includes all levels.",
+		Value: "info",
+	}
+)
+
+var Subcommands = cli.Commands{
+	{
+		Name:  "derivation",
+		Usage: "Replay derivation over a range of L1 blocks, trace every stage, and compare the derived L2 blocks to a L2 chain",
+		Flags: []cli.Flag{
+			L1Flag,
+			L1FixtureFlag,
+			L1RecordFlag,
+			L1RPCKindFlag,
+			L2Flag,
+			RollupConfigFlag,
+			NetworkFlag,
+			L2StartFlag,
+			L1EndFlag,
+			TraceOutFlag,
+			LogLevelFlag,
+		},
+		Action: Main,
+	},
+}
+
+func Main(cliCtx *cli.Context) error {
+	lvl, err := log.LvlFromString(cliCtx.String(LogLevelFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+	logHandler := log.LvlFilterHandler(lvl, log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+	logger := log.New()
+	logger.SetHandler(logHandler)
+
+	cfg, err := rollupConfig(cliCtx)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Check(); err != nil {
+		return fmt.Errorf("invalid rollup config: %w", err)
+	}
+
+	var traceOut io.Writer = os.Stdout
+	if path := cliCtx.String(TraceOutFlag.Name); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create trace output file: %w", err)
+		}
+		defer f.Close()
+		traceOut = f
+	}
+	tracer := NewTracer(traceOut)
+
+	ctx := cliCtx.Context
+	var l1 derive.L1Fetcher
+	var recorder *RecordingL1
+	if path := cliCtx.String(L1FixtureFlag.Name); path != "" {
+		fixture, err := LoadFixture(path)
+		if err != nil {
+			return err
+		}
+		l1 = NewFixtureL1(fixture)
+	} else {
+		if cliCtx.String(L1Flag.Name) == "" {
+			return errors.New("either an L1 RPC or L1 fixture is required")
+		}
+		l1RPC, err := client.NewRPC(ctx, logger, cliCtx.String(L1Flag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L1 RPC: %w", err)
+		}
+		defer l1RPC.Close()
+		rpcKind := *cliCtx.Generic(L1RPCKindFlag.Name).(*sources.RPCProviderKind)
+		l1Client, err := sources.NewL1Client(l1RPC, logger, nil, sources.L1ClientDefaultConfig(cfg, true, rpcKind))
+		if err != nil {
+			return fmt.Errorf("failed to create L1 client: %w", err)
+		}
+		l1 = l1Client
+		if cliCtx.String(L1RecordFlag.Name) != "" {
+			recorder = NewRecordingL1(l1Client)
+			l1 = recorder
+		}
+	}
+
+	l2RPC, err := client.NewRPC(ctx, logger, cliCtx.String(L2Flag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer l2RPC.Close()
+	l2Client, err := sources.NewL2Client(l2RPC, logger, nil, sources.L2ClientDefaultConfig(cfg, true))
+	if err != nil {
+		return fmt.Errorf("failed to create L2 client: %w", err)
+	}
+
+	result, replayErr := Replay(ctx, logger, cfg, l1, l2Client, tracer, cliCtx.Uint64(L2StartFlag.Name), cliCtx.Uint64(L1EndFlag.Name))
+	if recorder != nil {
+		// write the fixture even if the replay failed, to be able to reproduce the failure
+		if err := recorder.Fixture().Write(cliCtx.String(L1RecordFlag.Name)); err != nil {
+			return err
+		}
+	}
+	if replayErr != nil {
+		return replayErr
+	}
+	if err := tracer.Emit(Event{Stage: StageDerive, Msg: "replay result", Fields: map[string]any{"result": result}}); err != nil {
+		return fmt.Errorf("failed to trace result: %w", err)
+	}
+	summary, _ := json.Marshal(result)
+	if result.Mismatch != "" {
+		logger.Error("Derived L2 chain does not match", "result", string(summary))
+		return errors.New(result.Mismatch)
+	}
+	logger.Info("Derived L2 chain matches", "result", string(summary))
+	return nil
+}
+
+func rollupConfig(cliCtx *cli.Context) (*rollup.Config, error) {
+	if network := cliCtx.String(NetworkFlag.Name); network != "" {
+		cfg, err := chaincfg.GetRollupConfig(network)
+		if err != nil {
+			return nil, err
+		}
+		return &cfg, nil
+	}
+	path := cliCtx.String(RollupConfigFlag.Name)
+	if path == "" {
+		return nil, errors.New("either a rollup config or network is required")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rollup config: %w", err)
+	}
+	defer f.Close()
+	var cfg rollup.Config
+	if err := json.NewDecoder(f).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode rollup config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,161 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// errMismatch is returned to the derivation pipeline when a derived block does not match the L2 chain
+var errMismatch = errors.New("derived block does not match the L2 chain")
+
+// replayPayloadID is the ID of the only payload the replay engine builds at a time
+var replayPayloadID = eth.PayloadID{1}
+
+// replayEngine is the engine of the derivation pipeline of a replay, without an execution engine.
+// Building a block looks up the block with the same number in the L2 chain instead, which is only
+// inserted if it matches the derived attributes. The derived attributes are traced.
+type replayEngine struct {
+	L2Source
+
+	cfg    *rollup.Config
+	log    log.Logger
+	tracer *Tracer
+	// origin returns the L1 origin of the derivation pipeline, to trace the derived blocks with
+	origin func() eth.L1BlockRef
+
+	// start is the L2 block the replay starts from, which is the finalized block of the engine
+	start eth.L2BlockRef
+	head  eth.L2BlockRef
+	// building is the block of the L2 chain that matches the attributes of the block being built
+	building *eth.ExecutionPayload
+
+	derived  uint64
+	mismatch string
+}
+
+var _ derive.Engine = (*replayEngine)(nil)
+
+func newReplayEngine(logger log.Logger, cfg *rollup.Config, l2 L2Source, tracer *Tracer, start eth.L2BlockRef) *replayEngine {
+	return &replayEngine{
+		L2Source: l2,
+		cfg:      cfg,
+		log:      logger,
+		tracer:   tracer,
+		origin:   func() eth.L1BlockRef { return eth.L1BlockRef{} },
+		start:    start,
+		head:     start,
+	}
+}
+
+func (e *replayEngine) L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	switch label {
+	case eth.Unsafe, eth.Safe:
+		return e.head, nil
+	case eth.Finalized:
+		return e.start, nil
+	default:
+		return eth.L2BlockRef{}, fmt.Errorf("unknown block label %q", label)
+	}
+}
+
+func (e *replayEngine) ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	if e.building != nil && state.HeadBlockHash == e.building.BlockHash {
+		head, err := derive.PayloadToBlockRef(e.building, &e.cfg.Genesis)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read L2 block ref of %s: %w", e.building.ID(), err)
+		}
+		e.head = head
+		e.building = nil
+	} else if state.HeadBlockHash != e.head.Hash {
+		return nil, eth.InputError{
+			Inner: fmt.Errorf("head %s is not the replayed head %s", state.HeadBlockHash, e.head),
+			Code:  eth.InvalidForkchoiceState,
+		}
+	}
+	valid := &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid}}
+	if attr == nil {
+		return valid, nil
+	}
+	if err := e.buildBlock(ctx, attr); err != nil {
+		return nil, err
+	}
+	valid.PayloadID = &replayPayloadID
+	return valid, nil
+}
+
+// buildBlock compares the attributes derived on top of the head to the next block of the L2 chain
+func (e *replayEngine) buildBlock(ctx context.Context, attr *eth.PayloadAttributes) error {
+	e.derived++
+	ev := Event{
+		Stage: StageAttributes,
+		Msg:   "derived L2 block",
+		Fields: map[string]any{
+			"parent":     e.head.ID(),
+			"l1_origin":  e.origin(),
+			"attributes": attr,
+		},
+	}
+	num := e.head.Number + 1
+	payload, err := e.PayloadByNumber(ctx, num)
+	if errors.Is(err, ethereum.NotFound) {
+		ev.Fields["match"] = false
+		ev.Fields["diff"] = "L2 chain does not have the block"
+		if err := e.tracer.Emit(ev); err != nil {
+			return fmt.Errorf("failed to trace: %w", err)
+		}
+		e.mismatch = fmt.Sprintf("L2 chain does not have block %d", num)
+		return errMismatch
+	} else if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %d to compare to: %w", num, err)
+	}
+	matchErr := derive.AttributesMatchBlock(attr, e.head.Hash, payload, e.log)
+	ev.Fields["l2_block"] = payload.ID()
+	ev.Fields["match"] = matchErr == nil
+	if matchErr != nil {
+		ev.Fields["diff"] = matchErr.Error()
+	}
+	if err := e.tracer.Emit(ev); err != nil {
+		return fmt.Errorf("failed to trace: %w", err)
+	}
+	if matchErr != nil {
+		e.mismatch = fmt.Sprintf("derived block %d does not match L2 block %s: %v", num, payload.ID(), matchErr)
+		return errMismatch
+	}
+	e.building = payload
+	return nil
+}
+
+func (e *replayEngine) GetPayload(ctx context.Context, payloadId eth.PayloadID) (*eth.ExecutionPayload, error) {
+	if e.building == nil || payloadId != replayPayloadID {
+		return nil, fmt.Errorf("unknown payload %s", payloadId)
+	}
+	return e.building, nil
+}
+
+func (e *replayEngine) NewPayload(ctx context.Context, payload *eth.ExecutionPayload) (*eth.PayloadStatusV1, error) {
+	if e.building == nil || payload.BlockHash != e.building.BlockHash {
+		return nil, fmt.Errorf("payload %s is not the matched block of the L2 chain", payload.ID())
+	}
+	return &eth.PayloadStatusV1{Status: eth.ExecutionValid}, nil
+}
+
+// endL1 is the L1 chain up to and including the end block of the replay
+type endL1 struct {
+	derive.L1Fetcher
+	end uint64
+}
+
+func (l *endL1) L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error) {
+	if num > l.end {
+		return eth.L1BlockRef{}, ethereum.NotFound
+	}
+	return l.L1Fetcher.L1BlockRefByNumber(ctx, num)
+}
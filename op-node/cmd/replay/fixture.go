@@ -0,0 +1,238 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// FixtureBlock is a L1 block, with all the data the derivation pipeline may read from it
+type FixtureBlock struct {
+	Header       *types.Header      `json:"header"`
+	Transactions types.Transactions `json:"transactions"`
+	Receipts     types.Receipts     `json:"receipts"`
+}
+
+// Fixture is a recording of L1 data, to replay derivation without L1 RPC
+type Fixture struct {
+	Blocks []*FixtureBlock `json:"blocks"`
+}
+
+func LoadFixture(path string) (*Fixture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open L1 fixture: %w", err)
+	}
+	defer f.Close()
+	var out Fixture
+	if err := json.NewDecoder(f).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode L1 fixture: %w", err)
+	}
+	return &out, nil
+}
+
+func (f *Fixture) Write(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode L1 fixture: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write L1 fixture: %w", err)
+	}
+	return nil
+}
+
+// FixtureL1 serves L1 data from a fixture
+type FixtureL1 struct {
+	byHash   map[common.Hash]*FixtureBlock
+	byNumber map[uint64]*FixtureBlock
+	head     *FixtureBlock
+}
+
+var _ derive.L1Fetcher = (*FixtureL1)(nil)
+
+func NewFixtureL1(f *Fixture) *FixtureL1 {
+	out := &FixtureL1{
+		byHash:   make(map[common.Hash]*FixtureBlock),
+		byNumber: make(map[uint64]*FixtureBlock),
+	}
+	for _, b := range f.Blocks {
+		out.byHash[b.Header.Hash()] = b
+		out.byNumber[b.Header.Number.Uint64()] = b
+		if out.head == nil || b.Header.Number.Cmp(out.head.Header.Number) > 0 {
+			out.head = b
+		}
+	}
+	return out
+}
+
+func (f *FixtureL1) block(hash common.Hash) (*FixtureBlock, error) {
+	b, ok := f.byHash[hash]
+	if !ok {
+		return nil, fmt.Errorf("block %s is not in the L1 fixture: %w", hash, ethereum.NotFound)
+	}
+	return b, nil
+}
+
+// L1BlockRefByLabel returns the latest block of the fixture for any label
+func (f *FixtureL1) L1BlockRefByLabel(_ context.Context, label eth.BlockLabel) (eth.L1BlockRef, error) {
+	if f.head == nil {
+		return eth.L1BlockRef{}, fmt.Errorf("L1 fixture is empty, no %s block: %w", label, ethereum.NotFound)
+	}
+	return eth.InfoToL1BlockRef(eth.HeaderBlockInfo(f.head.Header)), nil
+}
+
+func (f *FixtureL1) L1BlockRefByNumber(_ context.Context, num uint64) (eth.L1BlockRef, error) {
+	b, ok := f.byNumber[num]
+	if !ok {
+		return eth.L1BlockRef{}, fmt.Errorf("block %d is not in the L1 fixture: %w", num, ethereum.NotFound)
+	}
+	return eth.InfoToL1BlockRef(eth.HeaderBlockInfo(b.Header)), nil
+}
+
+func (f *FixtureL1) L1BlockRefByHash(_ context.Context, hash common.Hash) (eth.L1BlockRef, error) {
+	b, err := f.block(hash)
+	if err != nil {
+		return eth.L1BlockRef{}, err
+	}
+	return eth.InfoToL1BlockRef(eth.HeaderBlockInfo(b.Header)), nil
+}
+
+func (f *FixtureL1) InfoByHash(_ context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	b, err := f.block(hash)
+	if err != nil {
+		return nil, err
+	}
+	return eth.HeaderBlockInfo(b.Header), nil
+}
+
+func (f *FixtureL1) InfoAndTxsByHash(_ context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	b, err := f.block(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eth.HeaderBlockInfo(b.Header), b.Transactions, nil
+}
+
+func (f *FixtureL1) FetchReceipts(_ context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	b, err := f.block(blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eth.HeaderBlockInfo(b.Header), b.Receipts, nil
+}
+
+// RecordingL1 wraps a L1 source, and records every block that derivation reads from it, to create a fixture.
+type RecordingL1 struct {
+	derive.L1Fetcher
+
+	mu     sync.Mutex
+	blocks map[common.Hash]*FixtureBlock
+}
+
+var _ derive.L1Fetcher = (*RecordingL1)(nil)
+
+func NewRecordingL1(src derive.L1Fetcher) *RecordingL1 {
+	return &RecordingL1{L1Fetcher: src, blocks: make(map[common.Hash]*FixtureBlock)}
+}
+
+// record fetches and stores the full block data, if it was not recorded yet
+func (r *RecordingL1) record(ctx context.Context, hash common.Hash) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.blocks[hash]; ok {
+		return nil
+	}
+	info, txs, err := r.L1Fetcher.InfoAndTxsByHash(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to record transactions of block %s: %w", hash, err)
+	}
+	_, receipts, err := r.L1Fetcher.FetchReceipts(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to record receipts of block %s: %w", hash, err)
+	}
+	headerRLP, err := info.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("failed to record header of block %s: %w", hash, err)
+	}
+	var header types.Header
+	if err := rlp.Decode(bytes.NewReader(headerRLP), &header); err != nil {
+		return fmt.Errorf("failed to decode header of block %s: %w", hash, err)
+	}
+	r.blocks[hash] = &FixtureBlock{Header: &header, Transactions: txs, Receipts: receipts}
+	return nil
+}
+
+func (r *RecordingL1) L1BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L1BlockRef, error) {
+	ref, err := r.L1Fetcher.L1BlockRefByLabel(ctx, label)
+	if err != nil {
+		return ref, err
+	}
+	return ref, r.record(ctx, ref.Hash)
+}
+
+func (r *RecordingL1) L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error) {
+	ref, err := r.L1Fetcher.L1BlockRefByNumber(ctx, num)
+	if err != nil {
+		return ref, err
+	}
+	return ref, r.record(ctx, ref.Hash)
+}
+
+func (r *RecordingL1) L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error) {
+	ref, err := r.L1Fetcher.L1BlockRefByHash(ctx, hash)
+	if err != nil {
+		return ref, err
+	}
+	return ref, r.record(ctx, hash)
+}
+
+func (r *RecordingL1) InfoByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	info, err := r.L1Fetcher.InfoByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return info, r.record(ctx, hash)
+}
+
+func (r *RecordingL1) InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	info, txs, err := r.L1Fetcher.InfoAndTxsByHash(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, txs, r.record(ctx, hash)
+}
+
+func (r *RecordingL1) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	info, receipts, err := r.L1Fetcher.FetchReceipts(ctx, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, receipts, r.record(ctx, blockHash)
+}
+
+// Fixture returns the recorded blocks, ordered by number
+func (r *RecordingL1) Fixture() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := &Fixture{Blocks: make([]*FixtureBlock, 0, len(r.blocks))}
+	for _, b := range r.blocks {
+		out.Blocks = append(out.Blocks, b)
+	}
+	sort.Slice(out.Blocks, func(i, j int) bool {
+		return out.Blocks[i].Header.Number.Cmp(out.Blocks[j].Header.Number) < 0
+	})
+	return out
+}
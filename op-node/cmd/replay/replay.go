@@ -0,0 +1,88 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+)
+
+// L2Source is the L2 chain that derived blocks are compared against
+type L2Source interface {
+	derive.SystemConfigL2Fetcher
+	PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error)
+	PayloadByNumber(ctx context.Context, number uint64) (*eth.ExecutionPayload, error)
+	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
+	L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error)
+}
+
+// Result summarizes a replay
+type Result struct {
+	// Start is the L2 block derivation started on top of
+	Start eth.L2BlockRef `json:"start"`
+	// SafeHead is the last derived L2 block that matches the L2 chain
+	SafeHead eth.L2BlockRef `json:"safeHead"`
+	// L1Origin is the L1 block the derivation pipeline traversed up to
+	L1Origin eth.L1BlockRef `json:"l1Origin"`
+	// Derived is the number of L2 blocks derived
+	Derived uint64 `json:"derived"`
+	// Mismatch describes how the first derived L2 block that does not match the L2 chain differs, if any
+	Mismatch string `json:"mismatch,omitempty"`
+}
+
+// Replay runs the derivation pipeline on top of the start L2 block, up to and including the L1 end block,
+// without an execution engine. Every derived L2 block is compared against the L2 chain, and replaying
+// stops at the first derived block that does not match.
+//
+// The derivation stages emit their trace events to the tracer.
+func Replay(ctx context.Context, logger log.Logger, cfg *rollup.Config, l1 derive.L1Fetcher, l2 L2Source, tracer *Tracer, start uint64, l1End uint64) (*Result, error) {
+	safe, err := l2.L2BlockRefByNumber(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L2 block %d to start from: %w", start, err)
+	}
+	logger.Info("Starting derivation replay", "start", safe, "l1_end", l1End)
+
+	eng := newReplayEngine(logger, cfg, l2, tracer, safe)
+	pipeline := derive.NewDerivationPipeline(logger, cfg, &endL1{L1Fetcher: l1, end: l1End}, eng, metrics.NoopMetrics, &sync.Config{}, derive.NoopSafeHeadListener{})
+	pipeline.SetTracer(tracer)
+	pipeline.Reset()
+	eng.origin = pipeline.Origin
+
+	result := &Result{Start: safe}
+	for {
+		if err := ctx.Err(); err != nil {
+			break
+		}
+		err := pipeline.Step(ctx)
+		if err := tracer.Err(); err != nil {
+			return nil, fmt.Errorf("failed to trace: %w", err)
+		}
+		if eng.mismatch != "" {
+			result.Mismatch = eng.mismatch
+			break
+		}
+		if errors.Is(err, io.EOF) {
+			if origin := pipeline.Origin(); origin.Number < l1End {
+				logger.Warn("Ran out of L1 data before reaching the end of the replay", "origin", origin, "l1_end", l1End)
+			}
+			break
+		} else if errors.Is(err, derive.NotEnoughData) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("derivation failed: %w", err)
+		}
+	}
+	result.SafeHead = pipeline.SafeL2Head()
+	result.L1Origin = pipeline.Origin()
+	result.Derived = eng.derived
+	return result, ctx.Err()
+}
@@ -0,0 +1,112 @@
+package replay
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// Stages of the derivation pipeline, as reported in trace events
+const (
+	StageL1         = string(derive.TraceStageL1)
+	StageFrame      = string(derive.TraceStageFrame)
+	StageChannel    = string(derive.TraceStageChannel)
+	StageBatch      = string(derive.TraceStageBatch)
+	StageAttributes = string(derive.TraceStageAttributes)
+	// StageDerive is the stage of the events of the replay itself
+	StageDerive = "derive"
+)
+
+// Batch decisions, as reported in trace events of the batch stage
+const (
+	DecisionAccept = string(derive.BatchDecisionAccept)
+	DecisionDrop   = string(derive.BatchDecisionDrop)
+	DecisionEmpty  = string(derive.BatchDecisionEmpty)
+)
+
+// Event is a single entry of the derivation trace
+type Event struct {
+	Stage string `json:"stage"`
+	Msg   string `json:"msg"`
+	// Decision is set for batch stage events that decide on a batch
+	Decision string `json:"decision,omitempty"`
+	// Reason is set for dropped batches, and describes the failed batch check
+	Reason string         `json:"reason,omitempty"`
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// Tracer writes a JSON-lines trace of the events of the derivation pipeline.
+// It receives the trace events of the derivation stages, see derive.TracedStage.
+type Tracer struct {
+	mu  sync.Mutex
+	out *json.Encoder
+	err error
+}
+
+var _ derive.Tracer = (*Tracer)(nil)
+
+func NewTracer(w io.Writer) *Tracer {
+	return &Tracer{out: json.NewEncoder(w)}
+}
+
+// Trace writes a trace event of a derivation stage. The stages cannot handle errors of the tracer,
+// the first error is kept and returned by Err.
+func (t *Tracer) Trace(ev derive.TraceEvent) {
+	err := t.Emit(Event{
+		Stage:    string(ev.Stage),
+		Msg:      ev.Msg,
+		Decision: string(ev.Decision),
+		Reason:   ev.Reason,
+		Fields:   fields(ev.Fields),
+	})
+	if err != nil {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.err == nil {
+			t.err = err
+		}
+	}
+}
+
+// Err returns the first error of writing the trace events of the derivation stages
+func (t *Tracer) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// Emit writes an event that does not originate from a derivation stage
+func (t *Tracer) Emit(ev Event) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.out.Encode(&ev)
+}
+
+// fields turns the fields of a trace event into JSON-encodable fields
+func fields(in map[string]any) map[string]any {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		out[k] = fieldValue(v)
+	}
+	return out
+}
+
+func fieldValue(v any) any {
+	switch x := v.(type) {
+	case json.Marshaler, encoding.TextMarshaler:
+		return x
+	case error:
+		return x.Error()
+	case fmt.Stringer:
+		return x.String()
+	default:
+		return x
+	}
+}
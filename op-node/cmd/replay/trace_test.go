@@ -0,0 +1,67 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+func TestTracer(t *testing.T) {
+	var buf bytes.Buffer
+	tr := NewTracer(&buf)
+	origin := eth.L1BlockRef{Number: 10}
+	tr.Trace(derive.TraceEvent{Stage: derive.TraceStageChannel, Msg: "created new channel", Fields: map[string]any{"origin": origin, "frame_number": 0}})
+	tr.Trace(derive.TraceEvent{Stage: derive.TraceStageFrame, Msg: "ingested frame", Fields: map[string]any{"origin": origin, "frame_number": 0}})
+	tr.Trace(derive.TraceEvent{
+		Stage:    derive.TraceStageBatch,
+		Msg:      "dropping batch",
+		Decision: derive.BatchDecisionDrop,
+		Reason:   "dropping batch with old timestamp",
+		Fields:   map[string]any{"batch_timestamp": 998},
+	})
+	tr.Trace(derive.TraceEvent{Stage: derive.TraceStageBatch, Msg: "found next batch", Decision: derive.BatchDecisionAccept})
+	tr.Trace(derive.TraceEvent{Stage: derive.TraceStageChannel, Msg: "failed to read batch from channel", Fields: map[string]any{"err": errors.New("oops")}})
+	require.NoError(t, tr.Emit(Event{Stage: StageDerive, Msg: "replay result"}))
+	require.NoError(t, tr.Err())
+
+	var events []Event
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var ev Event
+		require.NoError(t, json.Unmarshal([]byte(line), &ev))
+		events = append(events, ev)
+	}
+	require.Len(t, events, 6)
+	require.Equal(t, StageChannel, events[0].Stage)
+	require.Equal(t, StageFrame, events[1].Stage)
+	require.Empty(t, events[1].Decision)
+
+	require.Equal(t, StageBatch, events[2].Stage)
+	require.Equal(t, DecisionDrop, events[2].Decision)
+	require.Equal(t, "dropping batch with old timestamp", events[2].Reason)
+	require.Equal(t, float64(998), events[2].Fields["batch_timestamp"])
+
+	require.Equal(t, DecisionAccept, events[3].Decision)
+	require.Empty(t, events[3].Reason)
+
+	require.Equal(t, "oops", events[4].Fields["err"])
+	require.Equal(t, StageDerive, events[5].Stage)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestTracerErr(t *testing.T) {
+	tr := NewTracer(failingWriter{})
+	tr.Trace(derive.TraceEvent{Stage: derive.TraceStageL1, Msg: "advanced L1 origin"})
+	require.ErrorContains(t, tr.Err(), "write failed")
+}
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-node/sources"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opsigner "github.com/ethereum-optimism/optimism/op-signer/client"
 
 	"github.com/urfave/cli/v2"
 )
@@ -41,9 +43,14 @@ var (
 	}
 	Network = &cli.StringFlag{
 		Name:    "network",
-		Usage:   fmt.Sprintf("Predefined network selection. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
+		Usage:   fmt.Sprintf("Predefined network selection, or a chain of the --network.registry directory. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
 		EnvVars: prefixEnvVars("NETWORK"),
 	}
+	NetworkRegistry = &cli.StringFlag{
+		Name:    "network.registry",
+		Usage:   "Directory with a sub-directory per chain, holding its chain.json definition, to select with --network in addition to the predefined networks",
+		EnvVars: prefixEnvVars("NETWORK_REGISTRY"),
+	}
 	/* Optional Flags */
 	RPCListenAddr = &cli.StringFlag{
 		Name:    "rpc.addr",
@@ -82,6 +89,16 @@ var (
 			return &out
 		}(),
 	}
+	SyncModeFlag = &cli.GenericFlag{
+		Name: "syncmode",
+		Usage: "Method to sync the L2 chain with. Options: " + openum.EnumString(sync.Modes) +
+			". With execution-layer sync the execution engine syncs to the unsafe head by itself (e.g. snap-sync), before derivation from L1 continues.",
+		EnvVars: prefixEnvVars("SYNCMODE"),
+		Value: func() *sync.Mode {
+			out := sync.CLSync
+			return &out
+		}(),
+	}
 	L1RPCRateLimit = &cli.Float64Flag{
 		Name:    "l1.rpc-rate-limit",
 		Usage:   "Optional self-imposed global rate-limit on L1 RPC requests, specified in requests / second. Disabled if set to 0.",
@@ -100,6 +117,17 @@ var (
 		EnvVars: prefixEnvVars("L1_HTTP_POLL_INTERVAL"),
 		Value:   time.Second * 12,
 	}
+	L1DiskCache = &cli.StringFlag{
+		Name:    "l1.disk-cache",
+		Usage:   "Directory of a disk cache of finalized L1 block headers, transactions and receipts, to not fetch them from the L1 RPC again after a restart. Disabled if not set.",
+		EnvVars: prefixEnvVars("L1_DISK_CACHE"),
+	}
+	L1DiskCacheSize = &cli.Uint64Flag{
+		Name:    "l1.disk-cache-size",
+		Usage:   "Maximum size of the L1 disk cache, in MiB. The data of the oldest L1 blocks is evicted first.",
+		EnvVars: prefixEnvVars("L1_DISK_CACHE_SIZE"),
+		Value:   1024,
+	}
 	L2EngineJWTSecret = &cli.StringFlag{
 		Name:        "l2.jwt-secret",
 		Usage:       "Path to JWT secret key. Keys are 32 bytes, hex encoded in a file. A new key will be generated if left empty.",
@@ -185,6 +213,11 @@ var (
 		Usage:   "Path to the snapshot log file",
 		EnvVars: prefixEnvVars("SNAPSHOT_LOG"),
 	}
+	SafeDBPath = &cli.StringFlag{
+		Name:    "safedb.path",
+		Usage:   "File path used to persist the safe head after each L1 block, served by optimism_safeHeadAtL1Block. Disabled if not set.",
+		EnvVars: prefixEnvVars("SAFEDB_PATH"),
+	}
 	HeartbeatEnabledFlag = &cli.BoolFlag{
 		Name:    "heartbeat.enabled",
 		Usage:   "Enables or disables heartbeating",
@@ -214,6 +247,67 @@ var (
 		EnvVars:  prefixEnvVars("L2_BACKUP_UNSAFE_SYNC_RPC_TRUST_RPC"),
 		Required: false,
 	}
+	HAEnabledFlag = &cli.BoolFlag{
+		Name:    "ha.enabled",
+		Usage:   "Run the sequencer in a high-availability cluster: only the raft leader of the cluster sequences. Requires the sequencer to be enabled.",
+		EnvVars: prefixEnvVars("HA_ENABLED"),
+	}
+	HAServerIDFlag = &cli.StringFlag{
+		Name:    "ha.server-id",
+		Usage:   "Unique ID of this node in the sequencer cluster",
+		EnvVars: prefixEnvVars("HA_SERVER_ID"),
+	}
+	HAListenAddrFlag = &cli.StringFlag{
+		Name:    "ha.listen-addr",
+		Usage:   "Address the sequencer cluster transport listens on",
+		Value:   "0.0.0.0:50050",
+		EnvVars: prefixEnvVars("HA_LISTEN_ADDR"),
+	}
+	HAAdvertiseAddrFlag = &cli.StringFlag{
+		Name:    "ha.advertise-addr",
+		Usage:   "Address other members of the sequencer cluster reach this node on. Defaults to the listen address.",
+		EnvVars: prefixEnvVars("HA_ADVERTISE_ADDR"),
+	}
+	HAStorageDirFlag = &cli.StringFlag{
+		Name:    "ha.storage-dir",
+		Usage:   "Directory to persist the sequencer cluster state in",
+		Value:   "ha-data",
+		EnvVars: prefixEnvVars("HA_STORAGE_DIR"),
+	}
+	HABootstrapFlag = &cli.BoolFlag{
+		Name:    "ha.bootstrap",
+		Usage:   "Bootstrap a new sequencer cluster from the configured peers, if no cluster state exists yet",
+		EnvVars: prefixEnvVars("HA_BOOTSTRAP"),
+	}
+	HAPeersFlag = &cli.StringSliceFlag{
+		Name:    "ha.peers",
+		Usage:   "Initial members of the sequencer cluster, including this node, formatted as <server-id>=<host>:<port>",
+		EnvVars: prefixEnvVars("HA_PEERS"),
+	}
+	HAElectionTimeoutFlag = &cli.DurationFlag{
+		Name:    "ha.election-timeout",
+		Usage:   "Duration without contact with the cluster leader after which a new leader is elected",
+		Value:   time.Second,
+		EnvVars: prefixEnvVars("HA_ELECTION_TIMEOUT"),
+	}
+	HACommitTimeoutFlag = &cli.DurationFlag{
+		Name:    "ha.commit-timeout",
+		Usage:   "Timeout for committing a new unsafe block to the sequencer cluster",
+		Value:   time.Second,
+		EnvVars: prefixEnvVars("HA_COMMIT_TIMEOUT"),
+	}
+	HAHealthCheckIntervalFlag = &cli.DurationFlag{
+		Name:    "ha.health-check.interval",
+		Usage:   "Interval at which the cluster leader checks the health of its sequencer",
+		Value:   time.Second,
+		EnvVars: prefixEnvVars("HA_HEALTH_CHECK_INTERVAL"),
+	}
+	HAUnsafeMaxAgeFlag = &cli.DurationFlag{
+		Name:    "ha.health-check.unsafe-max-age",
+		Usage:   "Maximum age of the unsafe head before the cluster leader is considered unhealthy and hands off sequencing",
+		Value:   10 * time.Second,
+		EnvVars: prefixEnvVars("HA_HEALTH_CHECK_UNSAFE_MAX_AGE"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -226,11 +320,15 @@ var optionalFlags = []cli.Flag{
 	RPCListenPort,
 	RollupConfig,
 	Network,
+	NetworkRegistry,
 	L1TrustRPC,
 	L1RPCProviderKind,
+	SyncModeFlag,
 	L1RPCRateLimit,
 	L1RPCMaxBatchSize,
 	L1HTTPPollInterval,
+	L1DiskCache,
+	L1DiskCacheSize,
 	L2EngineJWTSecret,
 	VerifierL1Confs,
 	SequencerEnabledFlag,
@@ -247,19 +345,32 @@ var optionalFlags = []cli.Flag{
 	PprofAddrFlag,
 	PprofPortFlag,
 	SnapshotLog,
+	SafeDBPath,
 	HeartbeatEnabledFlag,
 	HeartbeatMonikerFlag,
 	HeartbeatURLFlag,
 	BackupL2UnsafeSyncRPC,
 	BackupL2UnsafeSyncRPCTrustRPC,
+	HAEnabledFlag,
+	HAServerIDFlag,
+	HAListenAddrFlag,
+	HAAdvertiseAddrFlag,
+	HAStorageDirFlag,
+	HABootstrapFlag,
+	HAPeersFlag,
+	HAElectionTimeoutFlag,
+	HACommitTimeoutFlag,
+	HAHealthCheckIntervalFlag,
+	HAUnsafeMaxAgeFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
 var Flags []cli.Flag
 
 func init() {
-	optionalFlags = append(optionalFlags, p2pFlags...)
+	optionalFlags = append(optionalFlags, P2PFlags...)
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, opsigner.CLIFlags(EnvVarPrefix)...)
 	Flags = append(requiredFlags, optionalFlags...)
 }
 
@@ -250,6 +250,13 @@ var (
 		Value:    "",
 		EnvVars:  p2pEnv("SEQUENCER_KEY"),
 	}
+	SequencerP2PSignerTimeoutFlag = &cli.DurationFlag{
+		Name:     "p2p.sequencer.signer-timeout",
+		Usage:    "Timeout for signing p2p application messages with the remote signer, configured with the signer.* flags.",
+		Required: false,
+		Value:    p2p.DefaultRemoteSignerTimeout,
+		EnvVars:  p2pEnv("SEQUENCER_SIGNER_TIMEOUT"),
+	}
 	GossipMeshDFlag = &cli.UintFlag{
 		Name:     "p2p.gossip.mesh.d",
 		Usage:    "Configure GossipSub topic stable mesh target count, a.k.a. desired outbound degree, number of peers to gossip to",
@@ -299,7 +306,7 @@ var (
 
 // None of these flags are strictly required.
 // Some are hidden if they are too technical, or not recommended.
-var p2pFlags = []cli.Flag{
+var P2PFlags = []cli.Flag{
 	DisableP2P,
 	NoDiscovery,
 	P2PPrivPath,
@@ -332,6 +339,7 @@ var p2pFlags = []cli.Flag{
 	PeerstorePath,
 	DiscoveryPath,
 	SequencerP2PKeyFlag,
+	SequencerP2PSignerTimeoutFlag,
 	GossipMeshDFlag,
 	GossipMeshDloFlag,
 	GossipMeshDhiFlag,
@@ -0,0 +1,392 @@
+package ha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/conductor"
+)
+
+var ErrNotLeader = errors.New("node is not the sequencer cluster leader")
+
+// healthCheckFailureThreshold is the number of consecutive failed health checks after which the leader steps down
+const healthCheckFailureThreshold = 3
+
+// committedPayloadQueueSize is the number of committed payloads that can be queued to be forwarded to the local node
+const committedPayloadQueueSize = 64
+
+// handoffPollInterval is the interval at which a new leader checks if it caught up with the last committed head
+const handoffPollInterval = 100 * time.Millisecond
+
+// SequencerControl is the sequencer of the local node, as controlled by the conductor
+type SequencerControl interface {
+	StartSequencer(ctx context.Context, blockHash common.Hash) error
+	StopSequencer(ctx context.Context) (common.Hash, error)
+	SequencerActive(ctx context.Context) (bool, error)
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+	OnUnsafeL2Payload(ctx context.Context, payload *eth.ExecutionPayload) error
+}
+
+// RaftConductor runs the sequencer in a cluster of sequencer nodes, in which the raft leader is the
+// only active sequencer. The leader commits every sealed unsafe payload to the cluster before using it,
+// such that a standby node can take over at the last committed head when the leader fails.
+type RaftConductor struct {
+	log log.Logger
+	cfg *Config
+
+	raft      *raft.Raft
+	fsm       *unsafeHeadFSM
+	transport raft.Transport
+	logs      raft.LogStore
+	notifyCh  chan bool
+
+	// committed queues the payloads committed by other nodes, to forward them to the local node
+	committed chan *eth.ExecutionPayload
+
+	sequencer SequencerControl
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ conductor.SequencerConductor = (*RaftConductor)(nil)
+
+// NewRaftConductor creates a conductor that communicates with the cluster over TCP,
+// and persists the raft state in the configured storage directory.
+func NewRaftConductor(log log.Logger, cfg *Config) (*RaftConductor, error) {
+	if err := os.MkdirAll(cfg.StorageDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create raft storage dir: %w", err)
+	}
+	store, err := raftboltdb.NewBoltStore(filepath.Join(cfg.StorageDir, "raft.db"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open raft store: %w", err)
+	}
+	snapshots, err := raft.NewFileSnapshotStore(cfg.StorageDir, 1, os.Stderr)
+	if err != nil {
+		_ = store.Close()
+		return nil, fmt.Errorf("failed to create raft snapshot store: %w", err)
+	}
+
+	advertiseAddr := cfg.AdvertiseAddr
+	if advertiseAddr == "" {
+		advertiseAddr = cfg.ListenAddr
+	}
+	advertised, err := net.ResolveTCPAddr("tcp", advertiseAddr)
+	if err != nil {
+		_ = store.Close()
+		return nil, fmt.Errorf("invalid advertise address %q: %w", advertiseAddr, err)
+	}
+	transport, err := raft.NewTCPTransport(cfg.ListenAddr, advertised, 3, cfg.CommitTimeout, os.Stderr)
+	if err != nil {
+		_ = store.Close()
+		return nil, fmt.Errorf("failed to create raft transport: %w", err)
+	}
+
+	c, err := newRaftConductor(log, cfg, transport, store, store, snapshots)
+	if err != nil {
+		_ = transport.Close()
+		_ = store.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewInmemRaftConductor creates a conductor that keeps the raft state in memory, and communicates
+// with the cluster over the given in-process transport. It is intended for testing.
+func NewInmemRaftConductor(log log.Logger, cfg *Config, transport *raft.InmemTransport) (*RaftConductor, error) {
+	store := raft.NewInmemStore()
+	return newRaftConductor(log, cfg, transport, store, store, raft.NewInmemSnapshotStore())
+}
+
+func newRaftConductor(log log.Logger, cfg *Config, transport raft.Transport, logs raft.LogStore, stable raft.StableStore, snapshots raft.SnapshotStore) (*RaftConductor, error) {
+	log = log.New("ha_server", cfg.ServerID)
+	notifyCh := make(chan bool, 10)
+
+	raftCfg := raft.DefaultConfig()
+	raftCfg.LocalID = raft.ServerID(cfg.ServerID)
+	raftCfg.HeartbeatTimeout = cfg.ElectionTimeout
+	raftCfg.ElectionTimeout = cfg.ElectionTimeout
+	raftCfg.LeaderLeaseTimeout = cfg.ElectionTimeout
+	raftCfg.NotifyCh = notifyCh
+	raftCfg.Logger = hclog.New(&hclog.LoggerOptions{Name: "raft", Level: hclog.Warn, Output: os.Stderr})
+
+	if cfg.Bootstrap {
+		exists, err := raft.HasExistingState(logs, stable, snapshots)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for existing raft state: %w", err)
+		}
+		if !exists {
+			servers := make([]raft.Server, 0, len(cfg.Peers))
+			for _, p := range cfg.Peers {
+				servers = append(servers, raft.Server{ID: raft.ServerID(p.ID), Address: raft.ServerAddress(p.Addr)})
+			}
+			if len(servers) == 0 {
+				servers = append(servers, raft.Server{ID: raftCfg.LocalID, Address: transport.LocalAddr()})
+			}
+			log.Info("Bootstrapping sequencer cluster", "servers", len(servers))
+			if err := raft.BootstrapCluster(raftCfg, logs, stable, snapshots, transport, raft.Configuration{Servers: servers}); err != nil {
+				return nil, fmt.Errorf("failed to bootstrap sequencer cluster: %w", err)
+			}
+		}
+	}
+
+	c := &RaftConductor{
+		log:       log,
+		cfg:       cfg,
+		fsm:       &unsafeHeadFSM{localID: raftCfg.LocalID},
+		transport: transport,
+		logs:      logs,
+		notifyCh:  notifyCh,
+		committed: make(chan *eth.ExecutionPayload, committedPayloadQueueSize),
+	}
+	c.fsm.onApply = c.onCommittedPayload
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+
+	r, err := raft.NewRaft(raftCfg, c.fsm, logs, stable, snapshots, transport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start raft: %w", err)
+	}
+	c.raft = r
+	return c, nil
+}
+
+// Start starts controlling the sequencer, based on the leadership of this node in the cluster.
+// The sequencer should be stopped when the conductor is started.
+func (c *RaftConductor) Start(sequencer SequencerControl) {
+	c.sequencer = sequencer
+
+	c.wg.Add(2)
+	go c.loop()
+	go c.forwardLoop()
+}
+
+// Leader returns true if this node is the leader of the cluster, and thus the active sequencer
+func (c *RaftConductor) Leader() bool {
+	return c.raft.State() == raft.Leader
+}
+
+// LeaderAddr returns the address of the current cluster leader, empty if there is no leader
+func (c *RaftConductor) LeaderAddr() string {
+	addr, _ := c.raft.LeaderWithID()
+	return string(addr)
+}
+
+// CommitUnsafePayload commits the payload to the cluster. This fails if this node is not the leader.
+func (c *RaftConductor) CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	if !c.Leader() {
+		return ErrNotLeader
+	}
+	data, err := encodePayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	timeout := c.cfg.CommitTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	// The entry is tagged with the ID of this node, such that it is not forwarded back to it once applied
+	entry := raft.Log{Data: data, Extensions: []byte(c.cfg.ServerID)}
+	if err := c.raft.ApplyLog(entry, timeout).Error(); err != nil {
+		return err
+	}
+	c.log.Debug("Committed unsafe payload", "id", payload.ID())
+	return nil
+}
+
+// onCommittedPayload queues a payload committed by another node, to forward it to the local node.
+// The raft log is applied sequentially, so this does not wait for the local node.
+func (c *RaftConductor) onCommittedPayload(payload *eth.ExecutionPayload) {
+	select {
+	case c.committed <- payload:
+	default:
+		// The local node catches up with the dropped payload through the p2p sync
+		c.log.Warn("Committed payload queue is full, dropping payload", "id", payload.ID())
+	}
+}
+
+// forwardLoop forwards the payloads committed by other nodes to the local node
+func (c *RaftConductor) forwardLoop() {
+	defer c.wg.Done()
+	for {
+		select {
+		case payload := <-c.committed:
+			ctx, cancel := context.WithTimeout(c.ctx, time.Second)
+			if err := c.sequencer.OnUnsafeL2Payload(ctx, payload); err != nil {
+				c.log.Warn("Failed to forward committed payload", "id", payload.ID(), "err", err)
+			}
+			cancel()
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *RaftConductor) loop() {
+	defer c.wg.Done()
+
+	healthTicker := time.NewTicker(c.cfg.HealthCheckInterval)
+	defer healthTicker.Stop()
+	healthFailures := 0
+
+	for {
+		select {
+		case leader := <-c.notifyCh:
+			healthFailures = 0
+			if leader {
+				c.log.Info("Became sequencer cluster leader")
+				if err := c.startSequencing(); err != nil {
+					c.log.Error("Failed to start sequencing as leader, stepping down", "err", err)
+					c.stepDown()
+				}
+			} else {
+				c.log.Info("Lost sequencer cluster leadership")
+				if err := c.stopSequencing(); err != nil {
+					c.log.Error("Failed to stop sequencing", "err", err)
+				}
+			}
+		case <-healthTicker.C:
+			if !c.Leader() {
+				healthFailures = 0
+				continue
+			}
+			if err := c.checkHealth(); err != nil {
+				healthFailures++
+				c.log.Warn("Leader health check failed", "failures", healthFailures, "err", err)
+				if healthFailures >= healthCheckFailureThreshold {
+					healthFailures = 0
+					c.stepDown()
+				}
+			} else {
+				healthFailures = 0
+			}
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// startSequencing starts the sequencer at the last committed head, once the local chain caught up with it
+func (c *RaftConductor) startSequencing() error {
+	// Ensure all payloads committed by previous leaders have been applied
+	if err := c.raft.Barrier(c.cfg.CommitTimeout).Error(); err != nil {
+		return fmt.Errorf("failed to apply committed payloads: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, c.cfg.UnsafeHeadMaxAge)
+	defer cancel()
+
+	target := c.fsm.Latest()
+	var head common.Hash
+	for {
+		if !c.Leader() {
+			return ErrNotLeader
+		}
+		status, err := c.sequencer.SyncStatus(ctx)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			head = status.UnsafeL2.Hash
+			break
+		} else if status.UnsafeL2.Hash == target.BlockHash {
+			head = target.BlockHash
+			break
+		}
+
+		// The committed payload may not have been received yet, e.g. when the previous leader failed to publish it
+		if status.UnsafeL2.Number < uint64(target.BlockNumber) {
+			if err := c.sequencer.OnUnsafeL2Payload(ctx, target); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("local unsafe head %s did not reach committed head %s: %w", status.UnsafeL2, target.ID(), ctx.Err())
+		case <-time.After(handoffPollInterval):
+		}
+	}
+
+	if active, err := c.sequencer.SequencerActive(ctx); err != nil {
+		return err
+	} else if active {
+		return nil
+	}
+	if err := c.sequencer.StartSequencer(ctx, head); err != nil {
+		return err
+	}
+	c.log.Info("Started sequencing at committed head", "head", head)
+	return nil
+}
+
+func (c *RaftConductor) stopSequencing() error {
+	ctx, cancel := context.WithTimeout(c.ctx, c.cfg.CommitTimeout)
+	defer cancel()
+	if active, err := c.sequencer.SequencerActive(ctx); err != nil || !active {
+		return err
+	}
+	head, err := c.sequencer.StopSequencer(ctx)
+	if err != nil {
+		return err
+	}
+	c.log.Info("Stopped sequencing", "head", head)
+	return nil
+}
+
+// checkHealth checks the leader is sequencing and its unsafe head is recent
+func (c *RaftConductor) checkHealth() error {
+	ctx, cancel := context.WithTimeout(c.ctx, c.cfg.HealthCheckInterval)
+	defer cancel()
+	if active, err := c.sequencer.SequencerActive(ctx); err != nil {
+		return err
+	} else if !active {
+		return errors.New("sequencer is not active")
+	}
+	status, err := c.sequencer.SyncStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if age := time.Since(time.Unix(int64(status.UnsafeL2.Time), 0)); age > c.cfg.UnsafeHeadMaxAge {
+		return fmt.Errorf("unsafe head %s is %s old", status.UnsafeL2, age)
+	}
+	return nil
+}
+
+// stepDown transfers the leadership to another node in the cluster
+func (c *RaftConductor) stepDown() {
+	if err := c.raft.LeadershipTransfer().Error(); err != nil {
+		c.log.Error("Failed to transfer leadership", "err", err)
+	}
+}
+
+// Close stops the raft node. The node stays a member of the cluster, and rejoins it with the
+// persisted raft state when restarted. Leadership is lost, and another member is elected.
+func (c *RaftConductor) Close() error {
+	c.cancel()
+	c.wg.Wait()
+
+	err := c.raft.Shutdown().Error()
+	for _, resource := range []interface{}{c.transport, c.logs} {
+		if closer, ok := resource.(io.Closer); ok {
+			if closeErr := closer.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+	}
+	return err
+}
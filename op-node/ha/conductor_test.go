@@ -0,0 +1,284 @@
+package ha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+// fakeSequencer mimics the start/stop semantics of the driver, and follows the unsafe payloads it receives
+type fakeSequencer struct {
+	mu     sync.Mutex
+	active bool
+	head   eth.L2BlockRef
+	// stale reports an old unsafe head, to fail the health checks
+	stale bool
+}
+
+func (s *fakeSequencer) StartSequencer(ctx context.Context, blockHash common.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active {
+		return errors.New("sequencer already running")
+	}
+	if blockHash != s.head.Hash {
+		return fmt.Errorf("block hash does not match: head %s, received %s", s.head.Hash, blockHash)
+	}
+	s.active = true
+	return nil
+}
+
+func (s *fakeSequencer) StopSequencer(ctx context.Context) (common.Hash, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.active {
+		return common.Hash{}, errors.New("sequencer not running")
+	}
+	s.active = false
+	return s.head.Hash, nil
+}
+
+func (s *fakeSequencer) SequencerActive(ctx context.Context) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active, nil
+}
+
+func (s *fakeSequencer) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	head := s.head
+	head.Time = uint64(time.Now().Unix())
+	if s.stale {
+		head.Time = 0
+	}
+	return &eth.SyncStatus{UnsafeL2: head}, nil
+}
+
+func (s *fakeSequencer) OnUnsafeL2Payload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if payload.ParentHash == s.head.Hash {
+		s.head = eth.L2BlockRef{Hash: payload.BlockHash, Number: uint64(payload.BlockNumber), ParentHash: payload.ParentHash}
+	}
+	return nil
+}
+
+func (s *fakeSequencer) Head() eth.L2BlockRef {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.head
+}
+
+func (s *fakeSequencer) Active() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active
+}
+
+func (s *fakeSequencer) set(fn func(s *fakeSequencer)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s)
+}
+
+type testNode struct {
+	conductor *RaftConductor
+	sequencer *fakeSequencer
+}
+
+func setupCluster(t *testing.T, n int, genesis eth.L2BlockRef) []*testNode {
+	transports := make([]*raft.InmemTransport, n)
+	peers := make([]Peer, n)
+	for i := range transports {
+		addr, transport := raft.NewInmemTransport(raft.ServerAddress(fmt.Sprintf("node-%d", i)))
+		transports[i] = transport
+		peers[i] = Peer{ID: fmt.Sprintf("server-%d", i), Addr: string(addr)}
+	}
+	for _, a := range transports {
+		for _, b := range transports {
+			if a != b {
+				a.Connect(b.LocalAddr(), b)
+			}
+		}
+	}
+
+	nodes := make([]*testNode, n)
+	for i := range nodes {
+		cfg := &Config{
+			Enabled:             true,
+			ServerID:            peers[i].ID,
+			Bootstrap:           true,
+			Peers:               peers,
+			ElectionTimeout:     50 * time.Millisecond,
+			CommitTimeout:       time.Second,
+			HealthCheckInterval: 50 * time.Millisecond,
+			UnsafeHeadMaxAge:    2 * time.Second,
+		}
+		require.NoError(t, cfg.Check())
+		conductor, err := NewInmemRaftConductor(testlog.Logger(t, log.LvlInfo), cfg, transports[i])
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conductor.Close() })
+
+		sequencer := &fakeSequencer{head: genesis}
+		conductor.Start(sequencer)
+		nodes[i] = &testNode{conductor: conductor, sequencer: sequencer}
+	}
+	return nodes
+}
+
+// waitForLeader waits until a single node leads the cluster and sequences, while all other nodes do not sequence
+func waitForLeader(t *testing.T, nodes []*testNode) *testNode {
+	return waitForNewLeader(t, nodes, nil)
+}
+
+// waitForNewLeader waits until a single node, other than the previous leader, leads the cluster and sequences
+func waitForNewLeader(t *testing.T, nodes []*testNode, prev *testNode) *testNode {
+	var leader *testNode
+	require.Eventually(t, func() bool {
+		leader = nil
+		for _, node := range nodes {
+			if node.conductor.Leader() && node.sequencer.Active() {
+				if leader != nil {
+					return false
+				}
+				leader = node
+			} else if node.sequencer.Active() {
+				return false
+			}
+		}
+		return leader != nil && leader != prev
+	}, 10*time.Second, 20*time.Millisecond, "expected a single active sequencer")
+	return leader
+}
+
+// sequence commits and inserts a new block on top of the head of the leader
+func sequence(t *testing.T, leader *testNode) *eth.ExecutionPayload {
+	head := leader.sequencer.Head()
+	payload := &eth.ExecutionPayload{
+		ParentHash:  head.Hash,
+		BlockNumber: eth.Uint64Quantity(head.Number + 1),
+		BlockHash:   common.Hash{byte(head.Number + 1), 0xff},
+		Timestamp:   eth.Uint64Quantity(time.Now().Unix()),
+	}
+	require.NoError(t, leader.conductor.CommitUnsafePayload(context.Background(), payload))
+	require.NoError(t, leader.sequencer.OnUnsafeL2Payload(context.Background(), payload))
+	return payload
+}
+
+func TestRaftConductorCommitsPayloads(t *testing.T) {
+	nodes := setupCluster(t, 3, eth.L2BlockRef{Hash: common.Hash{0xaa}})
+	leader := waitForLeader(t, nodes)
+
+	var last *eth.ExecutionPayload
+	for i := 0; i < 3; i++ {
+		last = sequence(t, leader)
+	}
+
+	for _, node := range nodes {
+		node := node
+		require.Eventually(t, func() bool {
+			return node.sequencer.Head().Hash == last.BlockHash
+		}, 5*time.Second, 20*time.Millisecond, "committed payloads are followed by all nodes")
+
+		if node != leader {
+			err := node.conductor.CommitUnsafePayload(context.Background(), last)
+			require.ErrorIs(t, err, ErrNotLeader)
+		}
+	}
+}
+
+func TestRaftConductorUnhealthyLeaderHandoff(t *testing.T) {
+	nodes := setupCluster(t, 3, eth.L2BlockRef{Hash: common.Hash{0xaa}})
+	leader := waitForLeader(t, nodes)
+	sequence(t, leader)
+	last := sequence(t, leader)
+
+	// a standby missed the last committed payload, and catches up with the committed head upon taking over
+	for _, node := range nodes {
+		if node != leader {
+			node.sequencer.set(func(s *fakeSequencer) {
+				s.head = eth.L2BlockRef{Hash: last.ParentHash, Number: uint64(last.BlockNumber) - 1}
+			})
+		}
+	}
+
+	leader.sequencer.set(func(s *fakeSequencer) { s.stale = true })
+	newLeader := waitForNewLeader(t, nodes, leader)
+	require.Equal(t, last.BlockHash, newLeader.sequencer.Head().Hash, "new leader sequences at the last committed head")
+	require.False(t, leader.sequencer.Active())
+
+	next := sequence(t, newLeader)
+	require.Equal(t, last.BlockHash, next.ParentHash)
+}
+
+func TestRaftConductorLeaderFailure(t *testing.T) {
+	nodes := setupCluster(t, 3, eth.L2BlockRef{Hash: common.Hash{0xaa}})
+	leader := waitForLeader(t, nodes)
+	last := sequence(t, leader)
+
+	require.NoError(t, leader.conductor.Close())
+
+	var remaining []*testNode
+	for _, node := range nodes {
+		if node != leader {
+			remaining = append(remaining, node)
+		}
+	}
+	newLeader := waitForLeader(t, remaining)
+	require.Equal(t, last.BlockHash, newLeader.sequencer.Head().Hash)
+	sequence(t, newLeader)
+}
+
+func TestUnsafeHeadFSMApply(t *testing.T) {
+	var forwarded []*eth.ExecutionPayload
+	fsm := &unsafeHeadFSM{
+		localID: "local",
+		onApply: func(payload *eth.ExecutionPayload) { forwarded = append(forwarded, payload) },
+	}
+	own := &eth.ExecutionPayload{BlockNumber: 1, BlockHash: common.Hash{1}}
+	other := &eth.ExecutionPayload{BlockNumber: 2, BlockHash: common.Hash{2}}
+	for _, entry := range []struct {
+		payload *eth.ExecutionPayload
+		origin  string
+	}{{own, "local"}, {other, "remote"}} {
+		data, err := encodePayload(entry.payload)
+		require.NoError(t, err)
+		require.Nil(t, fsm.Apply(&raft.Log{Data: data, Extensions: []byte(entry.origin)}))
+		require.Equal(t, entry.payload.BlockHash, fsm.Latest().BlockHash)
+	}
+	require.Len(t, forwarded, 1, "only payloads committed by other nodes are forwarded")
+	require.Equal(t, other.BlockHash, forwarded[0].BlockHash)
+}
+
+func TestRaftConductorCommittedPayloadQueue(t *testing.T) {
+	c := &RaftConductor{
+		log:       testlog.Logger(t, log.LvlInfo),
+		committed: make(chan *eth.ExecutionPayload, 1),
+	}
+	// queueing does not wait for the local node to accept the payload, and drops payloads when the queue is full
+	c.onCommittedPayload(&eth.ExecutionPayload{BlockNumber: 1})
+	c.onCommittedPayload(&eth.ExecutionPayload{BlockNumber: 2})
+	require.Len(t, c.committed, 1)
+	require.Equal(t, eth.Uint64Quantity(1), (<-c.committed).BlockNumber)
+}
+
+func TestParsePeers(t *testing.T) {
+	peers, err := ParsePeers([]string{"a=127.0.0.1:9000", " b=host:9001"})
+	require.NoError(t, err)
+	require.Equal(t, []Peer{{ID: "a", Addr: "127.0.0.1:9000"}, {ID: "b", Addr: "host:9001"}}, peers)
+
+	_, err = ParsePeers([]string{"127.0.0.1:9000"})
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package ha
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Peer is a member of the sequencer cluster
+type Peer struct {
+	ID   string
+	Addr string
+}
+
+// ParsePeers parses a list of peers, each formatted as "<id>=<addr>"
+func ParsePeers(values []string) ([]Peer, error) {
+	peers := make([]Peer, 0, len(values))
+	for _, v := range values {
+		id, addr, ok := strings.Cut(strings.TrimSpace(v), "=")
+		if !ok || id == "" || addr == "" {
+			return nil, fmt.Errorf("invalid peer %q, expected <id>=<addr>", v)
+		}
+		peers = append(peers, Peer{ID: id, Addr: addr})
+	}
+	return peers, nil
+}
+
+type Config struct {
+	// Enabled runs the sequencer in high-availability mode: the sequencer only runs while this
+	// node is the raft leader of the sequencer cluster.
+	Enabled bool
+
+	// ServerID uniquely identifies this node in the sequencer cluster.
+	ServerID string
+
+	// ListenAddr is the address the raft transport listens on.
+	ListenAddr string
+
+	// AdvertiseAddr is the address other cluster members reach this node on. Defaults to ListenAddr.
+	AdvertiseAddr string
+
+	// StorageDir is the directory the raft log and snapshots are persisted in.
+	StorageDir string
+
+	// Bootstrap initializes a new cluster from Peers, if no raft state exists yet.
+	Bootstrap bool
+
+	// Peers are the initial members of the cluster, including this node, used when bootstrapping.
+	Peers []Peer
+
+	// ElectionTimeout is the duration without contact with the leader after which a new leader is elected.
+	ElectionTimeout time.Duration
+
+	// CommitTimeout bounds the time it takes to commit an unsafe payload to the cluster.
+	CommitTimeout time.Duration
+
+	// HealthCheckInterval is the interval at which the leader checks its own health.
+	HealthCheckInterval time.Duration
+
+	// UnsafeHeadMaxAge is the maximum age of the unsafe head before the leader is considered unhealthy.
+	UnsafeHeadMaxAge time.Duration
+}
+
+func (c *Config) Check() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ServerID == "" {
+		return errors.New("missing server ID")
+	}
+	if c.ElectionTimeout <= 0 {
+		return errors.New("election timeout must be positive")
+	}
+	if c.CommitTimeout <= 0 {
+		return errors.New("commit timeout must be positive")
+	}
+	if c.HealthCheckInterval <= 0 {
+		return errors.New("health check interval must be positive")
+	}
+	if c.UnsafeHeadMaxAge <= 0 {
+		return errors.New("unsafe head max age must be positive")
+	}
+	if c.Bootstrap {
+		for _, p := range c.Peers {
+			if p.ID == c.ServerID {
+				return nil
+			}
+		}
+		if len(c.Peers) > 0 {
+			return fmt.Errorf("bootstrap peers do not include this server %q", c.ServerID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package ha
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/hashicorp/raft"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+// unsafeHeadFSM is the raft state machine of the sequencer cluster: it tracks the latest committed unsafe payload.
+type unsafeHeadFSM struct {
+	mu     sync.Mutex
+	latest *eth.ExecutionPayload
+
+	// localID is the ID of this node, the payloads committed by this node are tagged with it
+	localID raft.ServerID
+	// onApply is called with every payload committed to the cluster by another node. It must not block,
+	// as the raft log is applied sequentially.
+	onApply func(payload *eth.ExecutionPayload)
+}
+
+var _ raft.FSM = (*unsafeHeadFSM)(nil)
+
+func encodePayload(payload *eth.ExecutionPayload) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := payload.MarshalSSZ(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodePayload(data []byte) (*eth.ExecutionPayload, error) {
+	payload := new(eth.ExecutionPayload)
+	if err := payload.UnmarshalSSZ(uint32(len(data)), bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func (f *unsafeHeadFSM) Apply(l *raft.Log) interface{} {
+	payload, err := decodePayload(l.Data)
+	if err != nil {
+		return fmt.Errorf("failed to decode committed payload: %w", err)
+	}
+
+	f.mu.Lock()
+	f.latest = payload
+	onApply := f.onApply
+	f.mu.Unlock()
+
+	// The committing node inserts its own payloads itself. The origin of the entry is part of the log,
+	// so this does not depend on the leadership state at the time the entry is applied.
+	if onApply != nil && raft.ServerID(l.Extensions) != f.localID {
+		onApply(payload)
+	}
+	return nil
+}
+
+// Latest returns the latest committed unsafe payload, nil if none was committed yet
+func (f *unsafeHeadFSM) Latest() *eth.ExecutionPayload {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.latest
+}
+
+func (f *unsafeHeadFSM) Snapshot() (raft.FSMSnapshot, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.latest == nil {
+		return &unsafeHeadSnapshot{}, nil
+	}
+	data, err := encodePayload(f.latest)
+	if err != nil {
+		return nil, err
+	}
+	return &unsafeHeadSnapshot{data: data}, nil
+}
+
+func (f *unsafeHeadFSM) Restore(snapshot io.ReadCloser) error {
+	defer snapshot.Close()
+	data, err := io.ReadAll(snapshot)
+	if err != nil {
+		return err
+	}
+
+	var payload *eth.ExecutionPayload
+	if len(data) > 0 {
+		payload, err = decodePayload(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode snapshot payload: %w", err)
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latest = payload
+	return nil
+}
+
+type unsafeHeadSnapshot struct {
+	data []byte
+}
+
+func (s *unsafeHeadSnapshot) Persist(sink raft.SnapshotSink) error {
+	if _, err := sink.Write(s.data); err != nil {
+		_ = sink.Cancel()
+		return err
+	}
+	return sink.Close()
+}
+
+func (s *unsafeHeadSnapshot) Release() {}
@@ -5,34 +5,107 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 // SendInterval determines the delay between requests. This must be larger than the MinHeartbeatInterval in the server.
 const SendInterval = 10 * time.Minute
 
+// signingDomain separates heartbeat signatures from other messages signed with the p2p identity of a node.
+const signingDomain = "op-heartbeat:"
+
+var ErrInvalidSignature = errors.New("invalid heartbeat signature")
+
 type Payload struct {
 	Version string `json:"version"`
 	Meta    string `json:"meta"`
 	Moniker string `json:"moniker"`
 	PeerID  string `json:"peerID"`
 	ChainID uint64 `json:"chainID"`
+	// Timestamp is the unix time at which a signed heartbeat was sent, to not accept replays of old heartbeats.
+	Timestamp uint64 `json:"timestamp,omitempty"`
+}
+
+// SignedPayload is a heartbeat payload, signed with the p2p identity of the node with the PeerID of the payload.
+type SignedPayload struct {
+	Payload   json.RawMessage `json:"payload"`
+	Signature hexutil.Bytes   `json:"signature"`
+}
+
+// SignPayload signs the payload with the p2p private key of the node.
+// The PeerID of the payload must match the private key for the signature to be verified.
+func SignPayload(payload *Payload, priv crypto.PrivKey) (*SignedPayload, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode heartbeat: %w", err)
+	}
+	sig, err := priv.Sign(signingMessage(payloadJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign heartbeat: %w", err)
+	}
+	return &SignedPayload{Payload: payloadJSON, Signature: sig}, nil
+}
+
+// Verify checks that the payload is signed by the node with the PeerID of the payload, and returns the payload.
+func (s *SignedPayload) Verify() (*Payload, error) {
+	var payload Payload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode heartbeat: %w", err)
+	}
+	id, err := peer.Decode(payload.PeerID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid peer ID %q: %v", ErrInvalidSignature, payload.PeerID, err)
+	}
+	pub, err := id.ExtractPublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("%w: no public key in peer ID %s: %v", ErrInvalidSignature, id, err)
+	}
+	ok, err := pub.Verify(signingMessage(s.Payload), s.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: not signed by %s", ErrInvalidSignature, id)
+	}
+	return &payload, nil
+}
+
+func signingMessage(payloadJSON []byte) []byte {
+	return append([]byte(signingDomain), payloadJSON...)
 }
 
 // Beat sends a heartbeat to the server at the given URL. It will send a heartbeat immediately, and then every SendInterval.
 // Beat spawns a goroutine that will send heartbeats until the context is canceled.
+// If a p2p private key is given, the heartbeats are signed with it and timestamped. Otherwise the plain payload is sent.
 func Beat(
 	ctx context.Context,
 	log log.Logger,
 	url string,
 	payload *Payload,
+	priv crypto.PrivKey,
 ) error {
-	payloadJSON, err := json.Marshal(payload)
-	if err != nil {
+	encode := func() ([]byte, error) {
+		if priv == nil {
+			return json.Marshal(payload)
+		}
+		p := *payload
+		p.Timestamp = uint64(time.Now().Unix())
+		signed, err := SignPayload(&p, priv)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(signed)
+	}
+	// check that the payload can be encoded before starting
+	if _, err := encode(); err != nil {
 		return fmt.Errorf("telemetry crashed: %w", err)
 	}
 
@@ -41,6 +114,11 @@ func Beat(
 	}
 
 	send := func() {
+		payloadJSON, err := encode()
+		if err != nil {
+			log.Error("error encoding heartbeat", "err", err)
+			return
+		}
 		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payloadJSON))
 		req.Header.Set("User-Agent", fmt.Sprintf("op-node/%s", payload.Version))
 		req.Header.Set("Content-Type", "application/json")
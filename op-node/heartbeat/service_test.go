@@ -1,13 +1,18 @@
 package heartbeat
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -43,7 +48,7 @@ func TestBeat(t *testing.T) {
 			Moniker: "yeet",
 			PeerID:  "1UiUfoobar",
 			ChainID: 1234,
-		})
+		}, nil)
 		doneCh <- struct{}{}
 	}()
 
@@ -56,3 +61,83 @@ func TestBeat(t *testing.T) {
 		t.Fatalf("error: %v", ctx.Err())
 	}
 }
+
+func TestBeatSigned(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	reqCh := make(chan []byte, 2)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		reqCh <- body
+		r.Body.Close()
+	}))
+	defer s.Close()
+
+	doneCh := make(chan struct{})
+	go func() {
+		_ = Beat(ctx, log.Root(), s.URL, &Payload{
+			Version: "v1.2.3",
+			PeerID:  id.String(),
+			ChainID: 1234,
+		}, priv)
+		doneCh <- struct{}{}
+	}()
+
+	select {
+	case hb := <-reqCh:
+		var signed SignedPayload
+		require.NoError(t, json.Unmarshal(hb, &signed))
+		payload, err := signed.Verify()
+		require.NoError(t, err)
+		require.Equal(t, id.String(), payload.PeerID)
+		require.Equal(t, uint64(1234), payload.ChainID)
+		require.InDelta(t, time.Now().Unix(), payload.Timestamp, 60)
+		cancel()
+		<-doneCh
+	case <-ctx.Done():
+		t.Fatalf("error: %v", ctx.Err())
+	}
+}
+
+func TestVerifyPayload(t *testing.T) {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	other, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	otherID, err := peer.IDFromPrivateKey(other)
+	require.NoError(t, err)
+
+	signed, err := SignPayload(&Payload{Version: "v1.2.3", PeerID: id.String(), ChainID: 10}, priv)
+	require.NoError(t, err)
+	payload, err := signed.Verify()
+	require.NoError(t, err)
+	require.Equal(t, "v1.2.3", payload.Version)
+
+	// a modified payload is not signed by the peer
+	tampered := *signed
+	tampered.Payload = bytes.Replace(signed.Payload, []byte("v1.2.3"), []byte("v1.2.4"), 1)
+	_, err = tampered.Verify()
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// a payload signed by another peer cannot claim the peer ID
+	forged, err := SignPayload(&Payload{Version: "v1.2.3", PeerID: id.String(), ChainID: 10}, other)
+	require.NoError(t, err)
+	_, err = forged.Verify()
+	require.ErrorIs(t, err, ErrInvalidSignature)
+	require.NotEqual(t, id, otherID)
+
+	invalidPeer, err := SignPayload(&Payload{PeerID: "disabled"}, priv)
+	require.NoError(t, err)
+	_, err = invalidPeer.Verify()
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
@@ -8,9 +8,10 @@ import (
 // CacheMetrics implements the Metrics interface in the caching package,
 // implementing reusable metrics for different caches.
 type CacheMetrics struct {
-	SizeVec *prometheus.GaugeVec
-	GetVec  *prometheus.CounterVec
-	AddVec  *prometheus.CounterVec
+	SizeVec      *prometheus.GaugeVec
+	SizeBytesVec *prometheus.GaugeVec
+	GetVec       *prometheus.CounterVec
+	AddVec       *prometheus.CounterVec
 }
 
 // CacheAdd meters the addition of an item with a given type to the cache,
@@ -24,6 +25,11 @@ func (m *CacheMetrics) CacheAdd(typeLabel string, typeCacheSize int, evicted boo
 	}
 }
 
+// CacheSizeBytes meters the size in bytes of the cached data of a given type.
+func (m *CacheMetrics) CacheSizeBytes(typeLabel string, sizeBytes uint64) {
+	m.SizeBytesVec.WithLabelValues(typeLabel).Set(float64(sizeBytes))
+}
+
 // CacheGet meters a lookup of an item with a given type to the cache
 // and indicating if the lookup was a hit.
 func (m *CacheMetrics) CacheGet(typeLabel string, hit bool) {
@@ -43,6 +49,13 @@ func NewCacheMetrics(factory metrics.Factory, ns string, name string, displayNam
 		}, []string{
 			"type",
 		}),
+		SizeBytesVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      name + "_size_bytes",
+			Help:      displayName + " cache size in bytes",
+		}, []string{
+			"type",
+		}),
 		GetVec: factory.NewCounterVec(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      name + "_get",
@@ -70,6 +70,8 @@ type Metricer interface {
 	SetPeerScores(allScores []store.PeerScores)
 	ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
 	ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ClientPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration)
+	ServerPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration)
 	PayloadsQuarantineSize(n int)
 	RecordPeerUnban()
 	RecordIPUnban()
@@ -99,9 +101,11 @@ type Metrics struct {
 	SequencingErrors *EventMetrics
 	PublishingErrors *EventMetrics
 
-	P2PReqDurationSeconds *prometheus.HistogramVec
-	P2PReqTotal           *prometheus.CounterVec
-	P2PPayloadByNumber    *prometheus.GaugeVec
+	P2PReqDurationSeconds        *prometheus.HistogramVec
+	P2PReqTotal                  *prometheus.CounterVec
+	P2PPayloadByNumber           *prometheus.GaugeVec
+	P2PPayloadsByHashRangeHead   *prometheus.GaugeVec
+	P2PPayloadsByHashRangeBlocks *prometheus.CounterVec
 
 	PayloadsQuarantineTotal prometheus.Gauge
 
@@ -400,6 +404,22 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"p2p_role", // "client" or "server"
 		}),
+		P2PPayloadsByHashRangeHead: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "payloads_by_hash_range_head",
+			Help:      "Head block number of the latest payloads by hash range request",
+		}, []string{
+			"p2p_role", // "client" or "server"
+		}),
+		P2PPayloadsByHashRangeBlocks: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "payloads_by_hash_range_blocks_total",
+			Help:      "Number of blocks served through payloads by hash range requests",
+		}, []string{
+			"p2p_role", // "client" or "server"
+		}),
 		PayloadsQuarantineTotal: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -656,6 +676,11 @@ func (m *Metrics) RecordSequencerSealingTime(duration time.Duration) {
 	m.SequencerSealingDurationSeconds.Observe(float64(duration) / float64(time.Second))
 }
 
+// Registry returns the registry of the metrics, for other services to serve their metrics alongside.
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
 // Serve starts the metrics server on the given hostname and port.
 // The server will be closed when the passed-in context is cancelled.
 func (m *Metrics) Serve(ctx context.Context, hostname string, port int) error {
@@ -692,6 +717,25 @@ func (m *Metrics) ServerPayloadByNumberEvent(num uint64, resultCode byte, durati
 	m.P2PPayloadByNumber.WithLabelValues("server").Set(float64(num))
 }
 
+func (m *Metrics) ClientPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration) {
+	if resultCode > 4 { // summarize all high codes to reduce metrics overhead
+		resultCode = 5
+	}
+	code := strconv.FormatUint(uint64(resultCode), 10)
+	m.P2PReqTotal.WithLabelValues("client", "payloads_by_hash_range", code).Inc()
+	m.P2PReqDurationSeconds.WithLabelValues("client", "payloads_by_hash_range", code).Observe(float64(duration) / float64(time.Second))
+	m.P2PPayloadsByHashRangeHead.WithLabelValues("client").Set(float64(headNum))
+	m.P2PPayloadsByHashRangeBlocks.WithLabelValues("client").Add(float64(blocks))
+}
+
+func (m *Metrics) ServerPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration) {
+	code := strconv.FormatUint(uint64(resultCode), 10)
+	m.P2PReqTotal.WithLabelValues("server", "payloads_by_hash_range", code).Inc()
+	m.P2PReqDurationSeconds.WithLabelValues("server", "payloads_by_hash_range", code).Observe(float64(duration) / float64(time.Second))
+	m.P2PPayloadsByHashRangeHead.WithLabelValues("server").Set(float64(headNum))
+	m.P2PPayloadsByHashRangeBlocks.WithLabelValues("server").Add(float64(blocks))
+}
+
 func (m *Metrics) PayloadsQuarantineSize(n int) {
 	m.PayloadsQuarantineTotal.Set(float64(n))
 }
@@ -824,6 +868,12 @@ func (n *noopMetricer) ClientPayloadByNumberEvent(num uint64, resultCode byte, d
 func (n *noopMetricer) ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration) {
 }
 
+func (n *noopMetricer) ClientPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration) {
+}
+
+func (n *noopMetricer) ServerPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration) {
+}
+
 func (n *noopMetricer) PayloadsQuarantineSize(int) {
 }
 
@@ -4,12 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/client"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/sources"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	gn "github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -152,6 +157,13 @@ type L1EndpointConfig struct {
 	// It is recommended to use websockets or IPC for efficient following of the changing block.
 	// Setting this to 0 disables polling.
 	HttpPollInterval time.Duration
+
+	// DiskCachePath is the directory of the disk cache of finalized L1 data, to reduce L1 RPC usage after restarts.
+	// The disk cache is disabled if empty.
+	DiskCachePath string
+
+	// DiskCacheSize is the maximum size of the disk cache, in bytes.
+	DiskCacheSize uint64
 }
 
 var _ L1EndpointSetup = (*L1EndpointConfig)(nil)
@@ -163,6 +175,9 @@ func (cfg *L1EndpointConfig) Check() error {
 	if cfg.RateLimit < 0 {
 		return fmt.Errorf("rate limit cannot be negative")
 	}
+	if cfg.DiskCachePath != "" && cfg.DiskCacheSize == 0 {
+		return fmt.Errorf("disk cache size must be set when the disk cache is enabled")
+	}
 	return nil
 }
 
@@ -181,6 +196,8 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	}
 	rpcCfg := sources.L1ClientDefaultConfig(rollupCfg, cfg.L1TrustRPC, cfg.L1RPCKind)
 	rpcCfg.MaxRequestsPerBatch = cfg.BatchSize
+	rpcCfg.DiskCachePath = cfg.DiskCachePath
+	rpcCfg.DiskCacheSize = cfg.DiskCacheSize
 	return l1Node, rpcCfg, nil
 }
 
@@ -204,3 +221,39 @@ func (cfg *PreparedL1Endpoint) Check() error {
 
 	return nil
 }
+
+// chainL1Client is the L1 client to cross-check the chain definition of the node against L1 with.
+type chainL1Client struct {
+	rpc client.RPC
+}
+
+func (c *chainL1Client) ChainID(ctx context.Context) (*big.Int, error) {
+	var id hexutil.Big
+	if err := c.rpc.CallContext(ctx, &id, "eth_chainId"); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&id), nil
+}
+
+func (c *chainL1Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var header *types.Header
+	if err := c.rpc.CallContext(ctx, &header, "eth_getBlockByNumber", hexutil.EncodeBig(number), false); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+func (c *chainL1Client) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	blockTag := "latest"
+	if blockNumber != nil {
+		blockTag = hexutil.EncodeBig(blockNumber)
+	}
+	var code hexutil.Bytes
+	if err := c.rpc.CallContext(ctx, &code, "eth_getCode", account, blockTag); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
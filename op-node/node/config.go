@@ -6,10 +6,13 @@ import (
 	"math"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/ha"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -21,8 +24,17 @@ type Config struct {
 
 	Driver driver.Config
 
+	Sync sync.Config
+
+	// SafeDBPath is the path to the database tracking the safe head after each L1 block, disabled if empty
+	SafeDBPath string
+
 	Rollup rollup.Config
 
+	// Chain is the definition of the selected network, cross-checked against L1 at startup.
+	// It is nil if the rollup config was loaded from a file.
+	Chain *chaincfg.ChainDefinition
+
 	// P2PSigner will be used for signing off on published content
 	// if the node is sequencing and if the p2p stack is enabled
 	P2PSigner p2p.SignerSetup
@@ -40,6 +52,9 @@ type Config struct {
 
 	ConfigPersistence ConfigPersistence
 
+	// HA runs the sequencer in a cluster of sequencer nodes, of which only the leader sequences
+	HA ha.Config
+
 	// Optional
 	Tracer    Tracer
 	Heartbeat HeartbeatConfig
@@ -119,5 +134,11 @@ func (cfg *Config) Check() error {
 			return fmt.Errorf("p2p config error: %w", err)
 		}
 	}
+	if cfg.HA.Enabled && !cfg.Driver.SequencerEnabled {
+		return errors.New("sequencer high-availability mode requires the sequencer to be enabled")
+	}
+	if err := cfg.HA.Check(); err != nil {
+		return fmt.Errorf("ha config error: %w", err)
+	}
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -15,8 +16,12 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/client"
 	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/ha"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/conductor"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/sources"
 )
@@ -32,6 +37,8 @@ type OpNode struct {
 
 	l1Source  *sources.L1Client     // L1 Client to fetch data from
 	l2Driver  *driver.Driver        // L2 Engine to Sync
+	haCluster *ha.RaftConductor     // Sequencer high-availability cluster, optional (may be nil)
+	safeDB    closableSafeDB        // Safe head by L1 block index, disabled unless a path is configured
 	l2Source  *sources.EngineClient // L2 Execution Engine RPC bindings
 	rpcSync   *sources.SyncClient   // Alt-sync RPC client, optional (may be nil)
 	server    *rpcServer            // RPC server hosting the rollup-node API
@@ -84,6 +91,9 @@ func (n *OpNode) init(ctx context.Context, cfg *Config, snapshotLog log.Logger)
 	if err := n.initRuntimeConfig(ctx, cfg); err != nil {
 		return err
 	}
+	if err := n.initHA(ctx, cfg); err != nil {
+		return err
+	}
 	if err := n.initL2(ctx, cfg, snapshotLog); err != nil {
 		return err
 	}
@@ -131,6 +141,12 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 		return err
 	}
 
+	if cfg.Chain != nil {
+		if err := cfg.Chain.CheckL1(ctx, &chainL1Client{rpc: l1Node}); err != nil {
+			return fmt.Errorf("invalid L1 for network %s: %w", cfg.Chain.Name, err)
+		}
+	}
+
 	// Keep subscribed to the L1 heads, which keeps the L1 maintainer pointing to the best headers to sync
 	n.l1HeadsSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
 		if err != nil {
@@ -182,6 +198,29 @@ func (n *OpNode) initRuntimeConfig(ctx context.Context, cfg *Config) error {
 	return errors.New("failed to load runtime configuration repeatedly")
 }
 
+func (n *OpNode) initHA(ctx context.Context, cfg *Config) error {
+	if !cfg.HA.Enabled {
+		return nil
+	}
+	// The sequencer is started by the cluster, once this node becomes the leader
+	if !cfg.Driver.SequencerStopped {
+		n.log.Info("Starting with the sequencer stopped, the sequencer cluster leader sequences")
+		cfg.Driver.SequencerStopped = true
+	}
+	haCluster, err := ha.NewRaftConductor(n.log, &cfg.HA)
+	if err != nil {
+		return fmt.Errorf("failed to setup sequencer cluster: %w", err)
+	}
+	n.haCluster = haCluster
+	return nil
+}
+
+type closableSafeDB interface {
+	derive.SafeHeadListener
+	SafeDBReader
+	io.Closer
+}
+
 func (n *OpNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Logger) error {
 	rpcClient, rpcCfg, err := cfg.L2.Setup(ctx, n.log, &cfg.Rollup)
 	if err != nil {
@@ -199,7 +238,21 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Logger
 		return err
 	}
 
-	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics, cfg.ConfigPersistence)
+	if cfg.SafeDBPath != "" {
+		safeDB, err := safedb.NewSafeDB(n.log, cfg.SafeDBPath)
+		if err != nil {
+			return fmt.Errorf("failed to create safe head database: %w", err)
+		}
+		n.safeDB = safeDB
+	} else {
+		n.safeDB = safedb.Disabled
+	}
+
+	var sequencerConductor conductor.SequencerConductor = conductor.NoOpConductor{}
+	if n.haCluster != nil {
+		sequencerConductor = n.haCluster
+	}
+	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics, cfg.ConfigPersistence, &cfg.Sync, n.safeDB, sequencerConductor)
 
 	return nil
 }
@@ -221,7 +274,7 @@ func (n *OpNode) initRPCSync(ctx context.Context, cfg *Config) error {
 }
 
 func (n *OpNode) initRPCServer(ctx context.Context, cfg *Config) error {
-	server, err := newRPCServer(ctx, &cfg.RPC, &cfg.Rollup, n.l2Source.L2Client, n.l2Driver, n.log, n.appVersion, n.metrics)
+	server, err := newRPCServer(ctx, &cfg.RPC, &cfg.Rollup, n.l2Source.L2Client, n.l2Driver, n.safeDB, n.log, n.appVersion, n.metrics)
 	if err != nil {
 		return err
 	}
@@ -288,6 +341,11 @@ func (n *OpNode) Start(ctx context.Context) error {
 		return err
 	}
 
+	if n.haCluster != nil {
+		n.haCluster.Start(n.l2Driver)
+		n.log.Info("Started sequencer cluster membership")
+	}
+
 	// If the backup unsafe sync client is enabled, start its event loop
 	if n.rpcSync != nil {
 		if err := n.rpcSync.Start(); err != nil {
@@ -419,6 +477,13 @@ func (n *OpNode) Close() error {
 		n.resourcesClose()
 	}
 
+	// stop the raft node before the sequencer is stopped, the node stays a member of the sequencer cluster
+	if n.haCluster != nil {
+		if err := n.haCluster.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close sequencer cluster: %w", err))
+		}
+	}
+
 	// stop L1 heads feed
 	if n.l1HeadsSub != nil {
 		n.l1HeadsSub.Unsubscribe()
@@ -438,6 +503,13 @@ func (n *OpNode) Close() error {
 		}
 	}
 
+	// the driver is closed, no more safe head updates are made
+	if n.safeDB != nil {
+		if err := n.safeDB.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close safe head db: %w", err))
+		}
+	}
+
 	// close L2 engine RPC client
 	if n.l2Source != nil {
 		n.l2Source.Close()
@@ -1,13 +1,71 @@
 package node
 
 import (
+	"context"
+	"encoding/json"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
 )
 
 func TestUnixTimeStale(t *testing.T) {
 	require.True(t, unixTimeStale(1_600_000_000, 1*time.Hour))
 	require.False(t, unixTimeStale(uint64(time.Now().Unix()), 1*time.Hour))
 }
+
+func TestChainL1Client(t *testing.T) {
+	header := &types.Header{Number: big.NewInt(100), Difficulty: big.NewInt(1), BaseFee: big.NewInt(7)}
+	chain := &chaincfg.ChainDefinition{Name: "test", Rollup: chaincfg.Goerli}
+	chain.Rollup.Genesis.L1 = eth.BlockID{Hash: header.Hash(), Number: 100}
+
+	call := func(method string, result any, params ...any) client.RecordedCall {
+		if params == nil {
+			params = []any{}
+		}
+		p, err := json.Marshal(params)
+		require.NoError(t, err)
+		r, err := json.Marshal(result)
+		require.NoError(t, err)
+		return client.RecordedCall{Method: method, Params: p, Result: r}
+	}
+	calls := []client.RecordedCall{
+		call("eth_chainId", "0x5"),
+		call("eth_getBlockByNumber", header, "0x64", false),
+		call("eth_getCode", "0x6001", chain.Rollup.DepositContractAddress, "latest"),
+	}
+	newClient := func(calls ...client.RecordedCall) *chainL1Client {
+		rpc, err := client.NewReplayRPC(&client.RPCFixture{Calls: calls})
+		require.NoError(t, err)
+		return &chainL1Client{rpc: rpc}
+	}
+	ctx := context.Background()
+
+	t.Run("Valid", func(t *testing.T) {
+		code := call("eth_getCode", "0x6001", chain.Rollup.L1SystemConfigAddress, "latest")
+		require.NoError(t, chain.CheckL1(ctx, newClient(append(calls, code)...)))
+	})
+
+	t.Run("NoCode", func(t *testing.T) {
+		code := call("eth_getCode", "0x", chain.Rollup.L1SystemConfigAddress, "latest")
+		require.ErrorContains(t, chain.CheckL1(ctx, newClient(append(calls, code)...)), "no system config contract deployed")
+	})
+
+	t.Run("UnknownGenesis", func(t *testing.T) {
+		_, err := newClient(call("eth_getBlockByNumber", nil, "0x65", false)).HeaderByNumber(ctx, big.NewInt(101))
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("CodeAtBlock", func(t *testing.T) {
+		code, err := newClient(call("eth_getCode", "0x6001", common.Address{1}, "0x64")).CodeAt(ctx, common.Address{1}, big.NewInt(100))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x60, 0x01}, code)
+	})
+}
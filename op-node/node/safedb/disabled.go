@@ -0,0 +1,35 @@
+package safedb
+
+import (
+	"errors"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+var ErrNotEnabled = errors.New("safe head database is not enabled")
+
+type DisabledDB struct{}
+
+// Disabled is used when the safe head database is not configured: updates are ignored, and lookups fail.
+var Disabled = &DisabledDB{}
+
+func (d *DisabledDB) Enabled() bool {
+	return false
+}
+
+func (d *DisabledDB) SafeHeadUpdated(_ eth.L2BlockRef, _ eth.BlockID) error {
+	return nil
+}
+
+func (d *DisabledDB) SafeHeadReset(_ eth.L2BlockRef) error {
+	return nil
+}
+
+func (d *DisabledDB) SafeHeadAtL1(_ uint64) (l1 eth.BlockID, safeHead eth.BlockID, err error) {
+	err = ErrNotEnabled
+	return
+}
+
+func (d *DisabledDB) Close() error {
+	return nil
+}
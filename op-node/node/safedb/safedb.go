@@ -0,0 +1,172 @@
+// Package safedb persists which L1 block made each L2 block safe,
+// to answer what the safe L2 head was as of a historical L1 block.
+package safedb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidEntry = errors.New("invalid db entry")
+	ErrClosed       = errors.New("safe head database is closed")
+)
+
+const (
+	// keyPrefixSafeByL1BlockNum prefixes the entries keyed by L1 block number,
+	// each holding the L1 block hash and the safe L2 head after processing that L1 block.
+	keyPrefixSafeByL1BlockNum byte = 0
+
+	valueLen = common.HashLength + common.HashLength + 8
+)
+
+func safeByL1BlockNumKey(l1BlockNum uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = keyPrefixSafeByL1BlockNum
+	binary.BigEndian.PutUint64(key[1:], l1BlockNum)
+	return key
+}
+
+// safeByL1BlockNumRange bounds an iterator to the entries keyed by L1 block number
+func safeByL1BlockNumRange() *pebble.IterOptions {
+	return &pebble.IterOptions{
+		LowerBound: []byte{keyPrefixSafeByL1BlockNum},
+		UpperBound: []byte{keyPrefixSafeByL1BlockNum + 1},
+	}
+}
+
+func safeByL1BlockNumValue(l1 eth.BlockID, l2 eth.BlockID) []byte {
+	val := make([]byte, 0, valueLen)
+	val = append(val, l1.Hash.Bytes()...)
+	val = append(val, l2.Hash.Bytes()...)
+	val = binary.BigEndian.AppendUint64(val, l2.Number)
+	return val
+}
+
+func decodeSafeByL1BlockNum(key []byte, val []byte) (l1 eth.BlockID, l2 eth.BlockID, err error) {
+	if len(key) != 9 || len(val) != valueLen || key[0] != keyPrefixSafeByL1BlockNum {
+		err = ErrInvalidEntry
+		return
+	}
+	l1.Number = binary.BigEndian.Uint64(key[1:])
+	l1.Hash = common.BytesToHash(val[:common.HashLength])
+	l2.Hash = common.BytesToHash(val[common.HashLength : 2*common.HashLength])
+	l2.Number = binary.BigEndian.Uint64(val[2*common.HashLength:])
+	return
+}
+
+// SafeDB is an on-disk index of the safe L2 head after processing each L1 block,
+// updated by the derivation pipeline whenever the safe head changes.
+type SafeDB struct {
+	// m ensures the db is not closed while in use, and that updates and resets do not interleave.
+	m      sync.RWMutex
+	log    log.Logger
+	db     *pebble.DB
+	closed bool
+}
+
+func NewSafeDB(logger log.Logger, path string) (*SafeDB, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open safe head database at %q: %w", path, err)
+	}
+	return &SafeDB{log: logger, db: db}, nil
+}
+
+func (d *SafeDB) Enabled() bool {
+	return true
+}
+
+// SafeHeadUpdated records the safe head after fully processing the given L1 block.
+func (d *SafeDB) SafeHeadUpdated(safeHead eth.L2BlockRef, l1Block eth.BlockID) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	d.log.Debug("Record safe head", "l2", safeHead.ID(), "l1", l1Block)
+	if err := d.db.Set(safeByL1BlockNumKey(l1Block.Number), safeByL1BlockNumValue(l1Block, safeHead.ID()), pebble.Sync); err != nil {
+		return fmt.Errorf("failed to record safe head update: %w", err)
+	}
+	return nil
+}
+
+// SafeHeadReset removes the entries that may no longer be valid after the pipeline was reset to the given safe head:
+// all entries of a safe head past it, and of the safe head itself if it was recorded as a different block.
+func (d *SafeDB) SafeHeadReset(safeHead eth.L2BlockRef) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	iter := d.db.NewIter(safeByL1BlockNumRange())
+	defer iter.Close()
+
+	var deleteFrom []byte
+	for valid := iter.Last(); valid; valid = iter.Prev() {
+		_, l2, err := decodeSafeByL1BlockNum(iter.Key(), iter.Value())
+		if err != nil {
+			return err
+		}
+		if l2.Number < safeHead.Number || l2 == safeHead.ID() {
+			break
+		}
+		deleteFrom = append(deleteFrom[:0], iter.Key()...)
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to find entries to reset: %w", err)
+	}
+	if deleteFrom == nil {
+		return nil
+	}
+	d.log.Warn("Resetting safe head database", "safe_head", safeHead, "from_l1", binary.BigEndian.Uint64(deleteFrom[1:]))
+	if err := d.db.DeleteRange(deleteFrom, safeByL1BlockNumRange().UpperBound, pebble.Sync); err != nil {
+		return fmt.Errorf("failed to reset safe head entries: %w", err)
+	}
+	return nil
+}
+
+// SafeHeadAtL1 returns the safe head after processing the last recorded L1 block at or before the given L1 block number.
+func (d *SafeDB) SafeHeadAtL1(l1BlockNum uint64) (l1 eth.BlockID, safeHead eth.BlockID, err error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if d.closed {
+		err = ErrClosed
+		return
+	}
+	iter := d.db.NewIter(safeByL1BlockNumRange())
+	defer iter.Close()
+	var found bool
+	if l1BlockNum == math.MaxUint64 {
+		found = iter.Last()
+	} else {
+		found = iter.SeekLT(safeByL1BlockNumKey(l1BlockNum + 1))
+	}
+	if !found {
+		if err = iter.Error(); err == nil {
+			err = ErrNotFound
+		}
+		return
+	}
+	return decodeSafeByL1BlockNum(iter.Key(), iter.Value())
+}
+
+func (d *SafeDB) Close() error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+	return d.db.Close()
+}
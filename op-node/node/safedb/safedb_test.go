@@ -0,0 +1,106 @@
+package safedb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+func l2Ref(num uint64) eth.L2BlockRef {
+	return eth.L2BlockRef{Hash: common.Hash{0xbb, byte(num)}, Number: num}
+}
+
+func l1ID(num uint64) eth.BlockID {
+	return eth.BlockID{Hash: common.Hash{0xaa, byte(num)}, Number: num}
+}
+
+func TestStoreSafeHeads(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	dir := t.TempDir()
+	db, err := NewSafeDB(logger, dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.SafeHeadUpdated(l2Ref(1), l1ID(10)))
+	require.NoError(t, db.SafeHeadUpdated(l2Ref(5), l1ID(15)))
+
+	verify := func(db *SafeDB) {
+		_, _, err := db.SafeHeadAtL1(9)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		l1, safe, err := db.SafeHeadAtL1(10)
+		require.NoError(t, err)
+		require.Equal(t, l1ID(10), l1)
+		require.Equal(t, l2Ref(1).ID(), safe)
+
+		l1, safe, err = db.SafeHeadAtL1(14)
+		require.NoError(t, err)
+		require.Equal(t, l1ID(10), l1)
+		require.Equal(t, l2Ref(1).ID(), safe)
+
+		l1, safe, err = db.SafeHeadAtL1(15)
+		require.NoError(t, err)
+		require.Equal(t, l1ID(15), l1)
+		require.Equal(t, l2Ref(5).ID(), safe)
+
+		l1, safe, err = db.SafeHeadAtL1(math.MaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, l1ID(15), l1)
+		require.Equal(t, l2Ref(5).ID(), safe)
+	}
+	verify(db)
+
+	// Entries must survive a restart
+	require.NoError(t, db.Close())
+	db, err = NewSafeDB(logger, dir)
+	require.NoError(t, err)
+	verify(db)
+}
+
+func TestSafeHeadReset(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	db, err := NewSafeDB(logger, t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.SafeHeadUpdated(l2Ref(1), l1ID(10)))
+	require.NoError(t, db.SafeHeadUpdated(l2Ref(5), l1ID(15)))
+	require.NoError(t, db.SafeHeadUpdated(l2Ref(8), l1ID(18)))
+
+	// Reset back to a safe head in between recorded entries drops the later ones
+	require.NoError(t, db.SafeHeadReset(l2Ref(3)))
+
+	l1, safe, err := db.SafeHeadAtL1(20)
+	require.NoError(t, err)
+	require.Equal(t, l1ID(10), l1)
+	require.Equal(t, l2Ref(1).ID(), safe)
+
+	// Resetting to a recorded safe head keeps its entry
+	require.NoError(t, db.SafeHeadUpdated(l2Ref(5), l1ID(15)))
+	require.NoError(t, db.SafeHeadReset(l2Ref(5)))
+	l1, safe, err = db.SafeHeadAtL1(20)
+	require.NoError(t, err)
+	require.Equal(t, l1ID(15), l1)
+	require.Equal(t, l2Ref(5).ID(), safe)
+
+	// Resetting before all entries clears the db
+	require.NoError(t, db.SafeHeadReset(l2Ref(0)))
+	_, _, err = db.SafeHeadAtL1(20)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClosedDB(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	db, err := NewSafeDB(logger, t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.ErrorIs(t, db.SafeHeadUpdated(l2Ref(1), l1ID(10)), ErrClosed)
+	_, _, err = db.SafeHeadAtL1(10)
+	require.ErrorIs(t, err, ErrClosed)
+}
@@ -1,21 +1,30 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	opsigner "github.com/ethereum-optimism/optimism/op-signer/client"
 )
 
-// TODO: implement remote signer setup (config to authenticated endpoint)
-// and remote signer itself (e.g. a open http client to make signing requests)
-
 // LoadSignerSetup loads a configuration for a Signer to be set up later
-func LoadSignerSetup(ctx *cli.Context) (p2p.SignerSetup, error) {
+func LoadSignerSetup(ctx *cli.Context, l log.Logger) (p2p.SignerSetup, error) {
 	key := ctx.String(flags.SequencerP2PKeyFlag.Name)
+	signerConfig := opsigner.ReadCLIConfig(ctx)
+	if err := signerConfig.Check(); err != nil {
+		return nil, fmt.Errorf("invalid remote signer config: %w", err)
+	}
+
+	if key != "" && signerConfig.Enabled() {
+		return nil, errors.New("cannot specify both a p2p sequencer key and a remote signer")
+	}
+
 	if key != "" {
 		// Mnemonics are bad because they leak *all* keys when they leak.
 		// Unencrypted keys from file are bad because they are easy to leak (and we are not checking file permissions).
@@ -27,7 +36,15 @@ func LoadSignerSetup(ctx *cli.Context) (p2p.SignerSetup, error) {
 		return &p2p.PreparedSigner{Signer: p2p.NewLocalSigner(priv)}, nil
 	}
 
-	// TODO: create remote signer
+	if signerConfig.Enabled() {
+		// The remote signer keeps the key out of the op-node. The connection is
+		// authenticated with mTLS, configured with the signer.tls.* flags.
+		return &p2p.RemoteSignerSetup{
+			Log:     l,
+			Config:  signerConfig,
+			Timeout: ctx.Duration(flags.SequencerP2PSignerTimeoutFlag.Name),
+		}, nil
+	}
 
 	return nil, nil
 }
@@ -140,7 +140,8 @@ func (v *Secp256k1) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
-func enrToAddrInfo(r *enode.Node) (*peer.AddrInfo, *crypto.Secp256k1PublicKey, error) {
+// EnrToAddrInfo returns the libp2p address and public key of a discovered node, from its IP, TCP port and secp256k1 ENR entries.
+func EnrToAddrInfo(r *enode.Node) (*peer.AddrInfo, *crypto.Secp256k1PublicKey, error) {
 	ip := r.IP()
 	ipScheme := "ip4"
 	if ip4 := ip.To4(); ip4 == nil {
@@ -174,6 +175,19 @@ type OpStackENRData struct {
 	version uint64
 }
 
+// NewOpStackENRData creates the "opstack" ENR entry of a chain and version.
+func NewOpStackENRData(chainID uint64, version uint64) *OpStackENRData {
+	return &OpStackENRData{chainID: chainID, version: version}
+}
+
+func (o *OpStackENRData) ChainID() uint64 {
+	return o.chainID
+}
+
+func (o *OpStackENRData) Version() uint64 {
+	return o.version
+}
+
 func (o *OpStackENRData) ENRKey() string {
 	return "opstack"
 }
@@ -351,7 +365,7 @@ func (n *NodeP2P) DiscoveryProcess(ctx context.Context, log log.Logger, cfg *rol
 			if err := found.Load(&dat); err != nil { // we already filtered on chain ID and version
 				continue
 			}
-			info, pub, err := enrToAddrInfo(found)
+			info, pub, err := EnrToAddrInfo(found)
 			if err != nil {
 				continue
 			}
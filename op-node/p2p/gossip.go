@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -409,6 +410,10 @@ func (p *publisher) PublishL2Payload(ctx context.Context, payload *eth.Execution
 	if err != nil {
 		return fmt.Errorf("failed to sign execution payload with signer: %w", err)
 	}
+	// never publish a payload without a signature
+	if sig == nil {
+		return errors.New("signer returned no signature for execution payload")
+	}
 	copy(data[:65], sig[:])
 
 	// compress the full message
@@ -123,6 +123,8 @@ func (n *NodeP2P) init(resourcesCtx context.Context, rollupCfg *rollup.Config, l
 				// register the sync protocol with libp2p host
 				payloadByNumber := MakeStreamHandler(resourcesCtx, log.New("serve", "payloads_by_number"), n.syncSrv.HandleSyncRequest)
 				n.host.SetStreamHandler(PayloadByNumberProtocolID(rollupCfg.L2ChainID), payloadByNumber)
+				payloadsByHashRange := MakeStreamHandler(resourcesCtx, log.New("serve", "payloads_by_hash_range"), n.syncSrv.HandleRangeSyncRequest)
+				n.host.SetStreamHandler(PayloadsByHashRangeProtocolID(rollupCfg.L2ChainID), payloadsByHashRange)
 			}
 		}
 		n.scorer = NewScorer(rollupCfg, eps, metrics, n.appScorer, log)
@@ -4,13 +4,18 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"io"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opsigner "github.com/ethereum-optimism/optimism/op-signer/client"
 )
 
 var SigningDomainBlocksV1 = [32]byte{}
@@ -21,18 +26,7 @@ type Signer interface {
 }
 
 func SigningHash(domain [32]byte, chainID *big.Int, payloadBytes []byte) (common.Hash, error) {
-	var msgInput [32 + 32 + 32]byte
-	// domain: first 32 bytes
-	copy(msgInput[:32], domain[:])
-	// chain_id: second 32 bytes
-	if chainID.BitLen() > 256 {
-		return common.Hash{}, errors.New("chain_id is too large")
-	}
-	chainID.FillBytes(msgInput[32:64])
-	// payload_hash: third 32 bytes, hash of encoded payload
-	copy(msgInput[64:], crypto.Keccak256(payloadBytes))
-
-	return crypto.Keccak256Hash(msgInput[:]), nil
+	return opsigner.BlockPayloadSigningHash(domain, chainID, crypto.Keccak256Hash(payloadBytes))
 }
 
 func BlockSigningHash(cfg *rollup.Config, payloadBytes []byte) (common.Hash, error) {
@@ -69,6 +63,81 @@ func (s *LocalSigner) Close() error {
 	return nil
 }
 
+// DefaultRemoteSignerTimeout bounds the latency added to block publishing by the remote signer
+const DefaultRemoteSignerTimeout = time.Second
+
+// RemoteSigner requests block signatures from an op-signer service, such that the
+// signing key is not held by the op-node. Signatures are verified to be made by the
+// expected address, no signature is returned otherwise.
+type RemoteSigner struct {
+	mu      sync.Mutex
+	client  *opsigner.SignerClient
+	address common.Address
+	timeout time.Duration
+}
+
+func NewRemoteSigner(client *opsigner.SignerClient, address common.Address, timeout time.Duration) *RemoteSigner {
+	return &RemoteSigner{client: client, address: address, timeout: timeout}
+}
+
+func (s *RemoteSigner) Sign(ctx context.Context, domain [32]byte, chainID *big.Int, encodedMsg []byte) (sig *[65]byte, err error) {
+	s.mu.Lock()
+	client := s.client
+	s.mu.Unlock()
+	if client == nil {
+		return nil, errors.New("signer is closed")
+	}
+	signingHash, err := SigningHash(domain, chainID, encodedMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	signature, err := client.SignBlockPayload(ctx, opsigner.NewBlockPayloadArgs(domain, chainID, encodedMsg, &s.address))
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := crypto.SigToPub(signingHash[:], signature[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != s.address {
+		return nil, fmt.Errorf("remote signer signed with %s, expected %s", signer, s.address)
+	}
+	return &signature, nil
+}
+
+func (s *RemoteSigner) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+	return nil
+}
+
+// RemoteSignerSetup connects to the remote op-signer service when the signer is set up
+type RemoteSignerSetup struct {
+	Log     log.Logger
+	Config  opsigner.CLIConfig
+	Timeout time.Duration
+}
+
+func (r *RemoteSignerSetup) SetupSigner(ctx context.Context) (Signer, error) {
+	client, err := opsigner.NewSignerClientFromConfig(r.Log, r.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote signer client: %w", err)
+	}
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = DefaultRemoteSignerTimeout
+	}
+	return NewRemoteSigner(client, common.HexToAddress(r.Config.Address), timeout), nil
+}
+
 type PreparedSigner struct {
 	Signer
 }
@@ -1,10 +1,22 @@
 package p2p
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"math/big"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	opsigner "github.com/ethereum-optimism/optimism/op-signer/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,3 +77,94 @@ func TestSigningHash_LimitChainID(t *testing.T) {
 	_, err := SigningHash(SigningDomainBlocksV1, cfg.L2ChainID, []byte("arbitraryData"))
 	require.ErrorContains(t, err, "chain_id is too large")
 }
+
+type mockHealthAPI struct{}
+
+func (mockHealthAPI) Status() string {
+	return "mock"
+}
+
+// mockSignerAPI stands in for the op-signer service, signing block payloads with a local key
+type mockSignerAPI struct {
+	priv  *ecdsa.PrivateKey
+	delay time.Duration
+}
+
+func (m *mockSignerAPI) SignBlockPayload(args opsigner.BlockPayloadArgs) (hexutil.Bytes, error) {
+	time.Sleep(m.delay)
+	signingHash, err := args.ToSigningHash()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(signingHash[:], m.priv)
+}
+
+func newMockRemoteSigner(t *testing.T, api *mockSignerAPI, address common.Address, timeout time.Duration) *RemoteSigner {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("health", mockHealthAPI{}))
+	require.NoError(t, server.RegisterName("opsigner", api))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+
+	client, err := opsigner.NewSignerClient(testlog.Logger(t, log.LvlInfo), httpServer.URL, optls.CLIConfig{})
+	require.NoError(t, err)
+	signer := NewRemoteSigner(client, address, timeout)
+	t.Cleanup(func() { _ = signer.Close() })
+	return signer
+}
+
+func TestRemoteSigner(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(priv.PublicKey)
+	chainID := big.NewInt(100)
+	payload := []byte("arbitraryData")
+
+	t.Run("valid signature", func(t *testing.T) {
+		signer := newMockRemoteSigner(t, &mockSignerAPI{priv: priv}, address, DefaultRemoteSignerTimeout)
+		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, payload)
+		require.NoError(t, err)
+
+		signingHash, err := SigningHash(SigningDomainBlocksV1, chainID, payload)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(signingHash[:], sig[:])
+		require.NoError(t, err)
+		require.Equal(t, address, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("unexpected signer", func(t *testing.T) {
+		otherPriv, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signer := newMockRemoteSigner(t, &mockSignerAPI{priv: otherPriv}, address, DefaultRemoteSignerTimeout)
+		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, payload)
+		require.ErrorContains(t, err, "expected "+address.String())
+		require.Nil(t, sig)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		signer := newMockRemoteSigner(t, &mockSignerAPI{priv: priv, delay: 500 * time.Millisecond}, address, 50*time.Millisecond)
+		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, payload)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Nil(t, sig)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		signer := newMockRemoteSigner(t, &mockSignerAPI{priv: priv}, address, DefaultRemoteSignerTimeout)
+		require.NoError(t, signer.Close())
+		_, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, payload)
+		require.Error(t, err)
+	})
+	t.Run("closed while signing", func(t *testing.T) {
+		signer := newMockRemoteSigner(t, &mockSignerAPI{priv: priv, delay: 50 * time.Millisecond}, address, DefaultRemoteSignerTimeout)
+		errs := make(chan error, 1)
+		go func() {
+			_, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, payload)
+			errs <- err
+		}()
+		require.NoError(t, signer.Close())
+		<-errs
+		_, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, payload)
+		require.Error(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -58,13 +59,73 @@ const (
 	// we rather sync from other servers. We'll try again later,
 	// and eventually kick the peer based on degraded scoring if it's really not serving us well.
 	// TODO(CLI-4009): Use a backoff rather than this mechanism.
-	clientErrRateCost = peerServerBlocksBurst
+	clientErrRateCost = peerServerRangeBlocksBurst
+
+	// maxRangeRequestBlocks is the max number of payloads that can be requested, and served, with a single range request.
+	maxRangeRequestBlocks = 64
+	// Range requests are rate-limited per served block, not per request.
+	// Do not serve more than 160 blocks per second through range requests.
+	globalServerRangeBlocksRateLimit rate.Limit = 160
+	// Allows a burst of 2x our rate limit
+	globalServerRangeBlocksBurst = 320
+	// Do not serve more than 32 blocks per second to the same peer, so we can serve other peers at the same time
+	peerServerRangeBlocksRateLimit rate.Limit = 32
+	// Allow a peer to request a full range at once
+	peerServerRangeBlocksBurst = maxRangeRequestBlocks
+
+	// maxInFlightBlocks limits how many blocks can be requested at a time,
+	// so the quarantine can hold all unverified results until they can be promoted.
+	maxInFlightBlocks = 500
+	// quarantineSize is the max number of unverified payloads that are buffered.
+	quarantineSize = 1000
 )
 
+// PayloadByNumberProtocolID is the original sync protocol, serving a single payload per request, by block number.
+// It is still served for older clients, and the sync client falls back to it for peers that do not
+// support PayloadsByHashRangeProtocolID yet.
 func PayloadByNumberProtocolID(l2ChainID *big.Int) protocol.ID {
 	return protocol.ID(fmt.Sprintf("/opstack/req/payload_by_number/%d/0", l2ChainID))
 }
 
+// PayloadsByHashRangeProtocolID is the range sync protocol, serving a batch of payloads per request,
+// walking back through the parent-hashes from the requested head.
+func PayloadsByHashRangeProtocolID(l2ChainID *big.Int) protocol.ID {
+	return protocol.ID(fmt.Sprintf("/opstack/req/payloads_by_hash_range/%d/0", l2ChainID))
+}
+
+// rangeRequestLen is the size of an encoded range request: <head hash><head number><count>
+const rangeRequestLen = common.HashLength + 8 + 4
+
+// payloadsRangeRequest is a request for count payloads, walking back from the head block.
+// The head is identified by hash, or only by number if the hash is not known yet.
+type payloadsRangeRequest struct {
+	headHash common.Hash
+	headNum  uint64
+	count    uint32
+}
+
+func (r *payloadsRangeRequest) MarshalBinary() []byte {
+	out := make([]byte, 0, rangeRequestLen)
+	out = append(out, r.headHash[:]...)
+	out = binary.LittleEndian.AppendUint64(out, r.headNum)
+	out = binary.LittleEndian.AppendUint32(out, r.count)
+	return out
+}
+
+func (r *payloadsRangeRequest) UnmarshalBinary(data []byte) error {
+	if len(data) != rangeRequestLen {
+		return fmt.Errorf("expected %d bytes range request, got %d", rangeRequestLen, len(data))
+	}
+	copy(r.headHash[:], data[:common.HashLength])
+	r.headNum = binary.LittleEndian.Uint64(data[common.HashLength:])
+	r.count = binary.LittleEndian.Uint32(data[common.HashLength+8:])
+	return nil
+}
+
+func (r *payloadsRangeRequest) String() string {
+	return fmt.Sprintf("%s:%d (count %d)", r.headHash, r.headNum, r.count)
+}
+
 type requestHandlerFn func(ctx context.Context, log log.Logger, stream network.Stream)
 
 func MakeStreamHandler(resourcesCtx context.Context, log log.Logger, fn requestHandlerFn) network.StreamHandler {
@@ -95,11 +156,19 @@ type syncResult struct {
 }
 
 type peerRequest struct {
-	num uint64
+	payloadsRangeRequest
 
 	complete *atomic.Bool
 }
 
+// syncPeer is a peer registered for sync duties
+type syncPeer struct {
+	id peer.ID
+	// requests is buffered with a capacity of 1: a peer has at most one request queued while processing another.
+	requests chan peerRequest
+	cancel   context.CancelFunc
+}
+
 type inFlightCheck struct {
 	num uint64
 
@@ -108,10 +177,12 @@ type inFlightCheck struct {
 
 type SyncClientMetrics interface {
 	ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ClientPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration)
 	PayloadsQuarantineSize(n int)
 }
 
 type SyncPeerScorer interface {
+	ApplicationScore(id peer.ID) float64
 	onValidResponse(id peer.ID)
 	onResponseError(id peer.ID)
 	onRejectedPayload(id peer.ID)
@@ -129,8 +200,12 @@ type SyncPeerScorer interface {
 //
 // The sync mechanism is implemented as following:
 // - User sends range request: blocks on sync main loop (with ctx timeout)
-// - Main loop processes range request (from high to low), dividing block requests by number between parallel peers.
+// - Main loop processes range request (from high to low), dividing it into segments of up to maxRangeRequestBlocks blocks.
 //   - The high part of the range has a known block-hash, and is marked as trusted.
+//   - A segment is requested by the hash of its head block if known (through the parent-hash of the block above it),
+//     and by the number of its head block otherwise, so lower segments can be fetched in parallel.
+//   - Segments are assigned to the peers with the highest application score first,
+//     each peer buffers at most one segment while processing another.
 //   - Once there are no more peers available for buffering requests, we stop the range request processing.
 //   - Every request buffered for a peer is tracked as in-flight, by block number.
 //   - In-flight requests are not repeated
@@ -138,9 +213,12 @@ type SyncPeerScorer interface {
 //   - Data already in the quarantine that is trusted is attempted to be promoted.
 //
 // - Peers each have their own routine for processing requests.
-//   - They fetch the requested block by number, parse and validate it, and then send it back to the main loop
+//   - They fetch the requested segment, walking back by parent-hash, and validate that the payloads form a chain.
+//     Peers that do not support range requests yet are requested the payloads of the segment one by one instead.
+//     The results are then sent back to the main loop.
 //   - If peers fail to fetch or process it, or fail to send it back to the main loop within timeout,
-//     then the doRequest returns an error. It then marks the in-flight request as completed.
+//     then the doRequest returns an error.
+//   - Either way the in-flight request is marked as completed, so any blocks that were not served can be requested again.
 //
 // - Main loop receives results synchronously with the range requests
 //   - The result is removed from in-flight tracker
@@ -189,12 +267,13 @@ type SyncClient struct {
 	metrics   SyncClientMetrics
 	appScorer SyncPeerScorer
 
-	newStreamFn     newStreamFn
-	payloadByNumber protocol.ID
+	newStreamFn         newStreamFn
+	payloadsByHashRange protocol.ID
+	payloadByNumber     protocol.ID
 
 	peersLock sync.Mutex
 	// syncing worker per peer
-	peers map[peer.ID]context.CancelFunc
+	peers map[peer.ID]*syncPeer
 
 	// trusted blocks are, or have been, canonical at one point.
 	// Everything that's trusted is acceptable to pass to the sync receiver,
@@ -212,7 +291,6 @@ type SyncClient struct {
 	inFlight map[uint64]*atomic.Bool
 
 	requests       chan rangeRequest
-	peerRequests   chan peerRequest
 	inFlightChecks chan inFlightCheck
 
 	results chan syncResult
@@ -238,28 +316,28 @@ func NewSyncClient(log log.Logger, cfg *rollup.Config, newStream newStreamFn, rc
 	ctx, cancel := context.WithCancel(context.Background())
 
 	c := &SyncClient{
-		log:             log,
-		cfg:             cfg,
-		metrics:         metrics,
-		appScorer:       appScorer,
-		newStreamFn:     newStream,
-		payloadByNumber: PayloadByNumberProtocolID(cfg.L2ChainID),
-		peers:           make(map[peer.ID]context.CancelFunc),
-		quarantineByNum: make(map[uint64]common.Hash),
-		inFlight:        make(map[uint64]*atomic.Bool),
-		requests:        make(chan rangeRequest), // blocking
-		peerRequests:    make(chan peerRequest, 128),
-		results:         make(chan syncResult, 128),
-		inFlightChecks:  make(chan inFlightCheck, 128),
-		globalRL:        rate.NewLimiter(globalServerBlocksRateLimit, globalServerBlocksBurst),
-		resCtx:          ctx,
-		resCancel:       cancel,
-		receivePayload:  rcv,
+		log:                 log,
+		cfg:                 cfg,
+		metrics:             metrics,
+		appScorer:           appScorer,
+		newStreamFn:         newStream,
+		payloadsByHashRange: PayloadsByHashRangeProtocolID(cfg.L2ChainID),
+		payloadByNumber:     PayloadByNumberProtocolID(cfg.L2ChainID),
+		peers:               make(map[peer.ID]*syncPeer),
+		quarantineByNum:     make(map[uint64]common.Hash),
+		inFlight:            make(map[uint64]*atomic.Bool),
+		requests:            make(chan rangeRequest), // blocking
+		results:             make(chan syncResult, 128),
+		inFlightChecks:      make(chan inFlightCheck, 128),
+		globalRL:            rate.NewLimiter(globalServerRangeBlocksRateLimit, globalServerRangeBlocksBurst),
+		resCtx:              ctx,
+		resCancel:           cancel,
+		receivePayload:      rcv,
 	}
 	// never errors with positive LRU cache size
 	// TODO(CLI-3733): if we had an LRU based on on total payloads size, instead of payload count,
 	//  we can safely buffer more data in the happy case.
-	q, _ := simplelru.NewLRU[common.Hash, syncResult](100, c.onQuarantineEvict)
+	q, _ := simplelru.NewLRU[common.Hash, syncResult](quarantineSize, c.onQuarantineEvict)
 	c.quarantine = q
 	trusted, _ := simplelru.NewLRU[common.Hash, struct{}](10000, nil)
 	c.trusted = trusted
@@ -286,19 +364,20 @@ func (s *SyncClient) AddPeer(id peer.ID) {
 	s.wg.Add(1)
 	// add new peer routine
 	ctx, cancel := context.WithCancel(s.resCtx)
-	s.peers[id] = cancel
-	go s.peerLoop(ctx, id)
+	p := &syncPeer{id: id, requests: make(chan peerRequest, 1), cancel: cancel}
+	s.peers[id] = p
+	go s.peerLoop(ctx, p)
 }
 
 func (s *SyncClient) RemovePeer(id peer.ID) {
 	s.peersLock.Lock()
 	defer s.peersLock.Unlock()
-	cancel, ok := s.peers[id]
+	p, ok := s.peers[id]
 	if !ok {
 		s.log.Warn("cannot remove peer from sync duties, peer was not registered", "peer", id)
 		return
 	}
-	cancel() // once loop exits
+	p.cancel() // once loop exits
 	delete(s.peers, id)
 }
 
@@ -390,12 +469,11 @@ func (s *SyncClient) onRangeRequest(ctx context.Context, req rangeRequest) {
 		}
 	}
 
+	peers := s.peersByScore()
+
 	// Now try to fetch lower numbers than current end, to traverse back towards the updated start.
-	for i := uint64(0); ; i++ {
-		num := req.end.Number - 1 - i
-		if num <= req.start {
-			return
-		}
+	num := req.end.Number - 1
+	for num > req.start {
 		// check if we have something in quarantine already
 		if h, ok := s.quarantineByNum[num]; ok {
 			if s.trusted.Contains(h) { // if we trust it, try to promote it.
@@ -403,28 +481,103 @@ func (s *SyncClient) onRangeRequest(ctx context.Context, req rangeRequest) {
 			}
 			// Don't fetch things that we have a candidate for already.
 			// We'll evict it from quarantine by finding a conflict, or if we sync enough other blocks
+			num--
 			continue
 		}
 
 		if _, ok := s.inFlight[num]; ok {
 			log.Debug("request still in-flight, not rescheduling sync request", "num", num)
-			continue // request still in flight
+			num-- // request still in flight
+			continue
 		}
-		pr := peerRequest{num: num, complete: new(atomic.Bool)}
 
-		log.Debug("Scheduling P2P block request", "num", num)
-		// schedule number
-		select {
-		case s.peerRequests <- pr:
-			s.inFlight[num] = pr.complete
-		case <-ctx.Done():
-			log.Info("did not schedule full P2P sync range", "current", num, "err", ctx.Err())
+		if len(s.inFlight) >= maxInFlightBlocks {
+			log.Info("too many blocks in-flight, not scheduling more P2P requests for L2 block history", "current", num)
 			return
-		default: // peers may all be busy processing requests already
-			log.Info("no peers ready to handle block requests for more P2P requests for L2 block history", "current", num)
+		}
+
+		// Build a segment of blocks we do not have yet, walking back from the current number.
+		head := num
+		count := uint32(0)
+		for num > req.start && count < maxRangeRequestBlocks {
+			if _, ok := s.quarantineByNum[num]; ok {
+				break
+			}
+			if _, ok := s.inFlight[num]; ok {
+				break
+			}
+			num--
+			count++
+		}
+
+		pr := peerRequest{
+			payloadsRangeRequest: payloadsRangeRequest{headHash: s.expectedHash(req, head), headNum: head, count: count},
+			complete:             new(atomic.Bool),
+		}
+		log.Debug("Scheduling P2P block range request", "req", &pr.payloadsRangeRequest)
+		if err := ctx.Err(); err != nil {
+			log.Info("did not schedule full P2P sync range", "current", head, "err", err)
+			return
+		}
+		if !s.schedule(peers, pr) { // peers may all be busy processing requests already
+			log.Info("no peers ready to handle block requests for more P2P requests for L2 block history", "current", head)
 			return
 		}
+		for n := head; n > head-uint64(count); n-- {
+			s.inFlight[n] = pr.complete
+		}
+	}
+}
+
+// expectedHash returns the hash of the given block, if it can be derived from the sync target or
+// the parent-hash of a quarantined block. The zero hash is returned if it is not known.
+func (s *SyncClient) expectedHash(req rangeRequest, num uint64) common.Hash {
+	if num+1 == req.end.Number {
+		return req.end.ParentHash
+	}
+	if h, ok := s.quarantineByNum[num+1]; ok {
+		if res, ok := s.quarantine.Peek(h); ok {
+			return res.payload.ParentHash
+		}
+	}
+	return common.Hash{}
+}
+
+// peersByScore returns the registered peers, sorted by descending application score.
+func (s *SyncClient) peersByScore() []*syncPeer {
+	s.peersLock.Lock()
+	peers := make([]*syncPeer, 0, len(s.peers))
+	for _, p := range s.peers {
+		peers = append(peers, p)
+	}
+	s.peersLock.Unlock()
+	scores := make(map[peer.ID]float64, len(peers))
+	for _, p := range peers {
+		scores[p.id] = s.appScorer.ApplicationScore(p.id)
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return scores[peers[i].id] > scores[peers[j].id]
+	})
+	return peers
+}
+
+// schedule buffers the request with the first of the given peers that has capacity for it,
+// and returns false if none of the peers do.
+func (s *SyncClient) schedule(peers []*syncPeer, pr peerRequest) bool {
+	s.peersLock.Lock()
+	defer s.peersLock.Unlock()
+	for _, p := range peers {
+		// skip peers that have been removed since we ranked them
+		if s.peers[p.id] != p {
+			continue
+		}
+		select {
+		case p.requests <- pr:
+			return true
+		default:
+		}
 	}
+	return false
 }
 
 func (s *SyncClient) onQuarantineEvict(key common.Hash, value syncResult) {
@@ -497,10 +650,18 @@ func (s *SyncClient) onResult(ctx context.Context, res syncResult) {
 }
 
 // peerLoop for syncing from a single peer
-func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
+func (s *SyncClient) peerLoop(ctx context.Context, p *syncPeer) {
+	id := p.id
 	defer func() {
 		s.peersLock.Lock()
-		delete(s.peers, id) // clean up
+		if s.peers[id] == p { // clean up, unless the peer was already removed, and maybe re-added
+			delete(s.peers, id)
+		}
+		// the peer is no longer registered, so no more requests can be buffered: release the remaining ones.
+		for len(p.requests) > 0 {
+			pr := <-p.requests
+			pr.complete.Store(true)
+		}
 		s.log.Debug("stopped syncing loop of peer", "id", id)
 		s.wg.Done()
 		s.peersLock.Unlock()
@@ -511,56 +672,54 @@ func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
 
 	// Implement the same rate limits as the server does per-peer,
 	// so we don't be too aggressive to the server.
-	rl := rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst)
+	rl := rate.NewLimiter(peerServerRangeBlocksRateLimit, peerServerRangeBlocksBurst)
 
 	for {
-		// wait for a global allocation to be available
-		if err := s.globalRL.Wait(ctx); err != nil {
-			return
-		}
-		// wait for peer to be available for more work
-		if err := rl.Wait(ctx); err != nil {
-			return
-		}
-
 		// once the peer is available, wait for a sync request.
 		select {
-		case pr := <-s.peerRequests:
-			// We already established the peer is available w.r.t. rate-limiting,
-			// and this is the only loop over this peer, so we can request now.
-			start := time.Now()
-			err := s.doRequest(ctx, id, pr.num)
-			if err != nil {
-				// mark as complete if there's an error: we are not sending any result and can complete immediately.
-				pr.complete.Store(true)
-				log.Warn("failed p2p sync request", "num", pr.num, "err", err)
-				s.appScorer.onResponseError(id)
-				// If we hit an error, then count it as many requests.
-				// We'd like to avoid making more requests for a while, to back off.
-				if err := rl.WaitN(ctx, clientErrRateCost); err != nil {
-					return
-				}
-			} else {
-				log.Debug("completed p2p sync request", "num", pr.num)
-				s.appScorer.onValidResponse(id)
-			}
-			took := time.Since(start)
-
-			resultCode := byte(0)
-			if err != nil {
-				if re, ok := err.(requestResultErr); ok {
-					resultCode = re.ResultCode()
-				} else {
-					resultCode = 1
-				}
+		case pr := <-p.requests:
+			ok := s.servePeerRequest(ctx, log, rl, id, pr)
+			// Mark the request as complete when we are done with it, even if not all blocks were served,
+			// so that the remaining blocks can be requested again.
+			pr.complete.Store(true)
+			if !ok {
+				return
 			}
-			s.metrics.ClientPayloadByNumberEvent(pr.num, resultCode, took)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// servePeerRequest rate-limits and then makes the request to the peer.
+// It returns false if the peer loop should exit.
+func (s *SyncClient) servePeerRequest(ctx context.Context, log log.Logger, rl *rate.Limiter, id peer.ID, pr peerRequest) bool {
+	// wait for a global allocation to be available
+	if err := s.globalRL.WaitN(ctx, int(pr.count)); err != nil {
+		return false
+	}
+	// wait for peer to be available for more work
+	if err := rl.WaitN(ctx, int(pr.count)); err != nil {
+		return false
+	}
+	// We already established the peer is available w.r.t. rate-limiting,
+	// and this is the only loop over this peer, so we can request now.
+	n, err := s.doRequest(ctx, id, &pr.payloadsRangeRequest)
+	if err != nil {
+		log.Warn("failed p2p sync request", "req", &pr.payloadsRangeRequest, "served", n, "err", err)
+		s.appScorer.onResponseError(id)
+		// If we hit an error, then count it as many requests.
+		// We'd like to avoid making more requests for a while, to back off.
+		if err := rl.WaitN(ctx, clientErrRateCost); err != nil {
+			return false
+		}
+	} else {
+		log.Debug("completed p2p sync request", "req", &pr.payloadsRangeRequest, "served", n)
+		s.appScorer.onValidResponse(id)
+	}
+	return true
+}
+
 type requestResultErr byte
 
 func (r requestResultErr) Error() string {
@@ -571,22 +730,151 @@ func (r requestResultErr) ResultCode() byte {
 	return byte(r)
 }
 
-func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64) error {
+// resultCode returns the result code to record in the metrics for the outcome of a request
+func resultCode(err error) byte {
+	if err == nil {
+		return 0
+	}
+	var re requestResultErr
+	if errors.As(err, &re) {
+		return re.ResultCode()
+	}
+	return 1
+}
+
+var errEmptyRangeResponse = errors.New("peer did not serve any payloads of the range")
+
+// doRequest requests the range of payloads from the peer, and sends the valid results to the main loop.
+// It returns the number of payloads that were received.
+// The peer may serve less payloads than requested, e.g. if it does not have the full range (yet).
+// The range protocol is negotiated per request, and peers that only support the
+// payload_by_number protocol are requested the payloads of the range one by one instead.
+func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, req *payloadsRangeRequest) (int, error) {
 	// open stream to peer
 	reqCtx, reqCancel := context.WithTimeout(ctx, streamTimeout)
-	str, err := s.newStreamFn(reqCtx, id, s.payloadByNumber)
+	str, err := s.newStreamFn(reqCtx, id, s.payloadsByHashRange, s.payloadByNumber)
 	reqCancel()
 	if err != nil {
-		return fmt.Errorf("failed to open stream: %w", err)
+		return 0, fmt.Errorf("failed to open stream: %w", err)
+	}
+	if str.Protocol() == s.payloadByNumber {
+		return s.doPayloadByNumberRequests(ctx, id, str, req)
 	}
+	start := time.Now()
+	n, err := s.doRangeRequest(ctx, id, str, req)
+	s.metrics.ClientPayloadsByHashRangeEvent(req.headNum, n, resultCode(err), time.Since(start))
+	return n, err
+}
+
+// doRangeRequest requests the range of payloads over a payloads_by_hash_range stream
+func (s *SyncClient) doRangeRequest(ctx context.Context, id peer.ID, str network.Stream, req *payloadsRangeRequest) (int, error) {
 	defer str.Close()
+	// set write timeout (if available)
+	_ = str.SetWriteDeadline(time.Now().Add(clientWriteRequestTimeout))
+	if _, err := str.Write(req.MarshalBinary()); err != nil {
+		return 0, fmt.Errorf("failed to write request (%s): %w", req, err)
+	}
+	if err := str.CloseWrite(); err != nil {
+		return 0, fmt.Errorf("failed to close writer side while making request: %w", err)
+	}
+
+	// Read and verify all payloads, before any are processed:
+	// if any part of the response is invalid, we cannot trust the peer with any of it.
+	// If the peer fails to serve the rest of the range, the payloads it served so far
+	// are still valid, and only the rest of the range is requested again.
+	var payloads []*eth.ExecutionPayload
+	for i := uint32(0); i < req.count; i++ {
+		// set read timeout (if available), per response chunk
+		_ = str.SetReadDeadline(time.Now().Add(clientReadResponsetimeout))
+		res, err := readPayloadChunk(str)
+		if errors.Is(err, io.EOF) {
+			break // the peer is done serving, it may not have the full range
+		} else if err != nil {
+			return s.sendPartialResults(ctx, id, payloads, err)
+		}
+		var expectedHash common.Hash
+		if i == 0 {
+			expectedHash = req.headHash
+		} else {
+			expectedHash = payloads[i-1].ParentHash
+		}
+		if err := verifyBlock(res, req.headNum-uint64(i), expectedHash); err != nil {
+			return 0, fmt.Errorf("received execution payload %d of range is invalid: %w", i, err)
+		}
+		payloads = append(payloads, res)
+	}
+	if err := str.CloseRead(); err != nil {
+		return 0, fmt.Errorf("failed to close reading side")
+	}
+	// The server responds with an error if it cannot serve any payload, e.g. when it throttles the requests
+	if len(payloads) == 0 {
+		return 0, errEmptyRangeResponse
+	}
+	return s.sendResults(ctx, id, payloads)
+}
+
+// doPayloadByNumberRequests requests the range of payloads one by one, for peers that only support
+// the payload_by_number protocol. The first request is made over the given stream.
+func (s *SyncClient) doPayloadByNumberRequests(ctx context.Context, id peer.ID, str network.Stream, req *payloadsRangeRequest) (int, error) {
+	var payloads []*eth.ExecutionPayload
+	for i := uint32(0); i < req.count; i++ {
+		num := req.headNum - uint64(i)
+		if i > 0 {
+			reqCtx, reqCancel := context.WithTimeout(ctx, streamTimeout)
+			var err error
+			str, err = s.newStreamFn(reqCtx, id, s.payloadByNumber)
+			reqCancel()
+			if err != nil {
+				return s.sendPartialResults(ctx, id, payloads, fmt.Errorf("failed to open stream: %w", err))
+			}
+		}
+		start := time.Now()
+		res, err := requestPayloadByNumber(str, num)
+		_ = str.Close()
+		s.metrics.ClientPayloadByNumberEvent(num, resultCode(err), time.Since(start))
+		if err != nil {
+			// The peer may not have the full range (yet), what it served so far is still useful
+			var re requestResultErr
+			if i > 0 && errors.As(err, &re) && re.ResultCode() == 1 {
+				break
+			}
+			return s.sendPartialResults(ctx, id, payloads, err)
+		}
+		expectedHash := req.headHash
+		if i > 0 {
+			expectedHash = payloads[i-1].ParentHash
+		}
+		// if any payload is invalid, we cannot trust the peer with any of the payloads it served
+		if err := verifyBlock(res, num, expectedHash); err != nil {
+			return 0, fmt.Errorf("received execution payload is invalid: %w", err)
+		}
+		payloads = append(payloads, res)
+	}
+	return s.sendResults(ctx, id, payloads)
+}
+
+// sendPartialResults sends the valid payloads that were served before the peer failed to serve the
+// rest of the range, such that only the rest of the range is requested again.
+func (s *SyncClient) sendPartialResults(ctx context.Context, id peer.ID, payloads []*eth.ExecutionPayload, err error) (int, error) {
+	if len(payloads) == 0 {
+		return 0, err
+	}
+	n, sendErr := s.sendResults(ctx, id, payloads)
+	if sendErr != nil {
+		return n, sendErr
+	}
+	return n, fmt.Errorf("peer failed to serve the range after %d payloads: %w", n, err)
+}
+
+// requestPayloadByNumber requests a single payload over a payload_by_number stream
+func requestPayloadByNumber(str network.Stream, n uint64) (*eth.ExecutionPayload, error) {
 	// set write timeout (if available)
 	_ = str.SetWriteDeadline(time.Now().Add(clientWriteRequestTimeout))
 	if err := binary.Write(str, binary.LittleEndian, n); err != nil {
-		return fmt.Errorf("failed to write request (%d): %w", n, err)
+		return nil, fmt.Errorf("failed to write request (%d): %w", n, err)
 	}
 	if err := str.CloseWrite(); err != nil {
-		return fmt.Errorf("failed to close writer side while making request: %w", err)
+		return nil, fmt.Errorf("failed to close writer side while making request: %w", err)
 	}
 
 	// set read timeout (if available)
@@ -598,18 +886,18 @@ func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64) error
 	r := io.LimitReader(str, maxGossipSize)
 	var result [1]byte
 	if _, err := io.ReadFull(r, result[:]); err != nil {
-		return fmt.Errorf("failed to read result part of response: %w", err)
+		return nil, fmt.Errorf("failed to read result part of response: %w", err)
 	}
 	if res := result[0]; res != 0 {
-		return requestResultErr(res)
+		return nil, requestResultErr(res)
 	}
 	var versionData [4]byte
 	if _, err := io.ReadFull(r, versionData[:]); err != nil {
-		return fmt.Errorf("failed to read version part of response: %w", err)
+		return nil, fmt.Errorf("failed to read version part of response: %w", err)
 	}
 	version := binary.LittleEndian.Uint32(versionData[:])
 	if version != 0 {
-		return fmt.Errorf("unrecognized ExecutionPayload version: %d", version)
+		return nil, fmt.Errorf("unrecognized ExecutionPayload version: %d", version)
 	}
 	// payload is SSZ encoded with Snappy framed compression
 	r = snappy.NewReader(r)
@@ -618,27 +906,82 @@ func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64) error
 	// The server does not prepend it, nor would we trust a claimed length anyway, so we buffer the data we get.
 	data, err := io.ReadAll(r)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 	var res eth.ExecutionPayload
 	if err := res.UnmarshalSSZ(uint32(len(data)), bytes.NewReader(data)); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 	if err := str.CloseRead(); err != nil {
-		return fmt.Errorf("failed to close reading side")
+		return nil, fmt.Errorf("failed to close reading side")
 	}
-	if err := verifyBlock(&res, n); err != nil {
-		return fmt.Errorf("received execution payload is invalid: %w", err)
+	return &res, nil
+}
+
+// sendResults sends the verified payloads to the main loop, and returns how many were sent
+func (s *SyncClient) sendResults(ctx context.Context, id peer.ID, payloads []*eth.ExecutionPayload) (int, error) {
+	for i, res := range payloads {
+		select {
+		case s.results <- syncResult{payload: res, peer: id}:
+		case <-ctx.Done():
+			return i, fmt.Errorf("failed to process response, sync client is too busy: %w", ctx.Err())
+		}
 	}
-	select {
-	case s.results <- syncResult{payload: &res, peer: id}:
-	case <-ctx.Done():
-		return fmt.Errorf("failed to process response, sync client is too busy: %w", err)
+	return len(payloads), nil
+}
+
+// readPayloadChunk reads a single payload chunk of a range response.
+// io.EOF is returned if the stream ends before a new chunk starts.
+func readPayloadChunk(r io.Reader) (*eth.ExecutionPayload, error) {
+	var result [1]byte
+	if _, err := io.ReadFull(r, result[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to read result part of response: %w", err)
 	}
-	return nil
+	if res := result[0]; res != 0 {
+		return nil, requestResultErr(res)
+	}
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("failed to read version and length part of response: %w", err)
+	}
+	version := binary.LittleEndian.Uint32(header[:4])
+	if version != 0 {
+		return nil, fmt.Errorf("unrecognized ExecutionPayload version: %d", version)
+	}
+	// Limit input, as well as output.
+	// Compression may otherwise continue to read ignored data for a small output,
+	// or output more data than desired (zip-bomb)
+	size := binary.LittleEndian.Uint32(header[4:])
+	if size > maxGossipSize {
+		return nil, fmt.Errorf("payload chunk of %d bytes exceeds max size %d", size, maxGossipSize)
+	}
+	compressed := make([]byte, size)
+	if _, err := io.ReadFull(r, compressed); err != nil {
+		return nil, fmt.Errorf("failed to read payload part of response: %w", err)
+	}
+	// payload is SSZ encoded with Snappy block compression
+	if n, err := snappy.DecodedLen(compressed); err != nil {
+		return nil, fmt.Errorf("failed to read payload size: %w", err)
+	} else if n > maxGossipSize {
+		return nil, fmt.Errorf("payload of %d bytes exceeds max size %d", n, maxGossipSize)
+	}
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress payload: %w", err)
+	}
+	var res eth.ExecutionPayload
+	if err := res.UnmarshalSSZ(uint32(len(data)), bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &res, nil
 }
 
-func verifyBlock(payload *eth.ExecutionPayload, expectedNum uint64) error {
+// verifyBlock checks the payload has the expected number, and a valid block hash.
+// If expectedHash is not zero, the block hash must match it.
+func verifyBlock(payload *eth.ExecutionPayload, expectedNum uint64, expectedHash common.Hash) error {
 	// verify L2 block
 	if expectedNum != uint64(payload.BlockNumber) {
 		return fmt.Errorf("received execution payload for block %d, but expected block %d", payload.BlockNumber, expectedNum)
@@ -647,6 +990,9 @@ func verifyBlock(payload *eth.ExecutionPayload, expectedNum uint64) error {
 	if !ok { // payload itself contains bad block hash
 		return fmt.Errorf("received execution payload for block %d with bad block hash %s, expected %s", expectedNum, payload.BlockHash, actual)
 	}
+	if expectedHash != (common.Hash{}) && payload.BlockHash != expectedHash {
+		return fmt.Errorf("received execution payload for block %d with block hash %s, expected %s", expectedNum, payload.BlockHash, expectedHash)
+	}
 	return nil
 }
 
@@ -654,14 +1000,18 @@ func verifyBlock(payload *eth.ExecutionPayload, expectedNum uint64) error {
 type peerStat struct {
 	// Requests tokenizes each request to sync
 	Requests *rate.Limiter
+	// Blocks tokenizes each block served through range requests
+	Blocks *rate.Limiter
 }
 
 type L2Chain interface {
 	PayloadByNumber(ctx context.Context, number uint64) (*eth.ExecutionPayload, error)
+	PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error)
 }
 
 type ReqRespServerMetrics interface {
 	ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration)
 }
 
 type ReqRespServer struct {
@@ -675,6 +1025,7 @@ type ReqRespServer struct {
 	peerStatsLock  sync.Mutex
 
 	globalRequestsRL *rate.Limiter
+	globalBlocksRL   *rate.Limiter
 }
 
 func NewReqRespServer(cfg *rollup.Config, l2 L2Chain, metrics ReqRespServerMetrics) *ReqRespServer {
@@ -683,6 +1034,7 @@ func NewReqRespServer(cfg *rollup.Config, l2 L2Chain, metrics ReqRespServerMetri
 
 	peerRateLimits, _ := simplelru.NewLRU[peer.ID, *peerStat](1000, nil)
 	globalRequestsRL := rate.NewLimiter(globalServerBlocksRateLimit, globalServerBlocksBurst)
+	globalBlocksRL := rate.NewLimiter(globalServerRangeBlocksRateLimit, globalServerRangeBlocksBurst)
 
 	return &ReqRespServer{
 		cfg:              cfg,
@@ -690,7 +1042,25 @@ func NewReqRespServer(cfg *rollup.Config, l2 L2Chain, metrics ReqRespServerMetri
 		metrics:          metrics,
 		peerRateLimits:   peerRateLimits,
 		globalRequestsRL: globalRequestsRL,
+		globalBlocksRL:   globalBlocksRL,
+	}
+}
+
+// peerStat finds the rate limiting data of the peer, or adds it otherwise.
+// The bool is true if the peer was not known yet.
+func (srv *ReqRespServer) peerStat(id peer.ID) (*peerStat, bool) {
+	srv.peerStatsLock.Lock()
+	defer srv.peerStatsLock.Unlock()
+	ps, _ := srv.peerRateLimits.Get(id)
+	if ps != nil {
+		return ps, false
+	}
+	ps = &peerStat{
+		Requests: rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst),
+		Blocks:   rate.NewLimiter(peerServerRangeBlocksRateLimit, peerServerRangeBlocksBurst),
 	}
+	srv.peerRateLimits.Add(id, ps)
+	return ps, true
 }
 
 // HandleSyncRequest is a stream handler function to register the L2 unsafe payloads alt-sync protocol.
@@ -739,13 +1109,8 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 	}
 
 	// find rate limiting data of peer, or add otherwise
-	srv.peerStatsLock.Lock()
-	ps, _ := srv.peerRateLimits.Get(peerId)
-	if ps == nil {
-		ps = &peerStat{
-			Requests: rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst),
-		}
-		srv.peerRateLimits.Add(peerId, ps)
+	ps, isNew := srv.peerStat(peerId)
+	if isNew {
 		ps.Requests.Reserve() // count the hit, but make it delay the next request rather than immediately waiting
 	} else {
 		// Only wait if it's an existing peer, otherwise the instant rate-limit Wait call always errors.
@@ -757,7 +1122,6 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 			return 0, fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
 		}
 	}
-	srv.peerStatsLock.Unlock()
 
 	// Set read deadline, if available
 	_ = stream.SetReadDeadline(time.Now().Add(serverReadRequestTimeout))
@@ -810,3 +1174,151 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 	}
 	return req, nil
 }
+
+// HandleRangeSyncRequest is a stream handler function to register the L2 unsafe payloads range alt-sync protocol.
+// See MakeStreamHandler to transform this into a LibP2P handler function.
+//
+// Note that the same peer may open parallel streams.
+//
+// The caller must Close the stream.
+func (srv *ReqRespServer) HandleRangeSyncRequest(ctx context.Context, log log.Logger, stream network.Stream) {
+	// may stay empty if we fail to decode the request
+	start := time.Now()
+
+	// Serving is throttled per block. If the rate-limit delays reach the threshold,
+	// we stop serving, and the peer can request the remaining blocks again later.
+	ctx, cancel := context.WithTimeout(ctx, maxThrottleDelay)
+	req, served, err := srv.handleRangeSyncRequest(ctx, stream)
+	cancel()
+
+	resultCode := byte(0)
+	if err != nil {
+		log.Warn("failed to serve p2p range sync request", "req", &req, "served", served, "err", err)
+		if errors.Is(err, ethereum.NotFound) {
+			resultCode = 1
+		} else if errors.Is(err, invalidRequestErr) {
+			resultCode = 2
+		} else {
+			resultCode = 3
+		}
+		// try to write error code, so the other peer can understand the reason for failure.
+		// Once payloads were served, the stream ends without an error code instead,
+		// so the other peer keeps the payloads it received and only requests the rest again.
+		if served == 0 {
+			_, _ = stream.Write([]byte{resultCode})
+		}
+	} else {
+		log.Debug("successfully served range sync response", "req", &req, "served", served)
+	}
+	srv.metrics.ServerPayloadsByHashRangeEvent(req.headNum, int(served), resultCode, time.Since(start))
+}
+
+func (srv *ReqRespServer) handleRangeSyncRequest(ctx context.Context, stream network.Stream) (req payloadsRangeRequest, served uint32, err error) {
+	peerId := stream.Conn().RemotePeer()
+	ps, _ := srv.peerStat(peerId)
+
+	// Set read deadline, if available
+	_ = stream.SetReadDeadline(time.Now().Add(serverReadRequestTimeout))
+
+	// Read the request
+	var data [rangeRequestLen]byte
+	if _, err := io.ReadFull(stream, data[:]); err != nil {
+		return req, 0, fmt.Errorf("failed to read range request: %w", err)
+	}
+	if err := req.UnmarshalBinary(data[:]); err != nil {
+		return req, 0, fmt.Errorf("failed to decode range request: %w", err)
+	}
+	if err := stream.CloseRead(); err != nil {
+		return req, 0, fmt.Errorf("failed to close reading-side of a P2P range sync request call: %w", err)
+	}
+
+	// Check the request is within the expected range of blocks
+	if req.count == 0 || req.count > maxRangeRequestBlocks {
+		return req, 0, fmt.Errorf("cannot serve range of %d blocks, expected 1 to %d: %w", req.count, maxRangeRequestBlocks, invalidRequestErr)
+	}
+	if req.headNum < srv.cfg.Genesis.L2.Number {
+		return req, 0, fmt.Errorf("cannot serve request for L2 block %d before genesis %d: %w", req.headNum, srv.cfg.Genesis.L2.Number, invalidRequestErr)
+	}
+	max, err := srv.cfg.TargetBlockNumber(uint64(time.Now().Unix()))
+	if err != nil {
+		return req, 0, fmt.Errorf("cannot determine max target block number to verify request: %w", invalidRequestErr)
+	}
+	if req.headNum > max {
+		return req, 0, fmt.Errorf("cannot serve request for L2 block %d after max expected block (%v): %w", req.headNum, max, invalidRequestErr)
+	}
+
+	// The first payload is looked up by hash if the peer knows it, and by number otherwise.
+	// All payloads after that are looked up by parent-hash, so we serve a consistent chain.
+	next := req.headHash
+	for ; served < req.count; served++ {
+		// Take a token from the global and peer rate-limiters for every block we serve.
+		// If we wait too long, we stop serving: what was served so far is still useful to the peer.
+		// If nothing was served, the request fails, so the peer backs off instead of treating it as a valid response.
+		if err := srv.globalBlocksRL.Wait(ctx); err != nil {
+			if served == 0 {
+				return req, served, fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
+			}
+			return req, served, nil
+		}
+		if err := ps.Blocks.Wait(ctx); err != nil {
+			if served == 0 {
+				return req, served, fmt.Errorf("timed out waiting for peer sync rate limit: %w", err)
+			}
+			return req, served, nil
+		}
+
+		var payload *eth.ExecutionPayload
+		if served == 0 && next == (common.Hash{}) {
+			payload, err = srv.l2.PayloadByNumber(ctx, req.headNum)
+		} else {
+			payload, err = srv.l2.PayloadByHash(ctx, next)
+		}
+		if errors.Is(err, ethereum.NotFound) {
+			if served == 0 {
+				return req, served, fmt.Errorf("peer requested unknown block %s: %w", &req, err)
+			}
+			// We may not have the full range of blocks, but what we served so far is still valid.
+			return req, served, nil
+		} else if err != nil {
+			return req, served, fmt.Errorf("failed to retrieve payload to serve to peer: %w", err)
+		}
+		if served == 0 && uint64(payload.BlockNumber) != req.headNum {
+			return req, served, fmt.Errorf("peer requested block %s, but block has number %d: %w", next, payload.BlockNumber, ethereum.NotFound)
+		}
+
+		if err := writePayloadChunk(stream, payload); err != nil {
+			return req, served, err
+		}
+		if uint64(payload.BlockNumber) <= srv.cfg.Genesis.L2.Number {
+			served++
+			break // no blocks to serve before genesis
+		}
+		next = payload.ParentHash
+	}
+	return req, served, nil
+}
+
+// writePayloadChunk writes a single payload chunk of a range response.
+func writePayloadChunk(stream network.Stream, payload *eth.ExecutionPayload) error {
+	var buf bytes.Buffer
+	if _, err := payload.MarshalSSZ(&buf); err != nil {
+		return fmt.Errorf("failed to encode payload for sync response: %w", err)
+	}
+	compressed := snappy.Encode(nil, buf.Bytes())
+
+	// We set write deadline, if available, to safely write without blocking on a throttling peer connection
+	_ = stream.SetWriteDeadline(time.Now().Add(serverWriteChunkTimeout))
+
+	// 0 - resultCode: success = 0
+	// 1:5 - version: 0
+	// 5:9 - length of the compressed payload
+	var header [9]byte
+	binary.LittleEndian.PutUint32(header[5:], uint32(len(compressed)))
+	if _, err := stream.Write(header[:]); err != nil {
+		return fmt.Errorf("failed to write response header data: %w", err)
+	}
+	if _, err := stream.Write(compressed); err != nil {
+		return fmt.Errorf("failed to write payload to sync response: %w", err)
+	}
+	return nil
+}
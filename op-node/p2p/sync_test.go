@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"context"
+	"errors"
+	"io"
 	"math/big"
 	"sync"
 	"testing"
@@ -12,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -29,8 +32,36 @@ func (fn mockPayloadFn) PayloadByNumber(_ context.Context, number uint64) (*eth.
 	return fn(number)
 }
 
+// PayloadByHash is not used by the payload_by_number protocol
+func (fn mockPayloadFn) PayloadByHash(_ context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	return nil, ethereum.NotFound
+}
+
 var _ L2Chain = mockPayloadFn(nil)
 
+// mockL2Chain serves the payloads of the test data, if they exist
+type mockL2Chain struct {
+	data *syncTestData
+}
+
+func (m *mockL2Chain) PayloadByNumber(_ context.Context, number uint64) (*eth.ExecutionPayload, error) {
+	p, ok := m.data.getPayload(number)
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return p, nil
+}
+
+func (m *mockL2Chain) PayloadByHash(_ context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	p, ok := m.data.getPayloadByHash(hash)
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return p, nil
+}
+
+var _ L2Chain = (*mockL2Chain)(nil)
+
 type syncTestData struct {
 	sync.RWMutex
 	payloads map[uint64]*eth.ExecutionPayload
@@ -43,6 +74,17 @@ func (s *syncTestData) getPayload(i uint64) (payload *eth.ExecutionPayload, ok b
 	return payload, ok
 }
 
+func (s *syncTestData) getPayloadByHash(h common.Hash) (payload *eth.ExecutionPayload, ok bool) {
+	s.RLock()
+	defer s.RUnlock()
+	for _, p := range s.payloads {
+		if p.BlockHash == h {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
 func (s *syncTestData) deletePayload(i uint64) {
 	s.Lock()
 	defer s.Unlock()
@@ -288,3 +330,468 @@ func TestMultiPeerSync(t *testing.T) {
 		require.Equal(t, exp.BlockHash, p.BlockHash, "expecting the correct payload")
 	}
 }
+
+func TestSinglePeerRangeSync(t *testing.T) {
+	t.Parallel() // Takes a while, but can run in parallel
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	// Serving payloads: just load them from the map, if they exist
+	servePayload := &mockL2Chain{data: payloads}
+
+	// collect received payloads in a buffered channel, so we can verify we get everything
+	received := make(chan *eth.ExecutionPayload, 100)
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		received <- payload
+		return nil
+	})
+
+	// Setup 2 minimal test hosts to attach the sync protocol to
+	mnet, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB := hosts[0], hosts[1]
+	require.Equal(t, hostA.Network().Connectedness(hostB.ID()), network.Connected)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Setup host A as the server
+	srv := NewReqRespServer(cfg, servePayload, metrics.NoopMetrics)
+	payloadsByHashRange := MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleRangeSyncRequest)
+	hostA.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), payloadsByHashRange)
+
+	// Setup host B as the client
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostB.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+
+	// Setup host B (client) to sync from its peer Host A (server)
+	cl.AddPeer(hostA.ID())
+	cl.Start()
+	defer cl.Close()
+
+	// request to start syncing between 10 and 20
+	require.NoError(t, cl.RequestL2Range(ctx, payloads.getBlockRef(10), payloads.getBlockRef(20)))
+
+	// and wait for the sync results to come in (in reverse order)
+	for i := uint64(19); i > 10; i-- {
+		p := <-received
+		require.Equal(t, uint64(p.BlockNumber), i, "expecting payloads in order")
+		exp, ok := payloads.getPayload(uint64(p.BlockNumber))
+		require.True(t, ok, "expecting known payload")
+		require.Equal(t, exp.BlockHash, p.BlockHash, "expecting the correct payload")
+	}
+}
+
+func TestMultiPeerRangeSync(t *testing.T) {
+	t.Parallel() // Takes a while, but can run in parallel
+
+	log := testlog.Logger(t, log.LvlDebug)
+
+	cfg, payloads := setupSyncTestData(200)
+
+	setupPeer := func(ctx context.Context, h host.Host) (*SyncClient, chan *eth.ExecutionPayload) {
+		// Serving payloads: just load them from the map, if they exist
+		servePayload := &mockL2Chain{data: payloads}
+
+		// collect received payloads in a buffered channel, so we can verify we get everything
+		received := make(chan *eth.ExecutionPayload, 200)
+		receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+			received <- payload
+			return nil
+		})
+
+		// Setup as server
+		srv := NewReqRespServer(cfg, servePayload, metrics.NoopMetrics)
+		payloadsByHashRange := MakeStreamHandler(ctx, log.New("serve", "payloads_by_hash_range"), srv.HandleRangeSyncRequest)
+		h.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), payloadsByHashRange)
+
+		cl := NewSyncClient(log.New("role", "client"), cfg, h.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+		return cl, received
+	}
+
+	// Setup 3 minimal test hosts to attach the sync protocol to
+	mnet, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB, hostC := hosts[0], hosts[1], hosts[2]
+	require.Equal(t, hostA.Network().Connectedness(hostB.ID()), network.Connected)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clA, recvA := setupPeer(ctx, hostA)
+	clB, recvB := setupPeer(ctx, hostB)
+	clC, _ := setupPeer(ctx, hostC)
+
+	// Make them all sync from each other
+	clA.AddPeer(hostB.ID())
+	clA.AddPeer(hostC.ID())
+	clA.Start()
+	defer clA.Close()
+	clB.AddPeer(hostA.ID())
+	clB.AddPeer(hostC.ID())
+	clB.Start()
+	defer clB.Close()
+	clC.AddPeer(hostA.ID())
+	clC.AddPeer(hostB.ID())
+	clC.Start()
+	defer clC.Close()
+
+	// request to start syncing between 10 and 190.
+	// This spans multiple range requests, which are divided between B and C.
+	// Only the top range is requested by hash, the lower ranges by number, and are verified once the gap is closed.
+	require.NoError(t, clA.RequestL2Range(ctx, payloads.getBlockRef(10), payloads.getBlockRef(190)))
+
+	seen := make(map[uint64]struct{})
+	for len(seen) < 179 { // wait for all payloads
+		p := <-recvA
+		exp, ok := payloads.getPayload(uint64(p.BlockNumber))
+		require.True(t, ok, "expecting known payload")
+		require.Equal(t, exp.BlockHash, p.BlockHash, "expecting the correct payload")
+		require.Greater(t, uint64(p.BlockNumber), uint64(10))
+		require.Less(t, uint64(p.BlockNumber), uint64(190))
+		seen[uint64(p.BlockNumber)] = struct{}{}
+	}
+
+	// now see if B can sync a range, and fill the gap with a re-request
+	bl25, _ := payloads.getPayload(25) // temporarily remove it from the available payloads. This will create a gap
+	payloads.deletePayload(25)
+	require.NoError(t, clB.RequestL2Range(ctx, payloads.getBlockRef(20), payloads.getBlockRef(30)))
+	// the peers stop serving the range when they hit the missing block
+	for i := uint64(29); i > 25; i-- {
+		p := <-recvB
+		exp, ok := payloads.getPayload(uint64(p.BlockNumber))
+		require.True(t, ok, "expecting known payload")
+		require.Equal(t, exp.BlockHash, p.BlockHash, "expecting the correct payload")
+	}
+	// Wait till the request is marked as done, so the re-request actually runs.
+	waitCtx, cancelFunc := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelFunc()
+	for {
+		isInFlight, err := clB.isInFlight(waitCtx, 25)
+		require.NoError(t, err)
+		if !isInFlight {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	require.Zero(t, len(recvB), "there is a gap, should not see other payloads yet")
+	// Add back the block
+	payloads.addPayload(bl25)
+	// And request a range again, 25 is there now, and 21-24 should follow quickly
+	require.NoError(t, clB.RequestL2Range(ctx, payloads.getBlockRef(20), payloads.getBlockRef(26)))
+	for i := uint64(25); i > 20; i-- {
+		p := <-recvB
+		require.Equal(t, i, uint64(p.BlockNumber), "expecting payloads in order")
+		exp, ok := payloads.getPayload(uint64(p.BlockNumber))
+		require.True(t, ok, "expecting known payload")
+		require.Equal(t, exp.BlockHash, p.BlockHash, "expecting the correct payload")
+	}
+}
+
+func TestRangeRequestEncoding(t *testing.T) {
+	req := payloadsRangeRequest{headHash: common.Hash{0x42}, headNum: 1234, count: maxRangeRequestBlocks}
+	data := req.MarshalBinary()
+	require.Len(t, data, rangeRequestLen)
+	var out payloadsRangeRequest
+	require.NoError(t, out.UnmarshalBinary(data))
+	require.Equal(t, req, out)
+	require.Error(t, out.UnmarshalBinary(data[1:]))
+}
+
+// lyingL2Chain serves the wrong payloads by hash
+type lyingL2Chain struct {
+	mockL2Chain
+}
+
+func (m *lyingL2Chain) PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	return m.PayloadByNumber(ctx, 5)
+}
+
+func TestRangeSyncRejectsInvalidChain(t *testing.T) {
+	t.Parallel()
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	mnet, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB, hostC := hosts[0], hosts[1], hosts[2]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// host A serves a broken chain
+	srvA := NewReqRespServer(cfg, &lyingL2Chain{mockL2Chain{data: payloads}}, metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID),
+		MakeStreamHandler(ctx, log.New("role", "server"), srvA.HandleRangeSyncRequest))
+	// host B serves the correct chain
+	srvB := NewReqRespServer(cfg, &mockL2Chain{data: payloads}, metrics.NoopMetrics)
+	hostB.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID),
+		MakeStreamHandler(ctx, log.New("role", "server"), srvB.HandleRangeSyncRequest))
+
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		return nil
+	})
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostC.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+
+	n, err := cl.doRequest(ctx, hostA.ID(), &payloadsRangeRequest{headNum: 16, count: 10})
+	require.ErrorContains(t, err, "expected block 15")
+	require.Zero(t, n, "no payloads of an invalid chain should be processed")
+	require.Zero(t, len(cl.results))
+
+	// a range that does not match the requested hash is rejected
+	n, err = cl.doRequest(ctx, hostB.ID(), &payloadsRangeRequest{headHash: payloads.payloads[15].BlockHash, headNum: 16, count: 10})
+	require.ErrorContains(t, err, "peer failed to serve request with code 1")
+	require.Zero(t, n)
+
+	// a valid range is served in full
+	n, err = cl.doRequest(ctx, hostB.ID(), &payloadsRangeRequest{headHash: payloads.payloads[15].BlockHash, headNum: 15, count: 10})
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, 10, len(cl.results))
+	for i := uint64(15); i > 5; i-- {
+		res := <-cl.results
+		require.Equal(t, payloads.payloads[i].BlockHash, res.payload.BlockHash)
+	}
+}
+
+func TestSyncProtocolFallback(t *testing.T) {
+	t.Parallel()
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	mnet, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB, hostC := hosts[0], hosts[1], hosts[2]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// host A serves both protocols, host B is an older peer that only serves payloads by number
+	srv := NewReqRespServer(cfg, &mockL2Chain{data: payloads}, metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleSyncRequest))
+	hostA.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleRangeSyncRequest))
+	hostB.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleSyncRequest))
+
+	m := &protocolMetrics{}
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		return nil
+	})
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostC.NewStream, receivePayload, m, &NoopApplicationScorer{})
+
+	req := &payloadsRangeRequest{headHash: payloads.payloads[15].BlockHash, headNum: 15, count: 10}
+	n, err := cl.doRequest(ctx, hostA.ID(), req)
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, 1, m.rangeRequests)
+	require.Zero(t, m.numberRequests)
+
+	n, err = cl.doRequest(ctx, hostB.ID(), req)
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, 1, m.rangeRequests)
+	require.Equal(t, 10, m.numberRequests, "the range is requested block by block from the older peer")
+
+	// the older peer serves what it has of the range
+	bl10, _ := payloads.getPayload(10)
+	payloads.deletePayload(10)
+	n, err = cl.doRequest(ctx, hostB.ID(), req)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	payloads.addPayload(bl10)
+
+	require.Equal(t, 25, len(cl.results))
+	for i := 0; i < 25; i++ {
+		res := <-cl.results
+		exp, ok := payloads.getPayload(uint64(res.payload.BlockNumber))
+		require.True(t, ok)
+		require.Equal(t, exp.BlockHash, res.payload.BlockHash)
+	}
+}
+
+// protocolMetrics counts the client requests per protocol
+type protocolMetrics struct {
+	mu             sync.Mutex
+	numberRequests int
+	rangeRequests  int
+}
+
+func (m *protocolMetrics) ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.numberRequests++
+}
+
+func (m *protocolMetrics) ClientPayloadsByHashRangeEvent(headNum uint64, blocks int, resultCode byte, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rangeRequests++
+}
+
+func (m *protocolMetrics) PayloadsQuarantineSize(n int) {}
+
+func TestRangeSyncEmptyResponse(t *testing.T) {
+	t.Parallel()
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	mnet, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB, hostC := hosts[0], hosts[1], hosts[2]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// host A is out of rate-limit tokens, and cannot serve any block in time
+	srv := NewReqRespServer(cfg, &mockL2Chain{data: payloads}, metrics.NoopMetrics)
+	srv.globalBlocksRL = rate.NewLimiter(rate.Every(time.Hour), 0)
+	hostA.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleRangeSyncRequest))
+	// host B ends the response without serving anything
+	hostB.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), func(stream network.Stream) {
+		_ = stream.Close()
+	})
+
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		return nil
+	})
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostC.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+
+	req := &payloadsRangeRequest{headHash: payloads.payloads[15].BlockHash, headNum: 15, count: 10}
+	_, err = cl.doRequest(ctx, hostA.ID(), req)
+	require.ErrorIs(t, err, requestResultErr(3), "a throttled request fails")
+	_, err = cl.doRequest(ctx, hostB.ID(), req)
+	require.ErrorIs(t, err, errEmptyRangeResponse)
+}
+
+// failingL2Chain fails to retrieve the payloads below the given block number
+type failingL2Chain struct {
+	mockL2Chain
+	failBelow uint64
+}
+
+func (m *failingL2Chain) PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	p, err := m.mockL2Chain.PayloadByHash(ctx, hash)
+	if err == nil && uint64(p.BlockNumber) < m.failBelow {
+		return nil, errors.New("failed to read payload")
+	}
+	return p, err
+}
+
+func (m *failingL2Chain) PayloadByNumber(ctx context.Context, number uint64) (*eth.ExecutionPayload, error) {
+	if number < m.failBelow {
+		return nil, errors.New("failed to read payload")
+	}
+	return m.mockL2Chain.PayloadByNumber(ctx, number)
+}
+
+func TestRangeSyncPartialResponse(t *testing.T) {
+	t.Parallel()
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	mnet, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB, hostC := hosts[0], hosts[1], hosts[2]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// host A fails to serve the range after block 13
+	srv := NewReqRespServer(cfg, &failingL2Chain{mockL2Chain: mockL2Chain{data: payloads}, failBelow: 13}, metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleRangeSyncRequest))
+	// host B writes an error code after serving blocks 15 and 14
+	hostB.SetStreamHandler(PayloadsByHashRangeProtocolID(cfg.L2ChainID), func(stream network.Stream) {
+		defer stream.Close()
+		var req [rangeRequestLen]byte
+		if _, err := io.ReadFull(stream, req[:]); err != nil {
+			return
+		}
+		for i := uint64(15); i > 13; i-- {
+			p, _ := payloads.getPayload(i)
+			if err := writePayloadChunk(stream, p); err != nil {
+				return
+			}
+		}
+		_, _ = stream.Write([]byte{3})
+	})
+
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		return nil
+	})
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostC.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+
+	// the server ends the stream without an error code, so the served payloads are kept
+	req := &payloadsRangeRequest{headHash: payloads.payloads[15].BlockHash, headNum: 15, count: 10}
+	n, err := cl.doRequest(ctx, hostA.ID(), req)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// the payloads served before an error code are kept, and the request still fails
+	n, err = cl.doRequest(ctx, hostB.ID(), req)
+	require.ErrorIs(t, err, requestResultErr(3))
+	require.Equal(t, 2, n)
+
+	require.Equal(t, 5, len(cl.results))
+	for _, i := range []uint64{15, 14, 13, 15, 14} {
+		res := <-cl.results
+		require.Equal(t, payloads.payloads[i].BlockHash, res.payload.BlockHash)
+	}
+}
+
+func TestSyncProtocolFallbackPartialResponse(t *testing.T) {
+	t.Parallel()
+
+	log := testlog.Logger(t, log.LvlError)
+
+	cfg, payloads := setupSyncTestData(25)
+
+	mnet, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hosts := mnet.Hosts()
+	hostA, hostB := hosts[0], hosts[1]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// host A only serves payloads by number, and fails to serve the payloads below block 14
+	srv := NewReqRespServer(cfg, &failingL2Chain{mockL2Chain: mockL2Chain{data: payloads}, failBelow: 14}, metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleSyncRequest))
+
+	receivePayload := receivePayloadFn(func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
+		return nil
+	})
+	cl := NewSyncClient(log.New("role", "client"), cfg, hostB.NewStream, receivePayload, metrics.NoopMetrics, &NoopApplicationScorer{})
+
+	// the payloads served before the error are kept, and the request still fails
+	req := &payloadsRangeRequest{headHash: payloads.payloads[15].BlockHash, headNum: 15, count: 10}
+	n, err := cl.doRequest(ctx, hostA.ID(), req)
+	require.ErrorIs(t, err, requestResultErr(3))
+	require.Equal(t, 2, n)
+
+	require.Equal(t, 2, len(cl.results))
+	for _, i := range []uint64{15, 14} {
+		res := <-cl.results
+		require.Equal(t, payloads.payloads[i].BlockHash, res.payload.BlockHash)
+	}
+}
@@ -0,0 +1,25 @@
+package conductor
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+// SequencerConductor coordinates the sequencer with other sequencer nodes, such that only
+// a single node sequences at a time and a sequenced block is agreed upon before it is used.
+type SequencerConductor interface {
+	// CommitUnsafePayload commits a sealed unsafe payload before it is inserted into the local
+	// chain and published. If the payload cannot be committed, it must not be used.
+	CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayload) error
+}
+
+// NoOpConductor is a SequencerConductor that accepts all payloads, for a sequencer that
+// does not coordinate with any other sequencer nodes.
+type NoOpConductor struct{}
+
+var _ SequencerConductor = NoOpConductor{}
+
+func (NoOpConductor) CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayload) error {
+	return nil
+}
@@ -44,6 +44,8 @@ type BatchQueue struct {
 
 	// batches in order of when we've first seen them, grouped by L2 timestamp
 	batches map[uint64][]*BatchWithL1InclusionBlock
+
+	tracer Tracer
 }
 
 // NewBatchQueue creates a BatchQueue, which should be Reset(origin) before use.
@@ -52,9 +54,35 @@ func NewBatchQueue(log log.Logger, cfg *rollup.Config, prev NextBatchProvider) *
 		log:    log,
 		config: cfg,
 		prev:   prev,
+		tracer: NoopTracer,
 	}
 }
 
+func (bq *BatchQueue) SetTracer(tracer Tracer) {
+	bq.tracer = tracer
+}
+
+// traceBatch emits a trace event of a decision on the batch
+func (bq *BatchQueue) traceBatch(msg string, decision BatchDecision, reason string, batch *BatchData, l2SafeHead eth.L2BlockRef) {
+	if !tracing(bq.tracer) {
+		return
+	}
+	bq.tracer.Trace(TraceEvent{
+		Stage:    TraceStageBatch,
+		Msg:      msg,
+		Decision: decision,
+		Reason:   reason,
+		Fields: map[string]any{
+			"origin":          bq.origin,
+			"batch_timestamp": batch.Timestamp,
+			"parent_hash":     batch.ParentHash,
+			"batch_epoch":     batch.Epoch(),
+			"txs":             len(batch.Transactions),
+			"l2_safe_head":    l2SafeHead.ID(),
+		},
+	})
+}
+
 func (bq *BatchQueue) Origin() eth.L1BlockRef {
 	return bq.prev.Origin()
 }
@@ -135,9 +163,10 @@ func (bq *BatchQueue) AddBatch(batch *BatchData, l2SafeHead eth.L2BlockRef) {
 		L1InclusionBlock: bq.origin,
 		Batch:            batch,
 	}
-	validity := CheckBatch(bq.config, bq.log, bq.l1Blocks, l2SafeHead, &data)
+	validity, reason := checkBatch(bq.config, bq.log, bq.l1Blocks, l2SafeHead, &data)
 	if validity == BatchDrop {
-		return // if we do drop the batch, CheckBatch will log the drop reason with WARN level.
+		bq.traceBatch("dropping batch", BatchDecisionDrop, reason, batch, l2SafeHead)
+		return // if we do drop the batch, checkBatch will log the drop reason with WARN level.
 	}
 	bq.log.Debug("Adding batch", "batch_timestamp", batch.Timestamp, "parent_hash", batch.ParentHash, "batch_epoch", batch.Epoch(), "txs", len(batch.Transactions))
 	bq.batches[batch.Timestamp] = append(bq.batches[batch.Timestamp], &data)
@@ -173,7 +202,7 @@ func (bq *BatchQueue) deriveNextBatch(ctx context.Context, outOfData bool, l2Saf
 	candidates := bq.batches[nextTimestamp]
 batchLoop:
 	for i, batch := range candidates {
-		validity := CheckBatch(bq.config, bq.log.New("batch_index", i), bq.l1Blocks, l2SafeHead, batch)
+		validity, reason := checkBatch(bq.config, bq.log.New("batch_index", i), bq.l1Blocks, l2SafeHead, batch)
 		switch validity {
 		case BatchFuture:
 			return nil, NewCriticalError(fmt.Errorf("found batch with timestamp %d marked as future batch, but expected timestamp %d", batch.Batch.Timestamp, nextTimestamp))
@@ -186,6 +215,7 @@ batchLoop:
 				"l2_safe_head", l2SafeHead.ID(),
 				"l2_safe_head_time", l2SafeHead.Time,
 			)
+			bq.traceBatch("dropping batch", BatchDecisionDrop, reason, batch.Batch, l2SafeHead)
 			continue
 		case BatchAccept:
 			nextBatch = batch
@@ -214,6 +244,7 @@ batchLoop:
 			bq.l1Blocks = bq.l1Blocks[1:]
 		}
 		bq.log.Info("Found next batch", "epoch", epoch, "batch_epoch", nextBatch.Batch.EpochNum, "batch_timestamp", nextBatch.Batch.Timestamp)
+		bq.traceBatch("found next batch", BatchDecisionAccept, "", nextBatch.Batch, l2SafeHead)
 		return nextBatch.Batch, nil
 	}
 
@@ -243,7 +274,7 @@ batchLoop:
 	// batch to ensure that we at least have one batch per epoch.
 	if nextTimestamp < nextEpoch.Time || firstOfEpoch {
 		bq.log.Info("Generating next batch", "epoch", epoch, "timestamp", nextTimestamp)
-		return &BatchData{
+		batch := &BatchData{
 			BatchV1{
 				ParentHash:   l2SafeHead.Hash,
 				EpochNum:     rollup.Epoch(epoch.Number),
@@ -251,7 +282,9 @@ batchLoop:
 				Timestamp:    nextTimestamp,
 				Transactions: nil,
 			},
-		}, nil
+		}
+		bq.traceBatch("generating next batch", BatchDecisionEmpty, "", batch, l2SafeHead)
+		return batch, nil
 	}
 
 	// At this point we have auto generated every batch for the current epoch
@@ -183,6 +183,62 @@ func TestBatchQueueEager(t *testing.T) {
 	}
 }
 
+type recordingTracer struct {
+	events []TraceEvent
+}
+
+func (r *recordingTracer) Trace(ev TraceEvent) {
+	r.events = append(r.events, ev)
+}
+
+// TestBatchQueueTrace asserts that the batch queue traces its decisions on batches,
+// including the reason of dropped batches.
+func TestBatchQueueTrace(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	l1 := L1Chain([]uint64{10, 20, 30})
+	safeHead := eth.L2BlockRef{
+		Hash:           mockHash(10, 2),
+		Number:         0,
+		ParentHash:     common.Hash{},
+		Time:           10,
+		L1Origin:       l1[0].ID(),
+		SequenceNumber: 0,
+	}
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L2Time: 10,
+		},
+		BlockTime:         2,
+		MaxSequencerDrift: 600,
+		SeqWindowSize:     30,
+	}
+
+	input := &fakeBatchQueueInput{
+		batches: []*BatchData{b(10, l1[0]), b(12, l1[0])},
+		errors:  []error{nil, nil},
+		origin:  l1[0],
+	}
+
+	bq := NewBatchQueue(log, cfg, input)
+	tracer := &recordingTracer{}
+	bq.SetTracer(tracer)
+	_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
+	input.origin = l1[1]
+
+	_, err := bq.NextBatch(context.Background(), safeHead)
+	require.ErrorIs(t, err, NotEnoughData)
+	batch, err := bq.NextBatch(context.Background(), safeHead)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), batch.Timestamp)
+
+	require.Len(t, tracer.events, 2)
+	require.Equal(t, TraceStageBatch, tracer.events[0].Stage)
+	require.Equal(t, BatchDecisionDrop, tracer.events[0].Decision)
+	require.Equal(t, "dropping batch with old timestamp", tracer.events[0].Reason)
+	require.Equal(t, BatchDecisionAccept, tracer.events[1].Decision)
+	require.Empty(t, tracer.events[1].Reason)
+}
+
 // TestBatchQueueInvalidInternalAdvance asserts that we do not miss an epoch when generating batches.
 // This is a regression test for CLI-3378.
 func TestBatchQueueInvalidInternalAdvance(t *testing.T) {
@@ -37,6 +37,7 @@ type ChannelBank struct {
 
 	prev    NextFrameProvider
 	fetcher L1Fetcher
+	tracer  Tracer
 }
 
 var _ ResetableStage = (*ChannelBank)(nil)
@@ -50,9 +51,14 @@ func NewChannelBank(log log.Logger, cfg *rollup.Config, prev NextFrameProvider,
 		channelQueue: make([]ChannelID, 0, 10),
 		prev:         prev,
 		fetcher:      fetcher,
+		tracer:       NoopTracer,
 	}
 }
 
+func (cb *ChannelBank) SetTracer(tracer Tracer) {
+	cb.tracer = tracer
+}
+
 func (cb *ChannelBank) Origin() eth.L1BlockRef {
 	return cb.prev.Origin()
 }
@@ -80,6 +86,16 @@ func (cb *ChannelBank) IngestFrame(f Frame) {
 	origin := cb.Origin()
 	log := cb.log.New("origin", origin, "channel", f.ID, "length", len(f.Data), "frame_number", f.FrameNumber, "is_last", f.IsLast)
 	log.Debug("channel bank got new data")
+	trace := func(stage TraceStage, msg string, err error) {
+		if !tracing(cb.tracer) {
+			return
+		}
+		fields := map[string]any{"origin": origin, "channel": f.ID, "length": len(f.Data), "frame_number": f.FrameNumber, "is_last": f.IsLast}
+		if err != nil {
+			fields["err"] = err.Error()
+		}
+		cb.tracer.Trace(TraceEvent{Stage: stage, Msg: msg, Fields: fields})
+	}
 
 	currentCh, ok := cb.channels[f.ID]
 	if !ok {
@@ -88,19 +104,23 @@ func (cb *ChannelBank) IngestFrame(f Frame) {
 		cb.channels[f.ID] = currentCh
 		cb.channelQueue = append(cb.channelQueue, f.ID)
 		log.Info("created new channel")
+		trace(TraceStageChannel, "created new channel", nil)
 	}
 
 	// check if the channel is not timed out
 	if currentCh.OpenBlockNumber()+cb.cfg.ChannelTimeout < origin.Number {
 		log.Warn("channel is timed out, ignore frame")
+		trace(TraceStageFrame, "channel is timed out, ignore frame", nil)
 		return
 	}
 
 	log.Trace("ingesting frame")
 	if err := currentCh.AddFrame(f, origin); err != nil {
 		log.Warn("failed to ingest frame into channel", "err", err)
+		trace(TraceStageFrame, "failed to ingest frame into channel", err)
 		return
 	}
+	trace(TraceStageFrame, "ingested frame", nil)
 
 	// Prune after the frame is loaded.
 	cb.prune()
@@ -117,6 +137,9 @@ func (cb *ChannelBank) Read() (data []byte, err error) {
 	timedOut := ch.OpenBlockNumber()+cb.cfg.ChannelTimeout < cb.Origin().Number
 	if timedOut {
 		cb.log.Info("channel timed out", "channel", first, "frames", len(ch.inputs))
+		if tracing(cb.tracer) {
+			cb.tracer.Trace(TraceEvent{Stage: TraceStageChannel, Msg: "channel timed out", Fields: map[string]any{"origin": cb.Origin(), "channel": first, "frames": len(ch.inputs)}})
+		}
 		delete(cb.channels, first)
 		cb.channelQueue = cb.channelQueue[1:]
 		return nil, nil // multiple different channels may all be timed out
@@ -125,6 +148,9 @@ func (cb *ChannelBank) Read() (data []byte, err error) {
 		return nil, io.EOF
 	}
 	cb.log.Info("Reading channel", "channel", first, "frames", len(ch.inputs))
+	if tracing(cb.tracer) {
+		cb.tracer.Trace(TraceEvent{Stage: TraceStageChannel, Msg: "reading channel", Fields: map[string]any{"origin": cb.Origin(), "channel": first, "frames": len(ch.inputs)}})
+	}
 
 	delete(cb.channels, first)
 	cb.channelQueue = cb.channelQueue[1:]
@@ -23,6 +23,7 @@ type ChannelInReader struct {
 	prev *ChannelBank
 
 	metrics Metrics
+	tracer  Tracer
 }
 
 var _ ResetableStage = (*ChannelInReader)(nil)
@@ -33,9 +34,14 @@ func NewChannelInReader(log log.Logger, prev *ChannelBank, metrics Metrics) *Cha
 		log:     log,
 		prev:    prev,
 		metrics: metrics,
+		tracer:  NoopTracer,
 	}
 }
 
+func (cr *ChannelInReader) SetTracer(tracer Tracer) {
+	cr.tracer = tracer
+}
+
 func (cr *ChannelInReader) Origin() eth.L1BlockRef {
 	return cr.prev.Origin()
 }
@@ -82,9 +88,20 @@ func (cr *ChannelInReader) NextBatch(ctx context.Context) (*BatchData, error) {
 		return nil, NotEnoughData
 	} else if err != nil {
 		cr.log.Warn("failed to read batch from channel reader, skipping to next channel now", "err", err)
+		if tracing(cr.tracer) {
+			cr.tracer.Trace(TraceEvent{Stage: TraceStageChannel, Msg: "failed to read batch from channel", Fields: map[string]any{"origin": cr.Origin(), "err": err.Error()}})
+		}
 		cr.NextChannel()
 		return nil, NotEnoughData
 	}
+	if tracing(cr.tracer) {
+		cr.tracer.Trace(TraceEvent{Stage: TraceStageChannel, Msg: "read batch from channel", Fields: map[string]any{
+			"origin":          cr.Origin(),
+			"batch_timestamp": batch.Batch.Timestamp,
+			"batch_epoch":     batch.Batch.Epoch(),
+			"txs":             len(batch.Batch.Transactions),
+		}})
+	}
 	return batch.Batch, nil
 }
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/conductor"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 )
 
@@ -55,7 +56,8 @@ type EngineControl interface {
 	// If updateSafe, the resulting block will be marked as a safe block.
 	StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType BlockInsertionErrType, err error)
 	// ConfirmPayload requests the engine to complete the current block. If no block is being built, or if it fails, an error is returned.
-	ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
+	// The payload is committed through the sequencer conductor before it becomes canonical.
+	ConfirmPayload(ctx context.Context, sequencerConductor conductor.SequencerConductor) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
 	// CancelPayload requests the engine to stop building the current block without making it canonical.
 	// This is optional, as the engine expires building jobs that are left uncompleted, but can still save resources.
 	CancelPayload(ctx context.Context, force bool) error
@@ -63,6 +65,32 @@ type EngineControl interface {
 	BuildingPayload() (onto eth.L2BlockRef, id eth.PayloadID, safe bool)
 }
 
+// SafeHeadListener is notified of the safe head after processing each L1 block, and of resets of the safe head.
+type SafeHeadListener interface {
+	// Enabled reports if this listener is interested in safe head updates.
+	Enabled() bool
+	// SafeHeadUpdated indicates that the safe head changed to safeHead, after fully processing the given L1 block.
+	SafeHeadUpdated(safeHead eth.L2BlockRef, l1Block eth.BlockID) error
+	// SafeHeadReset indicates that derivation was reset to the given safe head,
+	// and that any later recorded safe heads may no longer be valid.
+	SafeHeadReset(resetSafeHead eth.L2BlockRef) error
+}
+
+// NoopSafeHeadListener ignores all safe head updates.
+type NoopSafeHeadListener struct{}
+
+func (NoopSafeHeadListener) Enabled() bool {
+	return false
+}
+
+func (NoopSafeHeadListener) SafeHeadUpdated(eth.L2BlockRef, eth.BlockID) error {
+	return nil
+}
+
+func (NoopSafeHeadListener) SafeHeadReset(eth.L2BlockRef) error {
+	return nil
+}
+
 // Max memory used for buffering unsafe payloads
 const maxUnsafePayloadsMemory = 500 * 1024 * 1024
 
@@ -85,6 +113,18 @@ const finalityLookback = 4*32 + 1
 // We do not want to do this too often, since it requires fetching a L1 block by number, so no cache data.
 const finalityDelay = 64
 
+// elSyncStatus tracks the progress of execution-layer sync, see sync.ELSync.
+type elSyncStatus int
+
+const (
+	// elSyncWillStart: EL sync is enabled, and starts on the next reset if the engine has not synced anything yet.
+	elSyncWillStart elSyncStatus = iota
+	// elSyncStarted: the engine is syncing towards the latest unsafe payload, derivation from L1 is paused.
+	elSyncStarted
+	// elSyncFinished: the engine synced (or EL sync was skipped or disabled), derivation runs as usual.
+	elSyncFinished
+)
+
 type FinalityData struct {
 	// The last L2 block that was fully derived and inserted into the L2 engine while processing this L1 block.
 	L2Block eth.L2BlockRef
@@ -133,12 +173,23 @@ type EngineQueue struct {
 
 	metrics   Metrics
 	l1Fetcher L1Fetcher
+
+	syncCfg      *sync.Config
+	elSyncStatus elSyncStatus
+
+	safeHeadNotifs SafeHeadListener
+	// lastNotifiedSafeHead is the safe head that the safeHeadNotifs were last notified of
+	lastNotifiedSafeHead eth.L2BlockRef
 }
 
 var _ EngineControl = (*EngineQueue)(nil)
 
 // NewEngineQueue creates a new EngineQueue, which should be Reset(origin) before use.
-func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics Metrics, prev NextAttributesProvider, l1Fetcher L1Fetcher) *EngineQueue {
+func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics Metrics, prev NextAttributesProvider, l1Fetcher L1Fetcher, syncCfg *sync.Config, safeHeadListener SafeHeadListener) *EngineQueue {
+	status := elSyncFinished
+	if syncCfg.SyncMode == sync.ELSync {
+		status = elSyncWillStart
+	}
 	return &EngineQueue{
 		log:            log,
 		cfg:            cfg,
@@ -148,6 +199,9 @@ func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics M
 		unsafePayloads: NewPayloadsQueue(maxUnsafePayloadsMemory, payloadMemSize),
 		prev:           prev,
 		l1Fetcher:      l1Fetcher,
+		syncCfg:        syncCfg,
+		elSyncStatus:   status,
+		safeHeadNotifs: safeHeadListener,
 	}
 }
 
@@ -221,10 +275,18 @@ func (eq *EngineQueue) SafeL2Head() eth.L2BlockRef {
 	return eq.safeHead
 }
 
+// ELSyncing returns true while the engine is syncing by itself, and the L2 chain is not derived from L1.
+func (eq *EngineQueue) ELSyncing() bool {
+	return eq.elSyncStatus == elSyncStarted
+}
+
 func (eq *EngineQueue) Step(ctx context.Context) error {
 	if eq.needForkchoiceUpdate {
 		return eq.tryUpdateEngine(ctx)
 	}
+	if eq.elSyncStatus == elSyncStarted {
+		return eq.tryELSync(ctx)
+	}
 	if eq.safeAttributes != nil {
 		return eq.tryNextSafeAttributes(ctx)
 	}
@@ -242,6 +304,10 @@ func (eq *EngineQueue) Step(ctx context.Context) error {
 	}
 	if next, err := eq.prev.NextAttributes(ctx, eq.safeHead); err == io.EOF {
 		outOfData = true
+		// all data of the current origin is processed, the safe head is final for this L1 block
+		if err := eq.notifySafeHead(); err != nil {
+			return err
+		}
 	} else if err != nil {
 		return err
 	} else {
@@ -375,6 +441,19 @@ func (eq *EngineQueue) postProcessSafeL2() {
 	}
 }
 
+// notifySafeHead notifies the safe head listener of the safe head after processing the current origin,
+// if the safe head changed since the last notification.
+func (eq *EngineQueue) notifySafeHead() error {
+	if !eq.safeHeadNotifs.Enabled() || eq.lastNotifiedSafeHead == eq.safeHead {
+		return nil
+	}
+	if err := eq.safeHeadNotifs.SafeHeadUpdated(eq.safeHead, eq.origin.ID()); err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to notify safe head listener: %w", err))
+	}
+	eq.lastNotifiedSafeHead = eq.safeHead
+	return nil
+}
+
 func (eq *EngineQueue) logSyncProgress(reason string) {
 	eq.log.Info("Sync progress",
 		"reason", reason,
@@ -483,6 +562,77 @@ func (eq *EngineQueue) tryNextUnsafePayload(ctx context.Context) error {
 	return nil
 }
 
+// tryELSync points the engine at the latest unsafe payload, for the engine to sync towards by itself.
+// Once the engine has synced, a safe head is anchored behind the synced head, and the pipeline is reset,
+// to derive the chain from L1 on top of the anchor and verify the synced blocks against the derived blocks.
+func (eq *EngineQueue) tryELSync(ctx context.Context) error {
+	// Only the latest payload is relevant as sync target, older payloads are included by the sync.
+	for eq.unsafePayloads.Len() > 1 {
+		eq.unsafePayloads.Pop()
+	}
+	target := eq.unsafePayloads.Peek()
+	if target == nil {
+		return io.EOF
+	}
+
+	status, err := eq.engine.NewPayload(ctx, target)
+	if err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to send sync target payload: %w", err))
+	}
+	switch status.Status {
+	case eth.ExecutionValid, eth.ExecutionSyncing, eth.ExecutionAccepted:
+	default:
+		eq.unsafePayloads.Pop()
+		return NewTemporaryError(fmt.Errorf("cannot sync to unsafe payload %s: %w", target.ID(), eth.NewPayloadErr(target, status)))
+	}
+
+	// The safe and finalized blocks are not known until the engine has synced.
+	fc := eth.ForkchoiceState{HeadBlockHash: target.BlockHash}
+	fcRes, err := eq.engine.ForkchoiceUpdate(ctx, &fc, nil)
+	if err != nil {
+		var inputErr eth.InputError
+		if errors.As(err, &inputErr) && inputErr.Code == eth.InvalidForkchoiceState {
+			eq.unsafePayloads.Pop()
+			return NewTemporaryError(fmt.Errorf("sync target %s was rejected by the engine: %w", target.ID(), inputErr.Unwrap()))
+		}
+		return NewTemporaryError(fmt.Errorf("failed to update forkchoice to sync target: %w", err))
+	}
+	switch fcRes.PayloadStatus.Status {
+	case eth.ExecutionSyncing, eth.ExecutionAccepted:
+		// The target stays queued: it is sent again on the next step, to check if the engine completed the sync.
+		eq.log.Info("Engine is syncing towards unsafe payload", "target", target.ID())
+		return io.EOF
+	case eth.ExecutionValid:
+	default:
+		eq.unsafePayloads.Pop()
+		return NewTemporaryError(fmt.Errorf("cannot sync to unsafe payload %s: %w", target.ID(), eth.ForkchoiceUpdateErr(fcRes.PayloadStatus)))
+	}
+
+	head, err := PayloadToBlockRef(target, &eq.cfg.Genesis)
+	if err != nil {
+		eq.unsafePayloads.Pop()
+		return NewTemporaryError(fmt.Errorf("failed to decode L2 block ref of sync target: %w", err))
+	}
+	anchor, err := sync.ELSyncAnchor(ctx, eq.cfg, eq.engine, head)
+	if err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to find safe head to verify synced chain from: %w", err))
+	}
+	// Mark the anchor as safe, for the reset to find the safe head from, and derive the blocks after it.
+	// Nothing is finalized yet: the synced chain only finalizes once it is derived from finalized L1 data.
+	fc = eth.ForkchoiceState{
+		HeadBlockHash:      head.Hash,
+		SafeBlockHash:      anchor.Hash,
+		FinalizedBlockHash: eq.cfg.Genesis.L2.Hash,
+	}
+	if _, err := eq.engine.ForkchoiceUpdate(ctx, &fc, nil); err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to mark safe head to verify synced chain from: %w", err))
+	}
+	eq.unsafePayloads.Pop()
+	eq.elSyncStatus = elSyncFinished
+	eq.log.Info("Finished EL sync", "head", head, "anchor", anchor)
+	return NewResetError(errors.New("finished EL sync, resetting to derive the synced chain from L1"))
+}
+
 func (eq *EngineQueue) tryNextSafeAttributes(ctx context.Context) error {
 	if eq.safeAttributes == nil { // sanity check the attributes are there
 		return nil
@@ -558,7 +708,8 @@ func (eq *EngineQueue) forceNextSafeAttributes(ctx context.Context) error {
 	attrs := eq.safeAttributes.attributes
 	errType, err := eq.StartPayload(ctx, eq.safeHead, attrs, true)
 	if err == nil {
-		_, errType, err = eq.ConfirmPayload(ctx)
+		// safe blocks are derived by every node, and are not committed through the conductor
+		_, errType, err = eq.ConfirmPayload(ctx, conductor.NoOpConductor{})
 	}
 	if err != nil {
 		switch errType {
@@ -622,7 +773,7 @@ func (eq *EngineQueue) StartPayload(ctx context.Context, parent eth.L2BlockRef,
 	return BlockInsertOK, nil
 }
 
-func (eq *EngineQueue) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+func (eq *EngineQueue) ConfirmPayload(ctx context.Context, sequencerConductor conductor.SequencerConductor) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
 	if eq.buildingID == (eth.PayloadID{}) {
 		return nil, BlockInsertPrestateErr, fmt.Errorf("cannot complete payload building: not currently building a payload")
 	}
@@ -634,7 +785,7 @@ func (eq *EngineQueue) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPa
 		SafeBlockHash:      eq.safeHead.Hash,
 		FinalizedBlockHash: eq.finalized.Hash,
 	}
-	payload, errTyp, err := ConfirmPayload(ctx, eq.log, eq.engine, fc, eq.buildingID, eq.buildingSafe)
+	payload, errTyp, err := ConfirmPayload(ctx, eq.log, eq.engine, fc, eq.buildingID, eq.buildingSafe, sequencerConductor)
 	if err != nil {
 		return nil, errTyp, fmt.Errorf("failed to complete building on top of L2 chain %s, id: %s, error (%d): %w", eq.buildingOnto, eq.buildingID, errTyp, err)
 	}
@@ -685,6 +836,20 @@ func (eq *EngineQueue) resetBuildingState() {
 // ResetStep Walks the L2 chain backwards until it finds an L2 block whose L1 origin is canonical.
 // The unsafe head is set to the head of the L2 chain, unless the existing safe head is not canonical.
 func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.SystemConfig) error {
+	if eq.elSyncStatus == elSyncWillStart {
+		head, err := eq.engine.L2BlockRefByLabel(ctx, eth.Unsafe)
+		if err != nil {
+			return NewTemporaryError(fmt.Errorf("failed to fetch the engine head to decide on EL sync: %w", err))
+		}
+		// Only a fresh engine syncs by itself, an engine that already has a chain continues with derivation.
+		if head.Number == eq.cfg.Genesis.L2.Number {
+			eq.log.Info("Starting EL sync")
+			eq.elSyncStatus = elSyncStarted
+		} else {
+			eq.log.Info("Skipping EL sync, the engine has synced before", "head", head)
+			eq.elSyncStatus = elSyncFinished
+		}
+	}
 	result, err := sync.FindL2Heads(ctx, eq.cfg, eq.l1Fetcher, eq.engine, eq.log)
 	if err != nil {
 		return NewTemporaryError(fmt.Errorf("failed to find the L2 Heads to start from: %w", err))
@@ -723,9 +888,14 @@ func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.System
 	if err != nil {
 		return NewTemporaryError(fmt.Errorf("failed to fetch L1 config of L2 block %s: %w", pipelineL2.ID(), err))
 	}
+	if err := eq.safeHeadNotifs.SafeHeadReset(safe); err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to reset safe head listener to %s: %w", safe, err))
+	}
 	eq.log.Debug("Reset engine queue", "safeHead", safe, "unsafe", unsafe, "safe_timestamp", safe.Time, "unsafe_timestamp", unsafe.Time, "l1Origin", l1Origin)
 	eq.unsafeHead = unsafe
 	eq.safeHead = safe
+	// the safe head was derived from a later L1 block than the one the pipeline restarts from, it is not notified again
+	eq.lastNotifiedSafeHead = safe
 	eq.safeAttributes = nil
 	eq.finalized = finalized
 	eq.resetBuildingState()
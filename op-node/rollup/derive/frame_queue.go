@@ -20,15 +20,21 @@ type FrameQueue struct {
 	log    log.Logger
 	frames []Frame
 	prev   NextDataProvider
+	tracer Tracer
 }
 
 func NewFrameQueue(log log.Logger, prev NextDataProvider) *FrameQueue {
 	return &FrameQueue{
-		log:  log,
-		prev: prev,
+		log:    log,
+		prev:   prev,
+		tracer: NoopTracer,
 	}
 }
 
+func (fq *FrameQueue) SetTracer(tracer Tracer) {
+	fq.tracer = tracer
+}
+
 func (fq *FrameQueue) Origin() eth.L1BlockRef {
 	return fq.prev.Origin()
 }
@@ -41,8 +47,14 @@ func (fq *FrameQueue) NextFrame(ctx context.Context) (Frame, error) {
 		} else {
 			if new, err := ParseFrames(data); err == nil {
 				fq.frames = append(fq.frames, new...)
+				if tracing(fq.tracer) {
+					fq.tracer.Trace(TraceEvent{Stage: TraceStageFrame, Msg: "parsed frames", Fields: map[string]any{"origin": fq.prev.Origin(), "frames": len(new)}})
+				}
 			} else {
 				fq.log.Warn("Failed to parse frames", "origin", fq.prev.Origin(), "err", err)
+				if tracing(fq.tracer) {
+					fq.tracer.Trace(TraceEvent{Stage: TraceStageFrame, Msg: "failed to parse frames", Fields: map[string]any{"origin": fq.prev.Origin(), "err": err.Error()}})
+				}
 			}
 		}
 	}
@@ -29,6 +29,7 @@ type L1Traversal struct {
 	log      log.Logger
 	sysCfg   eth.SystemConfig
 	cfg      *rollup.Config
+	tracer   Tracer
 }
 
 var _ ResetableStage = (*L1Traversal)(nil)
@@ -38,9 +39,14 @@ func NewL1Traversal(log log.Logger, cfg *rollup.Config, l1Blocks L1BlockRefByNum
 		log:      log,
 		l1Blocks: l1Blocks,
 		cfg:      cfg,
+		tracer:   NoopTracer,
 	}
 }
 
+func (l1t *L1Traversal) SetTracer(tracer Tracer) {
+	l1t.tracer = tracer
+}
+
 func (l1t *L1Traversal) Origin() eth.L1BlockRef {
 	return l1t.block
 }
@@ -82,6 +88,9 @@ func (l1t *L1Traversal) AdvanceL1Block(ctx context.Context) error {
 
 	l1t.block = nextL1Origin
 	l1t.done = false
+	if tracing(l1t.tracer) {
+		l1t.tracer.Trace(TraceEvent{Stage: TraceStageL1, Msg: "advanced L1 origin", Fields: map[string]any{"origin": nextL1Origin}})
+	}
 	return nil
 }
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/conductor"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 )
 
 type Metrics interface {
@@ -53,6 +55,7 @@ type EngineQueueStage interface {
 	Finalize(l1Origin eth.L1BlockRef)
 	AddUnsafePayload(payload *eth.ExecutionPayload)
 	UnsafeL2SyncTarget() eth.L2BlockRef
+	ELSyncing() bool
 	Step(context.Context) error
 }
 
@@ -75,7 +78,7 @@ type DerivationPipeline struct {
 }
 
 // NewDerivationPipeline creates a derivation pipeline, which should be reset before use.
-func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, engine Engine, metrics Metrics) *DerivationPipeline {
+func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, engine Engine, metrics Metrics, syncCfg *sync.Config, safeHeadListener SafeHeadListener) *DerivationPipeline {
 
 	// Pull stages
 	l1Traversal := NewL1Traversal(log, cfg, l1Fetcher)
@@ -89,7 +92,7 @@ func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetch
 	attributesQueue := NewAttributesQueue(log, cfg, attrBuilder, batchQueue)
 
 	// Step stages
-	eng := NewEngineQueue(log, cfg, engine, metrics, attributesQueue, l1Fetcher)
+	eng := NewEngineQueue(log, cfg, engine, metrics, attributesQueue, l1Fetcher, syncCfg, safeHeadListener)
 
 	// Reset from engine queue then up from L1 Traversal. The stages do not talk to each other during
 	// the reset, but after the engine queue, this is the order in which the stages could talk to each other.
@@ -108,10 +111,20 @@ func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetch
 	}
 }
 
+// SetTracer sets the tracer of the stages that emit trace events, see TracedStage
+func (dp *DerivationPipeline) SetTracer(tracer Tracer) {
+	for _, stage := range dp.stages {
+		if traced, ok := stage.(TracedStage); ok {
+			traced.SetTracer(tracer)
+		}
+	}
+}
+
 // EngineReady returns true if the engine is ready to be used.
 // When it's being reset its state is inconsistent, and should not be used externally.
+// While the engine syncs by itself (EL sync), it is not ready either.
 func (dp *DerivationPipeline) EngineReady() bool {
-	return dp.resetting > 0
+	return dp.resetting > 0 && !dp.eng.ELSyncing()
 }
 
 func (dp *DerivationPipeline) Reset() {
@@ -151,8 +164,8 @@ func (dp *DerivationPipeline) StartPayload(ctx context.Context, parent eth.L2Blo
 	return dp.eng.StartPayload(ctx, parent, attrs, updateSafe)
 }
 
-func (dp *DerivationPipeline) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
-	return dp.eng.ConfirmPayload(ctx)
+func (dp *DerivationPipeline) ConfirmPayload(ctx context.Context, sequencerConductor conductor.SequencerConductor) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+	return dp.eng.ConfirmPayload(ctx, sequencerConductor)
 }
 
 func (dp *DerivationPipeline) CancelPayload(ctx context.Context, force bool) error {
@@ -173,6 +186,11 @@ func (dp *DerivationPipeline) UnsafeL2SyncTarget() eth.L2BlockRef {
 	return dp.eng.UnsafeL2SyncTarget()
 }
 
+// ELSyncing returns true while the engine syncs by itself, and the L2 chain is not derived from L1
+func (dp *DerivationPipeline) ELSyncing() bool {
+	return dp.eng.ELSyncing()
+}
+
 // Step tries to progress the buffer.
 // An EOF is returned if there pipeline is blocked by waiting for new L1 data.
 // If ctx errors no error is returned, but the step may exit early in a state that can still be continued.
@@ -197,6 +215,10 @@ func (dp *DerivationPipeline) Step(ctx context.Context) error {
 
 	// Now step the engine queue. It will pull earlier data as needed.
 	if err := dp.eng.Step(ctx); err == io.EOF {
+		// There is no L1 data to traverse while the engine syncs by itself
+		if dp.eng.ELSyncing() {
+			return io.EOF
+		}
 		// If every stage has returned io.EOF, try to advance the L1 Origin
 		return dp.traversal.AdvanceL1Block(ctx)
 	} else if err != nil {
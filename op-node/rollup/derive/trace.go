@@ -0,0 +1,54 @@
+package derive
+
+// TraceStage identifies the derivation stage that emitted a trace event
+type TraceStage string
+
+const (
+	TraceStageL1         TraceStage = "l1"
+	TraceStageFrame      TraceStage = "frame"
+	TraceStageChannel    TraceStage = "channel"
+	TraceStageBatch      TraceStage = "batch"
+	TraceStageAttributes TraceStage = "attributes"
+)
+
+// BatchDecision is the decision of the batch queue on a batch, as reported in trace events
+type BatchDecision string
+
+const (
+	BatchDecisionAccept BatchDecision = "accept"
+	BatchDecisionDrop   BatchDecision = "drop"
+	BatchDecisionEmpty  BatchDecision = "generate-empty"
+)
+
+// TraceEvent is a structured event of a derivation stage
+type TraceEvent struct {
+	Stage TraceStage
+	Msg   string
+	// Decision is set for the batch stage events that decide on a batch
+	Decision BatchDecision
+	// Reason is set for dropped batches, and describes the batch check that failed
+	Reason string
+	Fields map[string]any
+}
+
+// Tracer receives the trace events of the derivation stages, e.g. to debug why a batch was dropped
+type Tracer interface {
+	Trace(ev TraceEvent)
+}
+
+// TracedStage is a derivation stage that emits trace events
+type TracedStage interface {
+	SetTracer(tracer Tracer)
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Trace(TraceEvent) {}
+
+// NoopTracer discards all trace events, it is the tracer of the stages by default
+var NoopTracer Tracer = noopTracer{}
+
+// tracing returns false if the trace events are discarded, such that the stages can skip building them
+func tracing(tracer Tracer) bool {
+	return tracer != NoopTracer
+}
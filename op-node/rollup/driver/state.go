@@ -540,7 +540,12 @@ type hashAndErrorChannel struct {
 // checkForGapInUnsafeQueue checks if there is a gap in the unsafe queue and attempts to retrieve the missing payloads from an alt-sync method.
 // WARNING: This is only an outgoing signal, the blocks are not guaranteed to be retrieved.
 // Results are received through OnUnsafeL2Payload.
+// No blocks are requested while the engine syncs by itself, as it retrieves the blocks it needs from its own peers.
 func (s *Driver) checkForGapInUnsafeQueue(ctx context.Context) error {
+	if s.derivation.ELSyncing() {
+		s.log.Debug("skipping alt-sync during execution-layer sync")
+		return nil
+	}
 	start := s.derivation.UnsafeL2Head()
 	end := s.derivation.UnsafeL2SyncTarget()
 	// Check if we have missing blocks between the start and end. Request them if we do.
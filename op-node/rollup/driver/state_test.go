@@ -0,0 +1,61 @@
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+)
+
+type fakeDerivationPipeline struct {
+	DerivationPipeline
+
+	elSyncing  bool
+	unsafe     eth.L2BlockRef
+	syncTarget eth.L2BlockRef
+}
+
+func (f *fakeDerivationPipeline) ELSyncing() bool {
+	return f.elSyncing
+}
+
+func (f *fakeDerivationPipeline) UnsafeL2Head() eth.L2BlockRef {
+	return f.unsafe
+}
+
+func (f *fakeDerivationPipeline) UnsafeL2SyncTarget() eth.L2BlockRef {
+	return f.syncTarget
+}
+
+type rangeRequest struct {
+	start, end eth.L2BlockRef
+}
+
+type fakeAltSync struct {
+	requests []rangeRequest
+}
+
+func (f *fakeAltSync) RequestL2Range(ctx context.Context, start, end eth.L2BlockRef) error {
+	f.requests = append(f.requests, rangeRequest{start, end})
+	return nil
+}
+
+func TestCheckForGapInUnsafeQueue(t *testing.T) {
+	unsafe := eth.L2BlockRef{Number: 10}
+	syncTarget := eth.L2BlockRef{Number: 20}
+	derivation := &fakeDerivationPipeline{elSyncing: true, unsafe: unsafe, syncTarget: syncTarget}
+	altSync := &fakeAltSync{}
+	d := &Driver{derivation: derivation, altSync: altSync, log: testlog.Logger(t, log.LvlError)}
+
+	// the engine retrieves the blocks by itself during EL sync
+	require.NoError(t, d.checkForGapInUnsafeQueue(context.Background()))
+	require.Empty(t, altSync.requests)
+
+	derivation.elSyncing = false
+	require.NoError(t, d.checkForGapInUnsafeQueue(context.Background()))
+	require.Equal(t, []rangeRequest{{unsafe, syncTarget}}, altSync.requests)
+}
@@ -0,0 +1,42 @@
+package rollup
+
+// ForkName is the name of a network upgrade of the rollup
+type ForkName string
+
+const (
+	Bedrock  ForkName = "bedrock"
+	Regolith ForkName = "regolith"
+)
+
+// ForkActivation is the activation time of a network upgrade.
+// Time is nil if the upgrade is not scheduled.
+type ForkActivation struct {
+	Name ForkName `json:"name"`
+	Time *uint64  `json:"time,omitempty"`
+}
+
+// IsActive returns true if the network upgrade is active at or past the given timestamp.
+func (f ForkActivation) IsActive(timestamp uint64) bool {
+	return f.Time != nil && timestamp >= *f.Time
+}
+
+// ForkSchedule returns the network upgrades of the rollup, in activation order.
+// Bedrock is active from the L2 genesis onwards.
+func (c *Config) ForkSchedule() []ForkActivation {
+	bedrockTime := c.Genesis.L2Time
+	return []ForkActivation{
+		{Name: Bedrock, Time: &bedrockTime},
+		{Name: Regolith, Time: c.RegolithTime},
+	}
+}
+
+// ActiveForks returns the network upgrades that are active at the given timestamp, in activation order.
+func (c *Config) ActiveForks(timestamp uint64) []ForkName {
+	var active []ForkName
+	for _, fork := range c.ForkSchedule() {
+		if fork.IsActive(timestamp) {
+			active = append(active, fork.Name)
+		}
+	}
+	return active
+}
@@ -0,0 +1,26 @@
+package rollup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveForks(t *testing.T) {
+	regolithTime := uint64(2000)
+	cfg := randConfig()
+	cfg.Genesis.L2Time = 1000
+	cfg.RegolithTime = &regolithTime
+
+	require.Empty(t, cfg.ActiveForks(999))
+	require.Equal(t, []ForkName{Bedrock}, cfg.ActiveForks(1000))
+	require.Equal(t, []ForkName{Bedrock}, cfg.ActiveForks(1999))
+	require.Equal(t, []ForkName{Bedrock, Regolith}, cfg.ActiveForks(2000))
+
+	cfg.RegolithTime = nil
+	require.Equal(t, []ForkName{Bedrock}, cfg.ActiveForks(2000))
+	schedule := cfg.ForkSchedule()
+	require.Len(t, schedule, 2)
+	require.Equal(t, Regolith, schedule[1].Name)
+	require.Nil(t, schedule[1].Time, "regolith is not scheduled")
+}
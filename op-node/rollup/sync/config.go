@@ -0,0 +1,43 @@
+package sync
+
+import "fmt"
+
+// Mode identifies how the L2 chain is synced to the tip of the chain.
+type Mode int
+
+const (
+	// CLSync is consensus-layer sync: the rollup node derives every block from L1,
+	// and inserts unsafe blocks one by one, fetching any missing unsafe blocks itself.
+	CLSync Mode = iota
+	// ELSync is execution-layer sync: the rollup node lets the execution engine sync (e.g. snap-sync)
+	// towards the unsafe head, and then resumes derivation from L1 on top of the synced chain.
+	ELSync
+)
+
+var Modes = []Mode{CLSync, ELSync}
+
+func (m Mode) String() string {
+	switch m {
+	case CLSync:
+		return "consensus-layer"
+	case ELSync:
+		return "execution-layer"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(m))
+	}
+}
+
+func (m *Mode) Set(value string) error {
+	for _, v := range Modes {
+		if v.String() == value {
+			*m = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sync mode: %q", value)
+}
+
+type Config struct {
+	// SyncMode is the mode the node syncs the L2 chain with.
+	SyncMode Mode
+}
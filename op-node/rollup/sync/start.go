@@ -247,3 +247,26 @@ func FindL2Heads(ctx context.Context, cfg *rollup.Config, l1 L1Chain, l2 L2Chain
 		}
 	}
 }
+
+// ELSyncAnchor walks back from the head of a chain that was synced by the execution engine,
+// to the block to mark as safe, and derive the chain from L1 on top of:
+// the first block of the epoch a full sequence window behind the epoch of the head.
+// FindL2Heads then starts derivation from before this block, so the synced blocks after it
+// are verified against the blocks derived from L1.
+func ELSyncAnchor(ctx context.Context, cfg *rollup.Config, l2 L2Chain, head eth.L2BlockRef) (eth.L2BlockRef, error) {
+	n := head
+	for n.Number > cfg.Genesis.L2.Number {
+		if n.SequenceNumber == 0 && n.L1Origin.Number+cfg.SeqWindowSize < head.L1Origin.Number {
+			return n, nil
+		}
+		parent, err := l2.L2BlockRefByHash(ctx, n.ParentHash)
+		if err != nil {
+			return eth.L2BlockRef{}, fmt.Errorf("failed to fetch L2 block by hash %v: %w", n.ParentHash, err)
+		}
+		n = parent
+	}
+	if n.Hash != cfg.Genesis.L2.Hash {
+		return eth.L2BlockRef{}, fmt.Errorf("%w L2: genesis: %s, got %s", WrongChainErr, cfg.Genesis.L2, n)
+	}
+	return n, nil
+}
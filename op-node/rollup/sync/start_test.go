@@ -292,3 +292,27 @@ func TestFindSyncStart(t *testing.T) {
 		t.Run(testCase.Name, testCase.Run)
 	}
 }
+
+func TestELSyncAnchor(t *testing.T) {
+	c := &syncStartTestCase{
+		L1:           "abcdefgh",
+		L2:           "ABCDEFGH",
+		NewL1:        "abcdefgh",
+		GenesisL1:    'a',
+		GenesisL2:    'A',
+		GenesisL1Num: 0,
+	}
+	chain, genesis := c.generateFakeL2(t)
+	head, err := chain.L2BlockRefByLabel(context.Background(), eth.Unsafe)
+	require.NoError(t, err)
+
+	cfg := &rollup.Config{Genesis: genesis, SeqWindowSize: 2}
+	anchor, err := ELSyncAnchor(context.Background(), cfg, chain, head)
+	require.NoError(t, err)
+	require.Equal(t, "E", string(refToRune(anchor.ID())), "anchor is a full sequence window behind the head")
+
+	cfg.SeqWindowSize = 10
+	anchor, err = ELSyncAnchor(context.Background(), cfg, chain, head)
+	require.NoError(t, err)
+	require.Equal(t, "A", string(refToRune(anchor.ID())), "anchor does not go past genesis")
+}
@@ -18,10 +18,12 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/ha"
 	"github.com/ethereum-optimism/optimism/op-node/node"
 	p2pcli "github.com/ethereum-optimism/optimism/op-node/p2p/cli"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 )
 
 // NewConfig creates a Config from the provided flags or environment variables.
@@ -30,7 +32,12 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		return nil, err
 	}
 
-	rollupConfig, err := NewRollupConfig(ctx)
+	chain, err := NewChainDefinition(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rollupConfig, err := newRollupConfig(ctx, chain)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +46,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 
 	driverConfig := NewDriverConfig(ctx)
 
-	p2pSignerSetup, err := p2pcli.LoadSignerSetup(ctx)
+	p2pSignerSetup, err := p2pcli.LoadSignerSetup(ctx, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load p2p signer: %w", err)
 	}
@@ -58,11 +65,17 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 
 	l2SyncEndpoint := NewL2SyncEndpointConfig(ctx)
 
+	haConfig, err := NewHAConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ha config: %w", err)
+	}
+
 	cfg := &node.Config{
 		L1:     l1Endpoint,
 		L2:     l2Endpoint,
 		L2Sync: l2SyncEndpoint,
 		Rollup: *rollupConfig,
+		Chain:  chain,
 		Driver: *driverConfig,
 		RPC: node.RPCConfig{
 			ListenAddr:  ctx.String(flags.RPCListenAddr.Name),
@@ -88,6 +101,9 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 			URL:     ctx.String(flags.HeartbeatURLFlag.Name),
 		},
 		ConfigPersistence: configPersistence,
+		Sync:              *NewSyncConfig(ctx),
+		SafeDBPath:        ctx.String(flags.SafeDBPath.Name),
+		HA:                *haConfig,
 	}
 
 	if err := cfg.LoadPersisted(log); err != nil {
@@ -108,6 +124,8 @@ func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 		RateLimit:        ctx.Float64(flags.L1RPCRateLimit.Name),
 		BatchSize:        ctx.Int(flags.L1RPCMaxBatchSize.Name),
 		HttpPollInterval: ctx.Duration(flags.L1HTTPPollInterval.Name),
+		DiskCachePath:    ctx.String(flags.L1DiskCache.Name),
+		DiskCacheSize:    ctx.Uint64(flags.L1DiskCacheSize.Name) * 1024 * 1024,
 	}
 }
 
@@ -168,14 +186,53 @@ func NewDriverConfig(ctx *cli.Context) *driver.Config {
 	}
 }
 
-func NewRollupConfig(ctx *cli.Context) (*rollup.Config, error) {
+func NewSyncConfig(ctx *cli.Context) *sync.Config {
+	return &sync.Config{
+		SyncMode: *ctx.Generic(flags.SyncModeFlag.Name).(*sync.Mode),
+	}
+}
+
+func NewHAConfig(ctx *cli.Context) (*ha.Config, error) {
+	peers, err := ha.ParsePeers(ctx.StringSlice(flags.HAPeersFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	return &ha.Config{
+		Enabled:             ctx.Bool(flags.HAEnabledFlag.Name),
+		ServerID:            ctx.String(flags.HAServerIDFlag.Name),
+		ListenAddr:          ctx.String(flags.HAListenAddrFlag.Name),
+		AdvertiseAddr:      ctx.String(flags.HAAdvertiseAddrFlag.Name),
+		StorageDir:          ctx.String(flags.HAStorageDirFlag.Name),
+		Bootstrap:           ctx.Bool(flags.HABootstrapFlag.Name),
+		Peers:               peers,
+		ElectionTimeout:     ctx.Duration(flags.HAElectionTimeoutFlag.Name),
+		CommitTimeout:       ctx.Duration(flags.HACommitTimeoutFlag.Name),
+		HealthCheckInterval: ctx.Duration(flags.HAHealthCheckIntervalFlag.Name),
+		UnsafeHeadMaxAge:    ctx.Duration(flags.HAUnsafeMaxAgeFlag.Name),
+	}, nil
+}
+
+// NewChainDefinition returns the definition of the selected network, or nil if no network is selected.
+func NewChainDefinition(ctx *cli.Context) (*chaincfg.ChainDefinition, error) {
 	network := ctx.String(flags.Network.Name)
-	if network != "" {
-		config, err := chaincfg.GetRollupConfig(network)
-		if err != nil {
-			return nil, err
-		}
+	if network == "" {
+		return nil, nil
+	}
+	return chaincfg.LoadChain(ctx.String(flags.NetworkRegistry.Name), network)
+}
+
+func NewRollupConfig(ctx *cli.Context) (*rollup.Config, error) {
+	chain, err := NewChainDefinition(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newRollupConfig(ctx, chain)
+}
 
+// newRollupConfig returns the rollup config of the chain, or loads it from the rollup config file if the chain is nil.
+func newRollupConfig(ctx *cli.Context, chain *chaincfg.ChainDefinition) (*rollup.Config, error) {
+	if chain != nil {
+		config := chain.Rollup
 		return &config, nil
 	}
 
@@ -5,6 +5,8 @@ import lru "github.com/hashicorp/golang-lru"
 type Metrics interface {
 	CacheAdd(label string, cacheSize int, evicted bool)
 	CacheGet(label string, hit bool)
+	// CacheSizeBytes meters the size in bytes of caches that are bounded by their size, instead of their number of entries
+	CacheSizeBytes(label string, sizeBytes uint64)
 }
 
 // LRUCache wraps hashicorp *lru.Cache and tracks cache metrics
@@ -0,0 +1,455 @@
+package sources
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/sources/caching"
+)
+
+const (
+	// keyPrefixHeader prefixes the RLP encoded block headers, keyed by block hash
+	keyPrefixHeader byte = 0
+	// keyPrefixTxs prefixes the RLP encoded transaction lists, keyed by block hash
+	keyPrefixTxs byte = 1
+	// keyPrefixReceipts prefixes the JSON encoded receipt lists, keyed by block hash.
+	// Receipts are encoded as JSON, since the consensus encoding drops the fields the derivation pipeline uses.
+	keyPrefixReceipts byte = 2
+	// keyPrefixBlock prefixes the entries keyed by block number and hash,
+	// each holding the total size of the data that is cached of that block. Blocks are evicted in this order.
+	keyPrefixBlock byte = 3
+	// keyFinalized holds the number of the last known finalized block
+	keyFinalized byte = 4
+)
+
+// cache labels, as reported in metrics
+const (
+	diskCacheLabel         = "disk"
+	diskCacheHeadersLabel  = "disk_headers"
+	diskCacheTxsLabel      = "disk_txs"
+	diskCacheReceiptsLabel = "disk_receipts"
+)
+
+var ErrDiskCacheClosed = errors.New("disk cache is closed")
+
+func diskCacheKey(prefix byte, hash common.Hash) []byte {
+	key := make([]byte, 1+common.HashLength)
+	key[0] = prefix
+	copy(key[1:], hash[:])
+	return key
+}
+
+func blockKey(id eth.BlockID) []byte {
+	key := make([]byte, 1+8+common.HashLength)
+	key[0] = keyPrefixBlock
+	binary.BigEndian.PutUint64(key[1:], id.Number)
+	copy(key[9:], id.Hash[:])
+	return key
+}
+
+// blocksRange bounds an iterator to the entries keyed by block number and hash
+func blocksRange() *pebble.IterOptions {
+	return &pebble.IterOptions{
+		LowerBound: []byte{keyPrefixBlock},
+		UpperBound: []byte{keyPrefixBlock + 1},
+	}
+}
+
+// DiskCache persists the headers, transactions and receipts of finalized blocks, keyed by block hash,
+// so they do not have to be fetched again from RPC after a restart.
+// Data of blocks that are not known to be finalized is never stored, so reorged data does not take up space.
+//
+// Cached data is verified against the block hash and the roots of the header when loaded:
+// an invalid entry is removed and reported as a cache miss.
+// The cache is bounded in size, and evicts the data of the oldest blocks first.
+type DiskCache struct {
+	// mu ensures the db is not closed while in use, and that the size accounting of updates does not interleave.
+	mu     sync.Mutex
+	log    log.Logger
+	m      caching.Metrics
+	db     *pebble.DB
+	closed bool
+
+	// maxSize is the maximum size of the cached data, in bytes
+	maxSize uint64
+	// size is the current size of the cached data, in bytes
+	size uint64
+	// blocks is the current number of blocks with cached data
+	blocks uint64
+
+	finalized uint64
+}
+
+// OpenDiskCache opens the disk cache at the given path, creating it if it does not exist yet.
+// The cache is bounded to maxSize bytes. Metrics are optional: no metrics will be tracked if m == nil.
+func OpenDiskCache(logger log.Logger, m caching.Metrics, path string, maxSize uint64) (*DiskCache, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open disk cache at %q: %w", path, err)
+	}
+	c := &DiskCache{log: logger, m: m, db: db, maxSize: maxSize}
+	if err := c.load(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	logger.Info("Opened disk cache", "path", path, "size", c.size, "max_size", maxSize, "finalized", c.finalized)
+	c.reportSize(false)
+	return c, nil
+}
+
+// load reads the size accounting and the last known finalized block from the db
+func (c *DiskCache) load() error {
+	iter := c.db.NewIter(blocksRange())
+	defer iter.Close()
+	for valid := iter.First(); valid; valid = iter.Next() {
+		if len(iter.Value()) != 8 {
+			return fmt.Errorf("invalid disk cache entry %x", iter.Key())
+		}
+		c.size += binary.BigEndian.Uint64(iter.Value())
+		c.blocks++
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to read disk cache size: %w", err)
+	}
+	val, closer, err := c.db.Get([]byte{keyFinalized})
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to read finalized block of disk cache: %w", err)
+	}
+	defer closer.Close()
+	if len(val) != 8 {
+		return fmt.Errorf("invalid finalized block entry in disk cache: %x", val)
+	}
+	c.finalized = binary.BigEndian.Uint64(val)
+	return nil
+}
+
+// Finalized returns the number of the last known finalized block.
+func (c *DiskCache) Finalized() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.finalized
+}
+
+// SetFinalized updates the last known finalized block. Blocks up to and including it may be stored.
+func (c *DiskCache) SetFinalized(num uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || num <= c.finalized {
+		return
+	}
+	c.finalized = num
+	if err := c.db.Set([]byte{keyFinalized}, binary.BigEndian.AppendUint64(nil, num), pebble.NoSync); err != nil {
+		c.log.Warn("Failed to persist finalized block of disk cache", "num", num, "err", err)
+	}
+}
+
+// Header returns the cached header of the given block, if any.
+func (c *DiskCache) Header(hash common.Hash) (eth.BlockInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	header, err := c.header(hash)
+	c.reportGet(diskCacheHeadersLabel, hash, err)
+	if err != nil {
+		return nil, false
+	}
+	return eth.HeaderBlockInfo(header), true
+}
+
+// HeaderAndTxs returns the cached header and transactions of the given block, if any.
+func (c *DiskCache) HeaderAndTxs(hash common.Hash) (eth.BlockInfo, types.Transactions, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	header, txs, err := c.headerAndTxs(hash)
+	c.reportGet(diskCacheTxsLabel, hash, err)
+	if err != nil {
+		return nil, nil, false
+	}
+	return eth.HeaderBlockInfo(header), txs, true
+}
+
+// Receipts returns the cached header and receipts of the given block, if any.
+func (c *DiskCache) Receipts(hash common.Hash) (eth.BlockInfo, types.Receipts, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	header, receipts, err := c.receipts(hash)
+	c.reportGet(diskCacheReceiptsLabel, hash, err)
+	if err != nil {
+		return nil, nil, false
+	}
+	return eth.HeaderBlockInfo(header), receipts, true
+}
+
+// reportGet meters a lookup, and removes the data of the block if the lookup found an invalid entry
+func (c *DiskCache) reportGet(label string, hash common.Hash, err error) {
+	if c.m != nil {
+		c.m.CacheGet(label, err == nil)
+	}
+	if err == nil || errors.Is(err, pebble.ErrNotFound) || errors.Is(err, ErrDiskCacheClosed) {
+		return
+	}
+	c.log.Warn("Removing invalid entry from disk cache", "hash", hash, "kind", label, "err", err)
+	if err := c.remove(hash); err != nil {
+		c.log.Error("Failed to remove invalid entry from disk cache", "hash", hash, "err", err)
+	}
+}
+
+func (c *DiskCache) get(key []byte) ([]byte, error) {
+	if c.closed {
+		return nil, ErrDiskCacheClosed
+	}
+	val, closer, err := c.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return common.CopyBytes(val), nil
+}
+
+func (c *DiskCache) header(hash common.Hash) (*types.Header, error) {
+	data, err := c.get(diskCacheKey(keyPrefixHeader, hash))
+	if err != nil {
+		return nil, err
+	}
+	var header types.Header
+	if err := rlp.DecodeBytes(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode header: %w", err)
+	}
+	if computed := header.Hash(); computed != hash {
+		return nil, fmt.Errorf("cached header has hash %s", computed)
+	}
+	return &header, nil
+}
+
+func (c *DiskCache) headerAndTxs(hash common.Hash) (*types.Header, types.Transactions, error) {
+	header, err := c.header(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := c.get(diskCacheKey(keyPrefixTxs, hash))
+	if err != nil {
+		return nil, nil, err
+	}
+	var txs types.Transactions
+	if err := rlp.DecodeBytes(data, &txs); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode transactions: %w", err)
+	}
+	if computed := types.DeriveSha(txs, trie.NewStackTrie(nil)); computed != header.TxHash {
+		return nil, nil, fmt.Errorf("cached transactions have root %s, but header has transactions root %s", computed, header.TxHash)
+	}
+	return header, txs, nil
+}
+
+func (c *DiskCache) receipts(hash common.Hash) (*types.Header, types.Receipts, error) {
+	header, txs, err := c.headerAndTxs(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := c.get(diskCacheKey(keyPrefixReceipts, hash))
+	if err != nil {
+		return nil, nil, err
+	}
+	var receipts types.Receipts
+	if err := json.Unmarshal(data, &receipts); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode receipts: %w", err)
+	}
+	id := eth.BlockID{Hash: hash, Number: header.Number.Uint64()}
+	if err := validateReceipts(id, header.ReceiptHash, eth.TransactionsToHashes(txs), receipts); err != nil {
+		return nil, nil, fmt.Errorf("invalid cached receipts: %w", err)
+	}
+	return header, receipts, nil
+}
+
+// AddHeader stores the header of the block, if the block is finalized.
+func (c *DiskCache) AddHeader(info eth.BlockInfo) {
+	c.add(info, nil, nil)
+}
+
+// AddHeaderAndTxs stores the header and transactions of the block, if the block is finalized.
+func (c *DiskCache) AddHeaderAndTxs(info eth.BlockInfo, txs types.Transactions) {
+	c.add(info, txs, nil)
+}
+
+// AddReceipts stores the header, transactions and receipts of the block, if the block is finalized.
+func (c *DiskCache) AddReceipts(info eth.BlockInfo, txs types.Transactions, receipts types.Receipts) {
+	c.add(info, txs, receipts)
+}
+
+// add stores the given data of a block. The transactions and receipts are optional.
+// Errors are logged and not returned: the cache is an optimization, and the data can be fetched again.
+func (c *DiskCache) add(info eth.BlockInfo, txs types.Transactions, receipts types.Receipts) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || info.NumberU64() > c.finalized {
+		return
+	}
+	if err := c.put(info, txs, receipts); err != nil {
+		c.log.Warn("Failed to add block to disk cache", "block", eth.ToBlockID(info), "err", err)
+		return
+	}
+	evicted, err := c.evict()
+	if err != nil {
+		c.log.Warn("Failed to evict blocks from disk cache", "err", err)
+	}
+	c.reportSize(evicted)
+}
+
+func (c *DiskCache) put(info eth.BlockInfo, txs types.Transactions, receipts types.Receipts) error {
+	id := eth.ToBlockID(info)
+	entries := make(map[byte][]byte)
+	headerRLP, err := info.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("failed to encode header: %w", err)
+	}
+	entries[keyPrefixHeader] = headerRLP
+	if txs != nil {
+		if entries[keyPrefixTxs], err = rlp.EncodeToBytes(txs); err != nil {
+			return fmt.Errorf("failed to encode transactions: %w", err)
+		}
+	}
+	if receipts != nil {
+		if entries[keyPrefixReceipts], err = json.Marshal(receipts); err != nil {
+			return fmt.Errorf("failed to encode receipts: %w", err)
+		}
+	}
+
+	blockSize, err := c.blockSize(id)
+	if err != nil {
+		return err
+	}
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	added := uint64(0)
+	for prefix, val := range entries {
+		key := diskCacheKey(prefix, id.Hash)
+		if _, closer, err := c.db.Get(key); err == nil {
+			_ = closer.Close()
+			continue // already cached
+		} else if !errors.Is(err, pebble.ErrNotFound) {
+			return err
+		}
+		if err := batch.Set(key, val, nil); err != nil {
+			return err
+		}
+		added += uint64(len(key) + len(val))
+	}
+	if added == 0 {
+		return nil
+	}
+	if blockSize == 0 {
+		added += uint64(len(blockKey(id)) + 8)
+	}
+	if err := batch.Set(blockKey(id), binary.BigEndian.AppendUint64(nil, blockSize+added), nil); err != nil {
+		return err
+	}
+	if err := batch.Commit(pebble.NoSync); err != nil {
+		return err
+	}
+	c.size += added
+	if blockSize == 0 {
+		c.blocks++
+	}
+	return nil
+}
+
+// blockSize returns the size of the data that is cached of the given block, or 0 if nothing is cached
+func (c *DiskCache) blockSize(id eth.BlockID) (uint64, error) {
+	val, closer, err := c.db.Get(blockKey(id))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+	if len(val) != 8 {
+		return 0, fmt.Errorf("invalid disk cache entry of block %s", id)
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+// evict removes the data of the oldest blocks, until the cache fits its size bound
+func (c *DiskCache) evict() (evicted bool, err error) {
+	if c.size <= c.maxSize {
+		return false, nil
+	}
+	iter := c.db.NewIter(blocksRange())
+	defer iter.Close()
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	size, blocks := c.size, c.blocks
+	for valid := iter.First(); valid && size > c.maxSize; valid = iter.Next() {
+		key := iter.Key()
+		if len(key) != len(blockKey(eth.BlockID{})) || len(iter.Value()) != 8 {
+			return false, fmt.Errorf("invalid disk cache entry %x", key)
+		}
+		hash := common.BytesToHash(key[9:])
+		for _, prefix := range []byte{keyPrefixHeader, keyPrefixTxs, keyPrefixReceipts} {
+			if err := batch.Delete(diskCacheKey(prefix, hash), nil); err != nil {
+				return false, err
+			}
+		}
+		if err := batch.Delete(key, nil); err != nil {
+			return false, err
+		}
+		size -= min(size, binary.BigEndian.Uint64(iter.Value()))
+		blocks--
+	}
+	if err := iter.Error(); err != nil {
+		return false, err
+	}
+	if err := batch.Commit(pebble.NoSync); err != nil {
+		return false, err
+	}
+	c.size, c.blocks = size, blocks
+	return true, nil
+}
+
+// remove deletes all cached data of the given block
+func (c *DiskCache) remove(hash common.Hash) error {
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	for _, prefix := range []byte{keyPrefixHeader, keyPrefixTxs, keyPrefixReceipts} {
+		if err := batch.Delete(diskCacheKey(prefix, hash), nil); err != nil {
+			return err
+		}
+	}
+	// The block entry, with the size accounting, can only be found by number.
+	// Without a valid header the number is unknown, so the entry is left to be evicted.
+	return batch.Commit(pebble.NoSync)
+}
+
+func (c *DiskCache) reportSize(evicted bool) {
+	if c.m != nil {
+		c.m.CacheAdd(diskCacheLabel, int(c.blocks), evicted)
+		c.m.CacheSizeBytes(diskCacheLabel, c.size)
+	}
+}
+
+func (c *DiskCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.db.Close()
+}
+
+func min(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
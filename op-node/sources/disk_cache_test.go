@@ -0,0 +1,195 @@
+package sources
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-node/testutils"
+)
+
+func TestDiskCache(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	dir := t.TempDir()
+	rng := rand.New(rand.NewSource(1234))
+	block, receipts := testutils.RandomBlock(rng, 4)
+	info := eth.HeaderBlockInfo(block.Header())
+
+	c, err := OpenDiskCache(logger, nil, dir, 1<<20)
+	require.NoError(t, err)
+
+	// not finalized yet, so not stored
+	c.AddReceipts(info, block.Transactions(), receipts)
+	_, ok := c.Header(block.Hash())
+	require.False(t, ok)
+
+	c.SetFinalized(block.NumberU64())
+	c.AddHeader(info)
+	_, _, ok = c.HeaderAndTxs(block.Hash())
+	require.False(t, ok, "only the header is stored")
+	c.AddReceipts(info, block.Transactions(), receipts)
+	require.NoError(t, c.Close())
+
+	// the data persists across restarts
+	c, err = OpenDiskCache(logger, nil, dir, 1<<20)
+	require.NoError(t, err)
+	require.Equal(t, block.NumberU64(), c.Finalized())
+	gotInfo, ok := c.Header(block.Hash())
+	require.True(t, ok)
+	require.Equal(t, block.Hash(), gotInfo.Hash())
+	_, txs, ok := c.HeaderAndTxs(block.Hash())
+	require.True(t, ok)
+	require.Equal(t, block.Transactions().Len(), txs.Len())
+	for i, tx := range txs {
+		require.Equal(t, block.Transactions()[i].Hash(), tx.Hash())
+	}
+	gotInfo, gotReceipts, ok := c.Receipts(block.Hash())
+	require.True(t, ok)
+	require.Equal(t, block.ReceiptHash(), gotInfo.ReceiptHash())
+	require.Len(t, gotReceipts, len(receipts))
+	for i, r := range gotReceipts {
+		require.Equal(t, receipts[i].TxHash, r.TxHash)
+		require.Equal(t, receipts[i].Logs, r.Logs)
+	}
+	require.NoError(t, c.Close())
+	_, ok = c.Header(block.Hash())
+	require.False(t, ok, "closed cache has no data")
+}
+
+func TestDiskCacheInvalidEntry(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	dir := t.TempDir()
+	rng := rand.New(rand.NewSource(1234))
+	block, receipts := testutils.RandomBlock(rng, 4)
+	other, _ := testutils.RandomBlock(rng, 3)
+
+	c, err := OpenDiskCache(logger, nil, dir, 1<<20)
+	require.NoError(t, err)
+	c.SetFinalized(block.NumberU64())
+	c.AddReceipts(eth.HeaderBlockInfo(block.Header()), block.Transactions(), receipts)
+
+	// replace the transactions of the block with those of another block
+	otherTxs, err := rlp.EncodeToBytes(other.Transactions())
+	require.NoError(t, err)
+	require.NoError(t, c.db.Set(diskCacheKey(keyPrefixTxs, block.Hash()), otherTxs, pebble.Sync))
+
+	_, ok := c.Header(block.Hash())
+	require.True(t, ok, "header is still valid")
+	_, _, ok = c.Receipts(block.Hash())
+	require.False(t, ok, "transactions do not match the header")
+	_, ok = c.Header(block.Hash())
+	require.False(t, ok, "invalid block data is removed")
+	require.NoError(t, c.Close())
+}
+
+func TestDiskCacheEviction(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	rng := rand.New(rand.NewSource(1234))
+	var blocks []*types.Block
+	for i := 0; i < 20; i++ {
+		block, _ := testutils.RandomBlock(rng, 2)
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].NumberU64() < blocks[j].NumberU64()
+	})
+
+	// fits roughly 10 blocks
+	blockSize := uint64(0)
+	for _, tx := range blocks[0].Transactions() {
+		blockSize += tx.Size()
+	}
+	blockSize += uint64(blocks[0].Header().Size())
+	maxSize := uint64(float64(blockSize) * 10.5)
+
+	m := &diskCacheMetrics{}
+	c, err := OpenDiskCache(logger, m, t.TempDir(), maxSize)
+	require.NoError(t, err)
+	defer c.Close()
+	c.SetFinalized(blocks[len(blocks)-1].NumberU64())
+	// add the blocks out of order, the oldest are evicted first regardless
+	for _, i := range rng.Perm(len(blocks)) {
+		c.AddHeaderAndTxs(eth.HeaderBlockInfo(blocks[i].Header()), blocks[i].Transactions())
+		require.LessOrEqual(t, c.size, maxSize)
+	}
+	newest := blocks[len(blocks)-1]
+	_, _, ok := c.HeaderAndTxs(newest.Hash())
+	require.True(t, ok, "newest block is kept")
+	oldest := blocks[0]
+	_, ok = c.Header(oldest.Hash())
+	require.False(t, ok, "oldest block is evicted")
+	kept := 0
+	for _, b := range blocks {
+		if _, ok := c.Header(b.Hash()); ok {
+			kept++
+		}
+	}
+	require.Greater(t, kept, 5)
+	require.Less(t, kept, 15)
+	require.Equal(t, kept, m.entries, "number of cached blocks is reported as the cache size")
+	require.Equal(t, c.size, m.sizeBytes, "size of the cached data is reported in bytes")
+	require.True(t, m.evicted)
+}
+
+type diskCacheMetrics struct {
+	entries   int
+	sizeBytes uint64
+	evicted   bool
+}
+
+func (m *diskCacheMetrics) CacheAdd(label string, cacheSize int, evicted bool) {
+	m.entries = cacheSize
+	m.evicted = m.evicted || evicted
+}
+
+func (m *diskCacheMetrics) CacheGet(label string, hit bool) {}
+
+func (m *diskCacheMetrics) CacheSizeBytes(label string, sizeBytes uint64) {
+	m.sizeBytes = sizeBytes
+}
+
+func TestEthClientDiskCache(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	dir := t.TempDir()
+	rng := rand.New(rand.NewSource(1234))
+	block, receipts := testutils.RandomBlock(rng, 4)
+
+	c, err := OpenDiskCache(logger, nil, dir, 1<<20)
+	require.NoError(t, err)
+	c.SetFinalized(block.NumberU64())
+	c.AddReceipts(eth.HeaderBlockInfo(block.Header()), block.Transactions(), receipts)
+	require.NoError(t, c.Close())
+
+	// The RPC has no expectations: any RPC call fails the test
+	m := new(mockRPC)
+	cfg := *testEthClientConfig
+	cfg.DiskCachePath = dir
+	cfg.DiskCacheSize = 1 << 20
+	s, err := NewEthClient(m, logger, nil, &cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	info, err := s.InfoByHash(ctx, block.Hash())
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), info.Hash())
+	_, txs, err := s.InfoAndTxsByHash(ctx, block.Hash())
+	require.NoError(t, err)
+	require.Equal(t, block.Transactions().Len(), txs.Len())
+	_, gotReceipts, err := s.FetchReceipts(ctx, block.Hash())
+	require.NoError(t, err)
+	require.Len(t, gotReceipts, len(receipts))
+	m.AssertExpectations(t)
+
+	m.On("Close").Once()
+	s.Close()
+	m.AssertExpectations(t)
+}
@@ -44,6 +44,12 @@ type EthClientConfig struct {
 	// Number of payloads to cache
 	PayloadsCacheSize int
 
+	// DiskCachePath is the directory of the disk cache of finalized block headers, transactions and receipts,
+	// which persists across restarts. The disk cache is disabled if empty.
+	DiskCachePath string
+	// DiskCacheSize is the maximum size of the disk cache, in bytes
+	DiskCacheSize uint64
+
 	// If the RPC is untrusted, then we should not use cached information from responses,
 	// and instead verify against the block-hash.
 	// Of real L1 blocks no deposits can be missed/faked, no batches can be missed/faked,
@@ -77,6 +83,9 @@ func (c *EthClientConfig) Check() error {
 	if c.PayloadsCacheSize < 0 {
 		return fmt.Errorf("invalid payloads cache size: %d", c.PayloadsCacheSize)
 	}
+	if c.DiskCachePath != "" && c.DiskCacheSize == 0 {
+		return fmt.Errorf("disk cache at %q is enabled but has no size", c.DiskCachePath)
+	}
 	if c.MaxConcurrentRequests < 1 {
 		return fmt.Errorf("expected at least 1 concurrent request, but max is %d", c.MaxConcurrentRequests)
 	}
@@ -120,6 +129,10 @@ type EthClient struct {
 	// common.Hash -> *eth.ExecutionPayload
 	payloadsCache *caching.LRUCache
 
+	// diskCache persists finalized block data across restarts, may be nil if disabled.
+	// The in-memory caches are checked first, and filled with the data that is loaded from disk.
+	diskCache *DiskCache
+
 	// availableReceiptMethods tracks which receipt methods can be used for fetching receipts
 	// This may be modified concurrently, but we don't lock since it's a single
 	// uint64 that's not critical (fine to miss or mix up a modification)
@@ -164,6 +177,14 @@ func NewEthClient(client client.RPC, log log.Logger, metrics caching.Metrics, co
 	if err := config.Check(); err != nil {
 		return nil, fmt.Errorf("bad config, cannot create L1 source: %w", err)
 	}
+	var diskCache *DiskCache
+	if config.DiskCachePath != "" {
+		var err error
+		diskCache, err = OpenDiskCache(log, metrics, config.DiskCachePath, config.DiskCacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
 	client = LimitRPC(client, config.MaxConcurrentRequests)
 	return &EthClient{
 		client:                  client,
@@ -176,6 +197,7 @@ func NewEthClient(client client.RPC, log log.Logger, metrics caching.Metrics, co
 		transactionsCache:       caching.NewLRUCache(metrics, "txs", config.TransactionsCacheSize),
 		headersCache:            caching.NewLRUCache(metrics, "headers", config.HeadersCacheSize),
 		payloadsCache:           caching.NewLRUCache(metrics, "payloads", config.PayloadsCacheSize),
+		diskCache:               diskCache,
 		availableReceiptMethods: AvailableReceiptsFetchingMethods(config.RPCProviderKind),
 		lastMethodsReset:        time.Now(),
 		methodResetDuration:     config.MethodResetDuration,
@@ -236,6 +258,12 @@ func (s *EthClient) headerCall(ctx context.Context, method string, id rpcBlockID
 		return nil, fmt.Errorf("fetched block header does not match requested ID: %w", err)
 	}
 	s.headersCache.Add(info.Hash(), info)
+	if s.diskCache != nil {
+		if id == eth.BlockLabel(eth.Finalized) {
+			s.diskCache.SetFinalized(info.NumberU64())
+		}
+		s.diskCache.AddHeader(info)
+	}
 	return info, nil
 }
 
@@ -257,6 +285,12 @@ func (s *EthClient) blockCall(ctx context.Context, method string, id rpcBlockID)
 	}
 	s.headersCache.Add(info.Hash(), info)
 	s.transactionsCache.Add(info.Hash(), txs)
+	if s.diskCache != nil {
+		if id == eth.BlockLabel(eth.Finalized) {
+			s.diskCache.SetFinalized(info.NumberU64())
+		}
+		s.diskCache.AddHeaderAndTxs(info, txs)
+	}
 	return info, txs, nil
 }
 
@@ -294,6 +328,12 @@ func (s *EthClient) InfoByHash(ctx context.Context, hash common.Hash) (eth.Block
 	if header, ok := s.headersCache.Get(hash); ok {
 		return header.(eth.BlockInfo), nil
 	}
+	if s.diskCache != nil {
+		if header, ok := s.diskCache.Header(hash); ok {
+			s.headersCache.Add(hash, header)
+			return header, nil
+		}
+	}
 	return s.headerCall(ctx, "eth_getBlockByHash", hashID(hash))
 }
 
@@ -313,6 +353,13 @@ func (s *EthClient) InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth
 			return header.(eth.BlockInfo), txs.(types.Transactions), nil
 		}
 	}
+	if s.diskCache != nil {
+		if header, txs, ok := s.diskCache.HeaderAndTxs(hash); ok {
+			s.headersCache.Add(hash, header)
+			s.transactionsCache.Add(hash, txs)
+			return header, txs, nil
+		}
+	}
 	return s.blockCall(ctx, "eth_getBlockByHash", hashID(hash))
 }
 
@@ -345,17 +392,23 @@ func (s *EthClient) PayloadByLabel(ctx context.Context, label eth.BlockLabel) (*
 // It verifies the receipt hash in the block header against the receipt hash of the fetched receipts
 // to ensure that the execution engine did not fail to return any receipts.
 func (s *EthClient) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
-	info, txs, err := s.InfoAndTxsByHash(ctx, blockHash)
-	if err != nil {
-		return nil, nil, err
-	}
 	// Try to reuse the receipts fetcher because is caches the results of intermediate calls. This means
 	// that if just one of many calls fail, we only retry the failed call rather than all of the calls.
 	// The underlying fetcher uses the receipts hash to verify receipt integrity.
 	var job *receiptsFetchingJob
 	if v, ok := s.receiptsCache.Get(blockHash); ok {
 		job = v.(*receiptsFetchingJob)
-	} else {
+	} else if s.diskCache != nil {
+		// The disk cache verifies the receipts against the receipts hash when loading them.
+		if info, receipts, ok := s.diskCache.Receipts(blockHash); ok {
+			return info, receipts, nil
+		}
+	}
+	info, txs, err := s.InfoAndTxsByHash(ctx, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job == nil {
 		txHashes := eth.TransactionsToHashes(txs)
 		job = NewReceiptsFetchingJob(s, s.client, s.maxBatchSize, eth.ToBlockID(info), info.ReceiptHash(), txHashes)
 		s.receiptsCache.Add(blockHash, job)
@@ -364,6 +417,9 @@ func (s *EthClient) FetchReceipts(ctx context.Context, blockHash common.Hash) (e
 	if err != nil {
 		return nil, nil, err
 	}
+	if s.diskCache != nil {
+		s.diskCache.AddReceipts(info, txs, receipts)
+	}
 
 	return info, receipts, nil
 }
@@ -425,4 +481,9 @@ func (s *EthClient) ReadStorageAt(ctx context.Context, address common.Address, s
 
 func (s *EthClient) Close() {
 	s.client.Close()
+	if s.diskCache != nil {
+		if err := s.diskCache.Close(); err != nil {
+			s.log.Error("Failed to close disk cache", "err", err)
+		}
+	}
 }
@@ -13,11 +13,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-node/client"
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
 )
 
 type mockRPC struct {
@@ -177,3 +179,30 @@ func TestEthClient_WrongInfoByHash(t *testing.T) {
 	require.Error(t, err, "cannot accept the wrong block")
 	m.Mock.AssertExpectations(t)
 }
+
+func TestEthClient_ReplayFixture(t *testing.T) {
+	// The fixture is an L1 block with a single transaction, recorded from an action test with OP_E2E_RECORD_RPC_DIR set.
+	fixture, err := client.LoadRPCFixture("testdata/l1_block_with_tx.json")
+	require.NoError(t, err)
+	m, err := client.NewReplayRPC(fixture)
+	require.NoError(t, err)
+	ctx := context.Background()
+	s, err := NewL1Client(m, testlog.Logger(t, log.LvlError), nil, L1ClientDefaultConfig(&rollup.Config{SeqWindowSize: 10}, false, RPCKindBasic))
+	require.NoError(t, err)
+
+	ref, err := s.L1BlockRefByNumber(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x175e705cddb71c168c94f1386299cb23c7287641f6c74963e0672c588ed448ab"), ref.Hash)
+	require.Equal(t, uint64(1), ref.Number)
+
+	// The receipts are checked against the receipts root of the block
+	info, receipts, err := s.FetchReceipts(ctx, ref.Hash)
+	require.NoError(t, err)
+	require.Equal(t, ref.Hash, info.Hash())
+	require.Len(t, receipts, 1)
+	require.Equal(t, common.HexToHash("0x82be26b519833e214e0d5c9bcb1ffb66e44f0a3901413e01a020713baf58aa25"), receipts[0].TxHash)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipts[0].Status)
+
+	_, err = s.L1BlockRefByNumber(ctx, 2)
+	require.ErrorIs(t, err, client.ErrNotRecorded)
+}
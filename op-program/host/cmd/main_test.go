@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"math/big"
 	"os"
 	"strconv"
 	"testing"
@@ -119,6 +120,13 @@ func TestL2Genesis(t *testing.T) {
 		cfg := configForArgs(t, replaceRequiredArg("--network", "goerli"))
 		require.Equal(t, config.OPGoerliChainConfig, cfg.L2ChainConfig)
 	})
+
+	t.Run("FromNetworkRegistry", func(t *testing.T) {
+		registry := writeNetworkRegistry(t)
+		cfg := configForArgs(t, append(replaceRequiredArg("--network", "custom"), "--network.registry", registry))
+		require.Equal(t, l2GenesisConfig, cfg.L2ChainConfig)
+		require.Equal(t, big.NewInt(1234), cfg.Rollup.L2ChainID)
+	})
 }
 
 func TestL2Head(t *testing.T) {
@@ -321,6 +329,21 @@ func writeValidGenesis(t *testing.T) string {
 	return genesisFile
 }
 
+// writeNetworkRegistry writes a registry with a "custom" chain, that references its L2 genesis
+func writeNetworkRegistry(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(dir+"/custom", 0777))
+	j, err := json.Marshal(l2Genesis)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dir+"/custom/genesis.json", j, 0666))
+	rollupCfg := chaincfg.Goerli
+	rollupCfg.L2ChainID = big.NewInt(1234)
+	j, err = json.Marshal(chaincfg.ChainDefinition{Rollup: rollupCfg, L2Genesis: "genesis.json"})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dir+"/custom/chain.json", j, 0666))
+	return dir
+}
+
 func writeValidRollupConfig(t *testing.T) string {
 	dir := t.TempDir()
 	j, err := json.Marshal(chaincfg.Goerli)
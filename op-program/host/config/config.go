@@ -7,6 +7,7 @@ import (
 	"os"
 
 	opnode "github.com/ethereum-optimism/optimism/op-node"
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/sources"
 	"github.com/ethereum-optimism/optimism/op-program/host/flags"
@@ -137,9 +138,18 @@ func NewConfigFromCLI(ctx *cli.Context) (*Config, error) {
 		networkName := ctx.String(flags.Network.Name)
 		l2ChainConfig = L2ChainConfigsByName[networkName]
 		if l2ChainConfig == nil {
-			return nil, fmt.Errorf("flag %s is required for network %s", flags.L2GenesisPath.Name, networkName)
+			// Chains of the registry may reference their L2 genesis
+			chain, err := chaincfg.LoadChain(ctx.String(flags.NetworkRegistry.Name), networkName)
+			if err != nil {
+				return nil, err
+			}
+			if chain.L2Genesis == "" {
+				return nil, fmt.Errorf("flag %s is required for network %s", flags.L2GenesisPath.Name, networkName)
+			}
+			l2GenesisPath = chain.L2Genesis
 		}
-	} else {
+	}
+	if l2GenesisPath != "" {
 		l2ChainConfig, err = loadChainConfigFromGenesis(l2GenesisPath)
 	}
 	if err != nil {
@@ -27,9 +27,14 @@ var (
 	}
 	Network = &cli.StringFlag{
 		Name:    "network",
-		Usage:   fmt.Sprintf("Predefined network selection. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
+		Usage:   fmt.Sprintf("Predefined network selection, or a chain of the --network.registry directory. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
 		EnvVars: prefixEnvVars("NETWORK"),
 	}
+	NetworkRegistry = &cli.StringFlag{
+		Name:    "network.registry",
+		Usage:   "Directory with a sub-directory per chain, holding its chain.json definition, to select with --network in addition to the predefined networks",
+		EnvVars: prefixEnvVars("NETWORK_REGISTRY"),
+	}
 	DataDir = &cli.StringFlag{
 		Name:    "datadir",
 		Usage:   "Directory to use for preimage data storage. Default uses in-memory storage",
@@ -109,6 +114,7 @@ var requiredFlags = []cli.Flag{
 var programFlags = []cli.Flag{
 	RollupConfig,
 	Network,
+	NetworkRegistry,
 	DataDir,
 	L2NodeAddr,
 	L2GenesisPath,
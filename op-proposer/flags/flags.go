@@ -2,10 +2,13 @@ package flags
 
 import (
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
@@ -32,13 +35,40 @@ var (
 		Usage:   "HTTP provider URL for the rollup node",
 		EnvVars: prefixEnvVars("ROLLUP_RPC"),
 	}
+
+	// Optional flags
 	L2OOAddressFlag = &cli.StringFlag{
 		Name:    "l2oo-address",
-		Usage:   "Address of the L2OutputOracle contract",
+		Usage:   "Address of the L2OutputOracle contract. Exactly one of l2oo-address and game-factory-address must be set.",
 		EnvVars: prefixEnvVars("L2OO_ADDRESS"),
 	}
-
-	// Optional flags
+	DisputeGameFactoryAddressFlag = &cli.StringFlag{
+		Name:    "game-factory-address",
+		Usage:   "Address of the DisputeGameFactory contract. When set, outputs are proposed by creating dispute games instead of through the L2OutputOracle.",
+		EnvVars: prefixEnvVars("GAME_FACTORY_ADDRESS"),
+	}
+	ProposalIntervalFlag = &cli.DurationFlag{
+		Name:    "proposal-interval",
+		Usage:   "Interval between proposals when creating dispute games through the DisputeGameFactory",
+		EnvVars: prefixEnvVars("PROPOSAL_INTERVAL"),
+	}
+	DisputeGameTypeFlag = &cli.UintFlag{
+		Name:    "game-type",
+		Usage:   "Dispute game type to create when proposing outputs through the DisputeGameFactory",
+		Value:   0,
+		EnvVars: prefixEnvVars("GAME_TYPE"),
+	}
+	ProposalBondFlag = &cli.GenericFlag{
+		Name:    "proposal-bond",
+		Usage:   "Bond in wei posted with each dispute game created through the DisputeGameFactory",
+		Value:   new(BigValue),
+		EnvVars: prefixEnvVars("PROPOSAL_BOND"),
+	}
+	DisputeGameFactoryStartBlockFlag = &cli.Uint64Flag{
+		Name:    "game-factory-start-block",
+		Usage:   "L1 block to search for the dispute games created by the proposer from on startup. Defaults to the deployment block of the DisputeGameFactory, which is searched for in the historical state of L1 and so requires an L1 archive node.",
+		EnvVars: prefixEnvVars("GAME_FACTORY_START_BLOCK"),
+	}
 	PollIntervalFlag = &cli.DurationFlag{
 		Name:    "poll-interval",
 		Usage:   "How frequently to poll L2 for new blocks",
@@ -50,6 +80,16 @@ var (
 		Usage:   "Allow the proposer to submit proposals for L2 blocks derived from non-finalized L1 blocks.",
 		EnvVars: prefixEnvVars("ALLOW_NON_FINALIZED"),
 	}
+	NetworkFlag = &cli.StringFlag{
+		Name:    "network",
+		Usage:   fmt.Sprintf("Network the proposer is running on, predefined or a chain of the --network.registry directory. It is cross-checked against L1 and the rollup node at startup, and provides the L2OutputOracle address if l2oo-address and game-factory-address are not set. Available networks: %s", strings.Join(chaincfg.AvailableNetworks(), ", ")),
+		EnvVars: prefixEnvVars("NETWORK"),
+	}
+	NetworkRegistryFlag = &cli.StringFlag{
+		Name:    "network.registry",
+		Usage:   "Directory with a sub-directory per chain, holding its chain.json definition, to select with --network in addition to the predefined networks",
+		EnvVars: prefixEnvVars("NETWORK_REGISTRY"),
+	}
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
 )
@@ -57,12 +97,19 @@ var (
 var requiredFlags = []cli.Flag{
 	L1EthRpcFlag,
 	RollupRpcFlag,
-	L2OOAddressFlag,
 }
 
 var optionalFlags = []cli.Flag{
+	L2OOAddressFlag,
+	DisputeGameFactoryAddressFlag,
+	ProposalIntervalFlag,
+	DisputeGameTypeFlag,
+	ProposalBondFlag,
+	DisputeGameFactoryStartBlockFlag,
 	PollIntervalFlag,
 	AllowNonFinalizedFlag,
+	NetworkFlag,
+	NetworkRegistryFlag,
 	L2OutputHDPathFlag,
 }
 
@@ -87,3 +134,17 @@ func CheckRequired(ctx *cli.Context) error {
 	}
 	return nil
 }
+
+// BigValue is a cli.Generic flag value for integers that may not fit in a uint64, like wei amounts
+type BigValue big.Int
+
+func (b *BigValue) Set(value string) error {
+	if _, ok := (*big.Int)(b).SetString(value, 10); !ok {
+		return fmt.Errorf("invalid integer %q", value)
+	}
+	return nil
+}
+
+func (b *BigValue) String() string {
+	return (*big.Int)(b).String()
+}
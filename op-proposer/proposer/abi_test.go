@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
@@ -73,3 +74,53 @@ func TestManualABIPacking(t *testing.T) {
 
 	require.Equal(t, txData, tx.Data())
 }
+
+// TestManualDGFABIPacking ensures that the manual ABI packing of the DisputeGameFactory create call
+// is the same as going through the bound contract.
+func TestManualDGFABIPacking(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1337))
+	require.NoError(t, err)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{opts.From: {Balance: big.NewInt(params.Ether)}}, 50_000_000)
+	_, _, contract, err := bindings.DeployDisputeGameFactory(opts, backend)
+	require.NoError(t, err)
+	rng := rand.New(rand.NewSource(1234))
+
+	abi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+
+	output := testutils.RandomOutputResponse(rng)
+	gameType := uint8(1)
+
+	txData, err := proposeL2OutputDGFTxData(abi, gameType, output)
+	require.NoError(t, err)
+
+	// set a gas limit to disable gas estimation. No game implementation is set in this test.
+	opts.GasLimit = 100_000
+	extraData := common.BigToHash(new(big.Int).SetUint64(output.BlockRef.Number)).Bytes()
+	tx, err := contract.Create(opts, gameType, output.OutputRoot, extraData)
+	require.NoError(t, err)
+
+	require.Equal(t, txData, tx.Data())
+}
+
+func TestCreatedGameProxy(t *testing.T) {
+	abi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	dgfAddr, proxy := common.Address{0xaa}, common.Address{0xbb}
+	event := abi.Events["DisputeGameCreated"]
+
+	gameCreated := &types.Log{
+		Address: dgfAddr,
+		Topics:  []common.Hash{event.ID, common.BytesToHash(proxy.Bytes()), common.BigToHash(big.NewInt(1)), {0xcc}},
+	}
+	otherLog := &types.Log{Address: common.Address{0xdd}, Topics: gameCreated.Topics}
+
+	found, err := createdGameProxy(abi, dgfAddr, &types.Receipt{Logs: []*types.Log{otherLog, gameCreated}})
+	require.NoError(t, err)
+	require.Equal(t, proxy, found)
+
+	_, err = createdGameProxy(abi, dgfAddr, &types.Receipt{Logs: []*types.Log{otherLog}})
+	require.ErrorIs(t, err, ErrMissingGameCreatedEvent)
+}
@@ -1,6 +1,10 @@
 package proposer
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -27,6 +31,17 @@ type Config struct {
 	L1Client           *ethclient.Client
 	RollupClient       *sources.RollupClient
 	AllowNonFinalized  bool
+
+	// DisputeGameFactoryAddr, when set, makes the proposer create dispute games
+	// through the DisputeGameFactory rather than proposing to the L2OutputOracle.
+	DisputeGameFactoryAddr *common.Address
+	ProposalInterval       time.Duration
+	DisputeGameType        uint8
+	ProposalBond           *big.Int
+	// DisputeGameFactoryStartBlock is the L1 block to search for the games created by the
+	// proposer from. The deployment block of the DisputeGameFactory is searched for when zero,
+	// which requires an L1 archive node.
+	DisputeGameFactoryStartBlock uint64
 }
 
 // CLIConfig is a well typed config that is parsed from the CLI params.
@@ -44,6 +59,24 @@ type CLIConfig struct {
 	// L2OOAddress is the L2OutputOracle contract address.
 	L2OOAddress string
 
+	// DGFAddress is the DisputeGameFactory contract address. Exactly one of
+	// L2OOAddress and DGFAddress must be set.
+	DGFAddress string
+
+	// ProposalInterval is the delay between proposals when creating dispute games.
+	ProposalInterval time.Duration
+
+	// DisputeGameType is the type of the dispute games created for proposals.
+	DisputeGameType uint
+
+	// ProposalBond is the bond in wei posted with each created dispute game.
+	ProposalBond *big.Int
+
+	// DGFStartBlock is the L1 block to search for the created dispute games from.
+	// Zero searches for the deployment block of the DisputeGameFactory, which requires
+	// the L1 node to serve historical state.
+	DGFStartBlock uint64
+
 	// PollInterval is the delay between querying L2 for more transaction
 	// and creating a new batch.
 	PollInterval time.Duration
@@ -52,6 +85,12 @@ type CLIConfig struct {
 	// for L2 blocks derived from non-finalized L1 data.
 	AllowNonFinalized bool
 
+	// Network is the name of the network the proposer is running on, if any.
+	Network string
+
+	// NetworkRegistry is the directory of additional chain definitions to select the network from.
+	NetworkRegistry string
+
 	TxMgrConfig txmgr.CLIConfig
 
 	RPCConfig oprpc.CLIConfig
@@ -64,6 +103,27 @@ type CLIConfig struct {
 }
 
 func (c CLIConfig) Check() error {
+	if c.L2OOAddress == "" && c.DGFAddress == "" && c.Network == "" {
+		return errors.New("one of the L2OutputOracle and DisputeGameFactory addresses, or the network, must be set")
+	}
+	if c.L2OOAddress != "" && c.DGFAddress != "" {
+		return errors.New("only one of the L2OutputOracle and DisputeGameFactory addresses may be set")
+	}
+	if c.DGFAddress != "" {
+		if c.ProposalInterval <= 0 {
+			return errors.New("the proposal interval must be set when proposing through the DisputeGameFactory")
+		}
+		if c.ProposalBond != nil && c.ProposalBond.Sign() < 0 {
+			return fmt.Errorf("invalid proposal bond %v", c.ProposalBond)
+		}
+		if c.DisputeGameType > math.MaxUint8 {
+			return fmt.Errorf("invalid dispute game type %d", c.DisputeGameType)
+		}
+	} else if c.ProposalBond != nil && c.ProposalBond.Sign() != 0 {
+		return errors.New("a proposal bond can only be posted when proposing through the DisputeGameFactory")
+	} else if c.DGFStartBlock != 0 {
+		return errors.New("a start block can only be set when proposing through the DisputeGameFactory")
+	}
 	if err := c.RPCConfig.Check(); err != nil {
 		return err
 	}
@@ -88,11 +148,18 @@ func NewConfig(ctx *cli.Context) CLIConfig {
 		// Required Flags
 		L1EthRpc:     ctx.String(flags.L1EthRpcFlag.Name),
 		RollupRpc:    ctx.String(flags.RollupRpcFlag.Name),
-		L2OOAddress:  ctx.String(flags.L2OOAddressFlag.Name),
 		PollInterval: ctx.Duration(flags.PollIntervalFlag.Name),
 		TxMgrConfig:  txmgr.ReadCLIConfig(ctx),
 		// Optional Flags
+		L2OOAddress:       ctx.String(flags.L2OOAddressFlag.Name),
+		DGFAddress:        ctx.String(flags.DisputeGameFactoryAddressFlag.Name),
+		ProposalInterval:  ctx.Duration(flags.ProposalIntervalFlag.Name),
+		DisputeGameType:   ctx.Uint(flags.DisputeGameTypeFlag.Name),
+		ProposalBond:      new(big.Int).Set((*big.Int)(ctx.Generic(flags.ProposalBondFlag.Name).(*flags.BigValue))),
+		DGFStartBlock:     ctx.Uint64(flags.DisputeGameFactoryStartBlockFlag.Name),
 		AllowNonFinalized: ctx.Bool(flags.AllowNonFinalizedFlag.Name),
+		Network:           ctx.String(flags.NetworkFlag.Name),
+		NetworkRegistry:   ctx.String(flags.NetworkRegistryFlag.Name),
 		RPCConfig:         oprpc.ReadCLIConfig(ctx),
 		LogConfig:         oplog.ReadCLIConfig(ctx),
 		MetricsConfig:     opmetrics.ReadCLIConfig(ctx),
@@ -0,0 +1,310 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+var ErrMissingGameCreatedEvent = errors.New("missing DisputeGameCreated event in proposal receipt")
+
+// ProposedGame is a dispute game created by the proposer
+type ProposedGame struct {
+	Proxy         common.Address
+	RootClaim     common.Hash
+	L2BlockNumber uint64
+}
+
+func (l *L2OutputSubmitter) initDisputeGameFactory(ctx context.Context, cfg Config) error {
+	dgfContract, err := bindings.NewDisputeGameFactoryCaller(*cfg.DisputeGameFactoryAddr, cfg.L1Client)
+	if err != nil {
+		return fmt.Errorf("failed to create DisputeGameFactory at address %s: %w", cfg.DisputeGameFactoryAddr, err)
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	version, err := dgfContract.Version(&bind.CallOpts{Context: cCtx})
+	if err != nil {
+		return err
+	}
+	l.log.Info("Connected to DisputeGameFactory", "address", cfg.DisputeGameFactoryAddr, "version", version, "game_type", cfg.DisputeGameType)
+
+	parsed, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	bond := cfg.ProposalBond
+	if bond == nil {
+		bond = new(big.Int)
+	}
+	if bond.Sign() > 0 && !parsed.Methods["create"].IsPayable() {
+		return errors.New("the DisputeGameFactory does not accept a bond when creating games")
+	}
+
+	l.dgfContract = dgfContract
+	l.dgfContractAddr = cfg.DisputeGameFactoryAddr
+	l.dgfABI = parsed
+	l.gameType = cfg.DisputeGameType
+	l.proposalBond = bond
+	l.proposalInterval = cfg.ProposalInterval
+
+	cCtx, cCancel = context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	chainID, err := cfg.L1Client.ChainID(cCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 chain ID: %w", err)
+	}
+	cCtx, cCancel = context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	head, err := cfg.L1Client.BlockNumber(cCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	start := cfg.DisputeGameFactoryStartBlock
+	if start == 0 {
+		l.log.Info("Searching for the deployment block of the DisputeGameFactory, which requires an L1 archive node. Configure the start block otherwise.")
+		start, err = deploymentBlock(ctx, cfg.L1Client, *cfg.DisputeGameFactoryAddr, head)
+		if err != nil {
+			return fmt.Errorf("failed to find the deployment block of the DisputeGameFactory, the start block must be configured: %w", err)
+		}
+	}
+	l.log.Info("Loading proposed dispute games", "from", start, "to", head)
+	games, err := loadProposedGames(ctx, cfg.L1Client, chainID, *cfg.DisputeGameFactoryAddr, parsed, cfg.DisputeGameType, l.txMgr.From(), start, head)
+	if err != nil {
+		return fmt.Errorf("failed to load the dispute games created by the proposer: %w", err)
+	}
+	l.games = games
+	if len(games) > 0 {
+		l.log.Info("Loaded proposed dispute games", "count", len(games), "last_l2_block", games[len(games)-1].L2BlockNumber)
+	}
+	return nil
+}
+
+// gameLogsBlockRange bounds the L1 blocks of each log query for the created dispute games,
+// such that the queries stay within the limits of public L1 RPC providers.
+const gameLogsBlockRange = 2000
+
+// gameCreationClient is the subset of the L1 client that is used to find the dispute games created by the proposer
+type gameCreationClient interface {
+	bind.ContractFilterer
+	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// deploymentBlock finds the first block, up to the head, in which the contract has code.
+// This requires the L1 node to serve the state of historical blocks.
+func deploymentBlock(ctx context.Context, client gameCreationClient, addr common.Address, head uint64) (uint64, error) {
+	hasCode := func(block uint64) (bool, error) {
+		code, err := client.CodeAt(ctx, addr, new(big.Int).SetUint64(block))
+		if err != nil {
+			return false, fmt.Errorf("failed to get code at block %d: %w", block, err)
+		}
+		return len(code) > 0, nil
+	}
+	if ok, err := hasCode(head); err != nil {
+		return 0, err
+	} else if !ok {
+		return 0, fmt.Errorf("no contract deployed at %s", addr)
+	}
+	low, high := uint64(0), head
+	for low < high {
+		mid := low + (high-low)/2
+		ok, err := hasCode(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low, nil
+}
+
+// loadProposedGames rebuilds the dispute games of the game type that were created by the proposer from the
+// DisputeGameCreated events of the DisputeGameFactory, emitted in the L1 blocks [start, end]. The logs are
+// queried in ranges of gameLogsBlockRange blocks. The event does not include the creator, so the games are
+// matched by the sender of the create transaction, which also holds the L2 block number in its extra data.
+func loadProposedGames(ctx context.Context, client gameCreationClient, chainID *big.Int, dgfAddr common.Address, dgfABI *abi.ABI, gameType uint8, proposer common.Address, start, end uint64) ([]ProposedGame, error) {
+	filterer, err := bindings.NewDisputeGameFactoryFilterer(dgfAddr, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var games []ProposedGame
+	for from := start; from <= end; from += gameLogsBlockRange {
+		to := from + gameLogsBlockRange - 1
+		if to > end {
+			to = end
+		}
+		it, err := filterer.FilterDisputeGameCreated(&bind.FilterOpts{Context: ctx, Start: from, End: &to}, nil, []uint8{gameType}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter dispute games in blocks %d-%d: %w", from, to, err)
+		}
+		games, err = appendProposedGames(ctx, games, it, client, chainID, dgfAddr, dgfABI, proposer)
+		it.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return games, nil
+}
+
+// appendProposedGames appends the games of the iterated events that were created by the proposer
+func appendProposedGames(ctx context.Context, games []ProposedGame, it *bindings.DisputeGameFactoryDisputeGameCreatedIterator, client gameCreationClient, chainID *big.Int, dgfAddr common.Address, dgfABI *abi.ABI, proposer common.Address) ([]ProposedGame, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	create := dgfABI.Methods["create"]
+	for it.Next() {
+		tx, _, err := client.TransactionByHash(ctx, it.Event.Raw.TxHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dispute game creation tx %s: %w", it.Event.Raw.TxHash, err)
+		}
+		// Games created by others, or not created by a direct call to the factory, are not tracked
+		if to := tx.To(); to == nil || *to != dgfAddr {
+			continue
+		}
+		if from, err := types.Sender(signer, tx); err != nil || from != proposer {
+			continue
+		}
+		data := tx.Data()
+		if len(data) < 4 || !bytes.Equal(data[:4], create.ID) {
+			continue
+		}
+		args, err := create.Inputs.Unpack(data[4:])
+		if err != nil || len(args) != 3 {
+			continue
+		}
+		extraData, ok := args[2].([]byte)
+		if !ok || len(extraData) != 32 {
+			continue
+		}
+		games = append(games, ProposedGame{
+			Proxy:         it.Event.DisputeProxy,
+			RootClaim:     it.Event.RootClaim,
+			L2BlockNumber: new(big.Int).SetBytes(extraData).Uint64(),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// fetchDGFOutputInfo gets the output to propose through the DisputeGameFactory. The output at the
+// current finalized (or safe) head is proposed, unless it is not past the last proposed block or a
+// dispute game for the same root claim already exists.
+func (l *L2OutputSubmitter) fetchDGFOutputInfo(ctx context.Context) (*eth.OutputResponse, bool, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	status, err := l.rollupClient.SyncStatus(cCtx)
+	if err != nil {
+		l.log.Error("proposer unable to get sync status", "err", err)
+		return nil, false, err
+	}
+
+	// Use either the finalized or safe head depending on the config. Finalized head is default & safer.
+	currentBlockNumber := status.FinalizedL2.Number
+	if l.allowNonFinalized {
+		currentBlockNumber = status.SafeL2.Number
+	}
+	if lastProposed, ok := l.lastProposedBlock(); currentBlockNumber == 0 || (ok && currentBlockNumber <= lastProposed) {
+		l.log.Info("no new L2 blocks to propose", "currentBlockNumber", currentBlockNumber, "lastProposedBlock", lastProposed)
+		return nil, false, nil
+	}
+
+	output, shouldPropose, err := l.fetchOuput(ctx, new(big.Int).SetUint64(currentBlockNumber))
+	if err != nil || !shouldPropose {
+		return nil, false, err
+	}
+
+	exists, err := l.gameExists(ctx, output)
+	if err != nil {
+		l.log.Error("proposer unable to check for existing dispute game", "err", err)
+		return nil, false, err
+	}
+	if exists {
+		l.log.Info("dispute game already exists for output, skipping proposal", "l2_block", output.BlockRef.Number, "root_claim", output.OutputRoot)
+		return nil, false, nil
+	}
+	return output, true, nil
+}
+
+// gameExists checks if the DisputeGameFactory already holds a game of the configured type for the output
+func (l *L2OutputSubmitter) gameExists(ctx context.Context, output *eth.OutputResponse) (bool, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	game, err := l.dgfContract.Games(&bind.CallOpts{Context: cCtx}, l.gameType, output.OutputRoot, gameExtraData(output))
+	if err != nil {
+		return false, err
+	}
+	return game.Proxy != (common.Address{}), nil
+}
+
+// ProposeL2OutputDGFTxData creates the transaction data for the DisputeGameFactory's create function
+func (l *L2OutputSubmitter) ProposeL2OutputDGFTxData(output *eth.OutputResponse) ([]byte, error) {
+	return proposeL2OutputDGFTxData(l.dgfABI, l.gameType, output)
+}
+
+// proposeL2OutputDGFTxData creates the transaction data for the DisputeGameFactory's create function
+func proposeL2OutputDGFTxData(abi *abi.ABI, gameType uint8, output *eth.OutputResponse) ([]byte, error) {
+	return abi.Pack("create", gameType, output.OutputRoot, gameExtraData(output))
+}
+
+// gameExtraData encodes the L2 block number of the output as the extra data of the dispute game
+func gameExtraData(output *eth.OutputResponse) []byte {
+	return common.BigToHash(new(big.Int).SetUint64(output.BlockRef.Number)).Bytes()
+}
+
+// recordGame tracks the dispute game created by the proposal in the receipt
+func (l *L2OutputSubmitter) recordGame(receipt *types.Receipt, output *eth.OutputResponse) error {
+	proxy, err := createdGameProxy(l.dgfABI, *l.dgfContractAddr, receipt)
+	if err != nil {
+		return err
+	}
+
+	l.gamesLock.Lock()
+	defer l.gamesLock.Unlock()
+	l.games = append(l.games, ProposedGame{Proxy: proxy, RootClaim: common.Hash(output.OutputRoot), L2BlockNumber: output.BlockRef.Number})
+	l.log.Info("created dispute game", "proxy", proxy, "l2_block", output.BlockRef.Number, "root_claim", output.OutputRoot)
+	return nil
+}
+
+// createdGameProxy finds the address of the dispute game created by the DisputeGameFactory in the receipt
+func createdGameProxy(dgfABI *abi.ABI, dgfAddr common.Address, receipt *types.Receipt) (common.Address, error) {
+	event := dgfABI.Events["DisputeGameCreated"]
+	for _, log := range receipt.Logs {
+		// The game proxy is the first indexed argument of the event
+		if log.Address == dgfAddr && len(log.Topics) == 4 && log.Topics[0] == event.ID {
+			return common.BytesToAddress(log.Topics[1].Bytes()), nil
+		}
+	}
+	return common.Address{}, ErrMissingGameCreatedEvent
+}
+
+// ProposedGames returns the dispute games created by this proposer
+func (l *L2OutputSubmitter) ProposedGames() []ProposedGame {
+	l.gamesLock.Lock()
+	defer l.gamesLock.Unlock()
+	return append([]ProposedGame(nil), l.games...)
+}
+
+func (l *L2OutputSubmitter) lastProposedBlock() (uint64, bool) {
+	l.gamesLock.Lock()
+	defer l.gamesLock.Unlock()
+	if len(l.games) == 0 {
+		return 0, false
+	}
+	return l.games[len(l.games)-1].L2BlockNumber, true
+}
@@ -0,0 +1,136 @@
+package proposer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+)
+
+// gameCreations serves the DisputeGameCreated logs and the transactions that created the games,
+// from a factory that is deployed at the deploy block
+type gameCreations struct {
+	logs        []types.Log
+	txs         map[common.Hash]*types.Transaction
+	deployBlock uint64
+
+	queries []ethereum.FilterQuery
+}
+
+func (g *gameCreations) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	g.queries = append(g.queries, q)
+	var logs []types.Log
+	for _, log := range g.logs {
+		if len(q.Topics) > 2 && len(q.Topics[2]) > 0 && q.Topics[2][0] != log.Topics[2] {
+			continue
+		}
+		if log.BlockNumber < q.FromBlock.Uint64() || log.BlockNumber > q.ToBlock.Uint64() {
+			continue
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+func (g *gameCreations) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (g *gameCreations) CodeAt(_ context.Context, _ common.Address, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber.Uint64() < g.deployBlock {
+		return nil, nil
+	}
+	return []byte{1}, nil
+}
+
+func (g *gameCreations) TransactionByHash(_ context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	tx, ok := g.txs[hash]
+	if !ok {
+		return nil, false, ethereum.NotFound
+	}
+	return tx, false, nil
+}
+
+func TestLoadProposedGames(t *testing.T) {
+	dgfABI, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	chainID := big.NewInt(900)
+	signer := types.LatestSignerForChainID(chainID)
+	dgfAddr := common.Address{0xdd}
+	proposerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	proposer := crypto.PubkeyToAddress(proposerKey.PublicKey)
+
+	client := &gameCreations{txs: make(map[common.Hash]*types.Transaction)}
+	createGame := func(l1Block uint64, gameType uint8, l2Block uint64, to common.Address, key *ecdsa.PrivateKey) ProposedGame {
+		output := &eth.OutputResponse{OutputRoot: eth.Bytes32{byte(l2Block)}, BlockRef: eth.L2BlockRef{Number: l2Block}}
+		data, err := proposeL2OutputDGFTxData(dgfABI, gameType, output)
+		require.NoError(t, err)
+		tx := types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: chainID, Nonce: uint64(len(client.logs)), To: &to, Data: data})
+		client.txs[tx.Hash()] = tx
+		game := ProposedGame{Proxy: common.Address{byte(len(client.logs) + 1)}, RootClaim: common.Hash(output.OutputRoot), L2BlockNumber: l2Block}
+		client.logs = append(client.logs, types.Log{
+			Address: dgfAddr,
+			Topics: []common.Hash{
+				dgfABI.Events["DisputeGameCreated"].ID,
+				common.BytesToHash(game.Proxy.Bytes()),
+				common.BigToHash(big.NewInt(int64(gameType))),
+				game.RootClaim,
+			},
+			TxHash:      tx.Hash(),
+			BlockNumber: l1Block,
+		})
+		return game
+	}
+
+	first := createGame(100, 0, 10, dgfAddr, proposerKey)
+	createGame(200, 1, 15, dgfAddr, proposerKey) // other game type
+	createGame(300, 0, 20, dgfAddr, otherKey)    // created by another account
+	createGame(400, 0, 25, common.Address{0xee}, proposerKey)
+	second := createGame(2100, 0, 30, dgfAddr, proposerKey)
+	third := createGame(4100, 0, 40, dgfAddr, proposerKey)
+
+	games, err := loadProposedGames(context.Background(), client, chainID, dgfAddr, dgfABI, 0, proposer, 50, 4100)
+	require.NoError(t, err)
+	require.Equal(t, []ProposedGame{first, second, third}, games)
+	// the blocks [50, 4100] are queried in bounded ranges
+	require.Len(t, client.queries, 3)
+	for i, q := range client.queries {
+		require.Equal(t, uint64(50+i*gameLogsBlockRange), q.FromBlock.Uint64())
+		require.LessOrEqual(t, q.ToBlock.Uint64()-q.FromBlock.Uint64(), uint64(gameLogsBlockRange-1))
+	}
+	require.Equal(t, uint64(4100), client.queries[2].ToBlock.Uint64())
+
+	// games before the start block are not loaded
+	games, err = loadProposedGames(context.Background(), client, chainID, dgfAddr, dgfABI, 0, proposer, 2000, 4000)
+	require.NoError(t, err)
+	require.Equal(t, []ProposedGame{second}, games)
+
+	games, err = loadProposedGames(context.Background(), client, chainID, dgfAddr, dgfABI, 0, common.Address{0xaa}, 0, 4100)
+	require.NoError(t, err)
+	require.Empty(t, games)
+}
+
+func TestDeploymentBlock(t *testing.T) {
+	dgfAddr := common.Address{0xdd}
+	for _, deployBlock := range []uint64{0, 1, 99, 100} {
+		block, err := deploymentBlock(context.Background(), &gameCreations{deployBlock: deployBlock}, dgfAddr, 100)
+		require.NoError(t, err)
+		require.Equal(t, deployBlock, block)
+	}
+
+	_, err := deploymentBlock(context.Background(), &gameCreations{deployBlock: 101}, dgfAddr, 100)
+	require.ErrorContains(t, err, "no contract deployed")
+}
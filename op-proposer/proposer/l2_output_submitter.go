@@ -17,6 +17,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum-optimism/optimism/op-node/sources"
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
@@ -125,6 +126,18 @@ type L2OutputSubmitter struct {
 	l2ooContractAddr common.Address
 	l2ooABI          *abi.ABI
 
+	// The DisputeGameFactory is used instead of the L2OutputOracle when set
+	dgfContract      *bindings.DisputeGameFactoryCaller
+	dgfContractAddr  *common.Address
+	dgfABI           *abi.ABI
+	gameType         uint8
+	proposalBond     *big.Int
+	proposalInterval time.Duration
+
+	// games tracks the dispute games created by this proposer
+	gamesLock sync.Mutex
+	games     []ProposedGame
+
 	// AllowNonFinalized enables the proposal of safe, but non-finalized L2 blocks.
 	// The L1 block-hash embedded in the proposal TX is checked and should ensure the proposal
 	// is never valid on an alternative L1 chain that would produce different L2 data.
@@ -146,9 +159,38 @@ func NewL2OutputSubmitterFromCLIConfig(cfg CLIConfig, l log.Logger, m metrics.Me
 
 // NewL2OutputSubmitterConfigFromCLIConfig creates the proposer config from the CLI config.
 func NewL2OutputSubmitterConfigFromCLIConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config, error) {
-	l2ooAddress, err := opservice.ParseAddress(cfg.L2OOAddress)
-	if err != nil {
-		return nil, err
+	var chain *chaincfg.ChainDefinition
+	if cfg.Network != "" {
+		var err error
+		chain, err = chaincfg.LoadChain(cfg.NetworkRegistry, cfg.Network)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var l2ooAddress common.Address
+	var dgfAddress *common.Address
+	if cfg.DGFAddress == "" && cfg.L2OOAddress == "" {
+		if chain == nil {
+			return nil, errors.New("one of the L2OutputOracle and DisputeGameFactory addresses, or the network, must be set")
+		}
+		addr, err := chain.Address(chaincfg.L2OutputOracleProxy)
+		if err != nil {
+			return nil, err
+		}
+		l2ooAddress = addr
+	} else if cfg.DGFAddress != "" {
+		addr, err := opservice.ParseAddress(cfg.DGFAddress)
+		if err != nil {
+			return nil, err
+		}
+		dgfAddress = &addr
+	} else {
+		addr, err := opservice.ParseAddress(cfg.L2OOAddress)
+		if err != nil {
+			return nil, err
+		}
+		l2ooAddress = addr
 	}
 
 	txManager, err := txmgr.NewSimpleTxManager("proposer", l, m, cfg.TxMgrConfig)
@@ -168,6 +210,19 @@ func NewL2OutputSubmitterConfigFromCLIConfig(cfg CLIConfig, l log.Logger, m metr
 		return nil, err
 	}
 
+	if chain != nil {
+		if err := chain.CheckL1(ctx, l1Client); err != nil {
+			return nil, fmt.Errorf("invalid L1 for network %s: %w", cfg.Network, err)
+		}
+		rollupCfg, err := rollupClient.RollupConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying rollup config: %w", err)
+		}
+		if err := chain.CheckRollupConfig(rollupCfg); err != nil {
+			return nil, fmt.Errorf("invalid rollup node for network %s: %w", cfg.Network, err)
+		}
+	}
+
 	return &Config{
 		L2OutputOracleAddr: l2ooAddress,
 		PollInterval:       cfg.PollInterval,
@@ -176,53 +231,79 @@ func NewL2OutputSubmitterConfigFromCLIConfig(cfg CLIConfig, l log.Logger, m metr
 		RollupClient:       rollupClient,
 		AllowNonFinalized:  cfg.AllowNonFinalized,
 		TxManager:          txManager,
+
+		DisputeGameFactoryAddr: dgfAddress,
+		ProposalInterval:       cfg.ProposalInterval,
+		DisputeGameType:        uint8(cfg.DisputeGameType),
+		ProposalBond:           cfg.ProposalBond,
+
+		DisputeGameFactoryStartBlock: cfg.DGFStartBlock,
 	}, nil
 
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
 func NewL2OutputSubmitter(cfg Config, l log.Logger, m metrics.Metricer) (*L2OutputSubmitter, error) {
+	// the interval of the proposal loop ticker
+	if cfg.DisputeGameFactoryAddr != nil && cfg.ProposalInterval <= 0 {
+		return nil, fmt.Errorf("invalid proposal interval %v, must be positive", cfg.ProposalInterval)
+	} else if cfg.DisputeGameFactoryAddr == nil && cfg.PollInterval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %v, must be positive", cfg.PollInterval)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
-	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	submitter := &L2OutputSubmitter{
+		txMgr:  cfg.TxManager,
+		done:   make(chan struct{}),
+		log:    l,
+		ctx:    ctx,
+		cancel: cancel,
+		metr:   m,
+
+		rollupClient: cfg.RollupClient,
+
+		allowNonFinalized: cfg.AllowNonFinalized,
+		pollInterval:      cfg.PollInterval,
+		networkTimeout:    cfg.NetworkTimeout,
+	}
+
+	var err error
+	if cfg.DisputeGameFactoryAddr != nil {
+		err = submitter.initDisputeGameFactory(ctx, cfg)
+	} else {
+		err = submitter.initL2OutputOracle(ctx, cfg)
+	}
 	if err != nil {
 		cancel()
-		return nil, fmt.Errorf("failed to create L2OO at address %s: %w", cfg.L2OutputOracleAddr, err)
+		return nil, err
+	}
+	return submitter, nil
+}
+
+func (l *L2OutputSubmitter) initL2OutputOracle(ctx context.Context, cfg Config) error {
+	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return fmt.Errorf("failed to create L2OO at address %s: %w", cfg.L2OutputOracleAddr, err)
 	}
 
 	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
 	defer cCancel()
 	version, err := l2ooContract.Version(&bind.CallOpts{Context: cCtx})
 	if err != nil {
-		cancel()
-		return nil, err
+		return err
 	}
 	log.Info("Connected to L2OutputOracle", "address", cfg.L2OutputOracleAddr, "version", version)
 
 	parsed, err := bindings.L2OutputOracleMetaData.GetAbi()
 	if err != nil {
-		cancel()
-		return nil, err
+		return err
 	}
 
-	return &L2OutputSubmitter{
-		txMgr:  cfg.TxManager,
-		done:   make(chan struct{}),
-		log:    l,
-		ctx:    ctx,
-		cancel: cancel,
-		metr:   m,
-
-		rollupClient: cfg.RollupClient,
-
-		l2ooContract:     l2ooContract,
-		l2ooContractAddr: cfg.L2OutputOracleAddr,
-		l2ooABI:          parsed,
-
-		allowNonFinalized: cfg.AllowNonFinalized,
-		pollInterval:      cfg.PollInterval,
-		networkTimeout:    cfg.NetworkTimeout,
-	}, nil
+	l.l2ooContract = l2ooContract
+	l.l2ooContractAddr = cfg.L2OutputOracleAddr
+	l.l2ooABI = parsed
+	return nil
 }
 
 func (l *L2OutputSubmitter) Start() error {
@@ -240,6 +321,10 @@ func (l *L2OutputSubmitter) Stop() {
 // FetchNextOutputInfo gets the block number of the next proposal.
 // It returns: the next block number, if the proposal should be made, error
 func (l *L2OutputSubmitter) FetchNextOutputInfo(ctx context.Context) (*eth.OutputResponse, bool, error) {
+	if l.dgfContractAddr != nil {
+		return l.fetchDGFOutputInfo(ctx)
+	}
+
 	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
 	defer cancel()
 	callOpts := &bind.CallOpts{
@@ -321,22 +406,42 @@ func proposeL2OutputTxData(abi *abi.ABI, output *eth.OutputResponse) ([]byte, er
 
 // sendTransaction creates & sends transactions through the underlying transaction manager.
 func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse) error {
-	data, err := l.ProposeL2OutputTxData(output)
-	if err != nil {
-		return err
+	var candidate txmgr.TxCandidate
+	if l.dgfContractAddr != nil {
+		data, err := l.ProposeL2OutputDGFTxData(output)
+		if err != nil {
+			return err
+		}
+		candidate = txmgr.TxCandidate{
+			TxData:   data,
+			To:       l.dgfContractAddr,
+			GasLimit: 0,
+			Value:    l.proposalBond,
+		}
+	} else {
+		data, err := l.ProposeL2OutputTxData(output)
+		if err != nil {
+			return err
+		}
+		candidate = txmgr.TxCandidate{
+			TxData:   data,
+			To:       &l.l2ooContractAddr,
+			GasLimit: 0,
+		}
 	}
-	receipt, err := l.txMgr.Send(ctx, txmgr.TxCandidate{
-		TxData:   data,
-		To:       &l.l2ooContractAddr,
-		GasLimit: 0,
-	})
+
+	receipt, err := l.txMgr.Send(ctx, candidate)
 	if err != nil {
 		return err
 	}
 	if receipt.Status == types.ReceiptStatusFailed {
 		l.log.Error("proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
-	} else {
-		l.log.Info("proposer tx successfully published", "tx_hash", receipt.TxHash)
+		return nil
+	}
+
+	l.log.Info("proposer tx successfully published", "tx_hash", receipt.TxHash)
+	if l.dgfContractAddr != nil {
+		return l.recordGame(receipt, output)
 	}
 	return nil
 }
@@ -347,7 +452,11 @@ func (l *L2OutputSubmitter) loop() {
 
 	ctx := l.ctx
 
-	ticker := time.NewTicker(l.pollInterval)
+	interval := l.pollInterval
+	if l.dgfContractAddr != nil {
+		interval = l.proposalInterval
+	}
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 	for {
 		select {
@@ -0,0 +1,45 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum-optimism/optimism/op-proposer/flags"
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+)
+
+func TestNewL2OutputSubmitterConfigWithoutAddress(t *testing.T) {
+	_, err := NewL2OutputSubmitterConfigFromCLIConfig(CLIConfig{}, testlog.Logger(t, log.LvlError), metrics.NoopMetrics)
+	require.ErrorContains(t, err, "addresses, or the network, must be set")
+}
+
+func TestNewL2OutputSubmitterInvalidInterval(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	_, err := NewL2OutputSubmitter(Config{PollInterval: 0}, logger, metrics.NoopMetrics)
+	require.ErrorContains(t, err, "invalid poll interval")
+
+	dgfAddr := common.Address{0xaa}
+	_, err = NewL2OutputSubmitter(Config{DisputeGameFactoryAddr: &dgfAddr, PollInterval: time.Second}, logger, metrics.NoopMetrics)
+	require.ErrorContains(t, err, "invalid proposal interval")
+}
+
+func TestCLIConfigProposalBond(t *testing.T) {
+	cfg := CLIConfig{L2OOAddress: "0x01", ProposalBond: big.NewInt(1)}
+	require.ErrorContains(t, cfg.Check(), "a proposal bond can only be posted")
+
+	// bonds above the max uint64 wei, ~18 ETH
+	var bond flags.BigValue
+	require.NoError(t, bond.Set("100000000000000000000"))
+	require.Equal(t, "100000000000000000000", (*big.Int)(&bond).String())
+	require.Error(t, bond.Set("1 ether"))
+
+	cfg = CLIConfig{DGFAddress: "0x01", ProposalInterval: time.Minute}
+	cfg.ProposalBond = big.NewInt(-1)
+	require.ErrorContains(t, cfg.Check(), "invalid proposal bond")
+}
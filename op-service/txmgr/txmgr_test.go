@@ -391,6 +391,21 @@ func TestTxMgr_CraftTx(t *testing.T) {
 
 	// Check that the gas was set using the gas limit.
 	require.Equal(t, candidate.GasLimit, tx.Gas())
+
+	// No value was set on the candidate.
+	require.Zero(t, tx.Value().Sign())
+}
+
+// TestTxMgr_CraftTxValue ensures that the candidate value is sent with the transaction.
+func TestTxMgr_CraftTxValue(t *testing.T) {
+	t.Parallel()
+	h := newTestHarness(t)
+	candidate := h.createTxCandidate()
+	candidate.Value = big.NewInt(1000)
+
+	tx, err := h.mgr.craftTx(context.Background(), candidate)
+	require.Nil(t, err)
+	require.Equal(t, candidate.Value, tx.Value())
 }
 
 // TestTxMgr_EstimateGas ensures that the tx manager will estimate
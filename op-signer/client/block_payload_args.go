@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BlockPayloadArgs represents the arguments to sign a p2p block payload.
+// Only the hash of the payload is sent, the signer derives the signing hash
+// from the domain, chain ID and payload hash, see ToSigningHash.
+type BlockPayloadArgs struct {
+	Domain        common.Hash     `json:"domain"`
+	ChainID       *hexutil.Big    `json:"chainId"`
+	PayloadHash   common.Hash     `json:"payloadHash"`
+	SenderAddress *common.Address `json:"senderAddress"`
+}
+
+// NewBlockPayloadArgs creates a BlockPayloadArgs struct from the encoded block payload
+func NewBlockPayloadArgs(domain [32]byte, chainId *big.Int, payloadBytes []byte, senderAddress *common.Address) *BlockPayloadArgs {
+	return &BlockPayloadArgs{
+		Domain:        domain,
+		ChainID:       (*hexutil.Big)(chainId),
+		PayloadHash:   crypto.Keccak256Hash(payloadBytes),
+		SenderAddress: senderAddress,
+	}
+}
+
+// Check validates the arguments
+func (args *BlockPayloadArgs) Check() error {
+	if args.ChainID == nil {
+		return errors.New("chainId not specified")
+	}
+	if args.ChainID.ToInt().Sign() < 0 || args.ChainID.ToInt().BitLen() > 256 {
+		return errors.New("chainId is out of range")
+	}
+	return nil
+}
+
+// ToSigningHash returns the hash that is signed for the block payload
+func (args *BlockPayloadArgs) ToSigningHash() (common.Hash, error) {
+	if err := args.Check(); err != nil {
+		return common.Hash{}, err
+	}
+	return BlockPayloadSigningHash(args.Domain, args.ChainID.ToInt(), args.PayloadHash)
+}
+
+// BlockPayloadSigningHash computes keccak256(domain ++ chain_id ++ payload_hash), the hash
+// signed for a block payload by the op-node and the op-signer service
+func BlockPayloadSigningHash(domain [32]byte, chainID *big.Int, payloadHash common.Hash) (common.Hash, error) {
+	var msgInput [32 + 32 + 32]byte
+	// domain: first 32 bytes
+	copy(msgInput[:32], domain[:])
+	// chain_id: second 32 bytes
+	if chainID.BitLen() > 256 {
+		return common.Hash{}, errors.New("chain_id is too large")
+	}
+	chainID.FillBytes(msgInput[32:64])
+	// payload_hash: third 32 bytes, hash of encoded payload
+	copy(msgInput[64:], payloadHash[:])
+
+	return crypto.Keccak256Hash(msgInput[:]), nil
+}
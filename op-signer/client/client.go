@@ -107,3 +107,25 @@ func (s *SignerClient) SignTransaction(ctx context.Context, chainId *big.Int, fr
 
 	return signed, nil
 }
+
+// SignBlockPayload requests a signature for a p2p block payload. The signature is
+// returned in the [R || S || V] format, with V being 0 or 1.
+func (s *SignerClient) SignBlockPayload(ctx context.Context, args *BlockPayloadArgs) ([65]byte, error) {
+	var result hexutil.Bytes
+	if err := s.client.CallContext(ctx, &result, "opsigner_signBlockPayload", args); err != nil {
+		return [65]byte{}, fmt.Errorf("opsigner_signBlockPayload failed: %w", err)
+	}
+
+	var sig [65]byte
+	if len(result) != len(sig) {
+		return [65]byte{}, fmt.Errorf("invalid signature length: %d", len(result))
+	}
+
+	copy(sig[:], result)
+	return sig, nil
+}
+
+// Close closes the underlying RPC connection
+func (s *SignerClient) Close() {
+	s.client.Close()
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-signer/client"
+)
+
+// HashSigner signs 32-byte hashes on behalf of a single address
+type HashSigner interface {
+	Address() common.Address
+	// SignHash returns the signature of the hash in the [R || S || V] format, with V being 0 or 1
+	SignHash(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+// PrivateKeyHashSigner signs hashes with a local private key
+type PrivateKeyHashSigner struct {
+	priv *ecdsa.PrivateKey
+}
+
+func NewPrivateKeyHashSigner(priv *ecdsa.PrivateKey) *PrivateKeyHashSigner {
+	return &PrivateKeyHashSigner{priv: priv}
+}
+
+func (s *PrivateKeyHashSigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.priv.PublicKey)
+}
+
+func (s *PrivateKeyHashSigner) SignHash(_ context.Context, hash common.Hash) ([]byte, error) {
+	return crypto.Sign(hash[:], s.priv)
+}
+
+// BlockPayloadAPI serves the opsigner_signBlockPayload method that is used by
+// the op-node to sign p2p block payloads with a remote key.
+type BlockPayloadAPI struct {
+	logger log.Logger
+	signer HashSigner
+}
+
+func NewBlockPayloadAPI(logger log.Logger, signer HashSigner) *BlockPayloadAPI {
+	return &BlockPayloadAPI{logger: logger, signer: signer}
+}
+
+// SignBlockPayload signs keccak256(domain ++ chain_id ++ payload_hash) and returns
+// the signature in the [R || S || V] format, with V being 0 or 1. If a sender address
+// is specified it must match the address of the signing key.
+func (api *BlockPayloadAPI) SignBlockPayload(ctx context.Context, args client.BlockPayloadArgs) (hexutil.Bytes, error) {
+	if args.SenderAddress != nil && *args.SenderAddress != api.signer.Address() {
+		return nil, fmt.Errorf("unexpected sender address %s, signing as %s", args.SenderAddress, api.signer.Address())
+	}
+	signingHash, err := args.ToSigningHash()
+	if err != nil {
+		return nil, fmt.Errorf("invalid block payload args: %w", err)
+	}
+	sig, err := api.signer.SignHash(ctx, signingHash)
+	if err != nil {
+		api.logger.Error("failed to sign block payload", "chain_id", args.ChainID, "payload_hash", args.PayloadHash, "err", err)
+		return nil, fmt.Errorf("failed to sign block payload: %w", err)
+	}
+	if len(sig) != 65 {
+		return nil, errors.New("invalid signature length")
+	}
+	api.logger.Debug("signed block payload", "chain_id", args.ChainID, "payload_hash", args.PayloadHash)
+	return sig, nil
+}
+
+// HealthAPI serves the health_status method that clients use to check that the signer is reachable
+type HealthAPI struct {
+	Version string
+}
+
+func (api *HealthAPI) Status() string {
+	return api.Version
+}
+
+// GetAPIs returns the RPC APIs of the signer service
+func GetAPIs(logger log.Logger, signer HashSigner, version string) []rpc.API {
+	return []rpc.API{
+		{Namespace: "opsigner", Service: NewBlockPayloadAPI(logger, signer)},
+		{Namespace: "health", Service: &HealthAPI{Version: version}},
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	"github.com/ethereum-optimism/optimism/op-signer/client"
+)
+
+func TestSignBlockPayload(t *testing.T) {
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := NewPrivateKeyHashSigner(priv)
+
+	server := rpc.NewServer()
+	for _, api := range GetAPIs(logger, signer, "test") {
+		require.NoError(t, server.RegisterName(api.Namespace, api.Service))
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+
+	signerClient, err := client.NewSignerClient(logger, httpServer.URL, optls.CLIConfig{})
+	require.NoError(t, err)
+	t.Cleanup(signerClient.Close)
+
+	address := signer.Address()
+	args := client.NewBlockPayloadArgs([32]byte{1}, big.NewInt(100), []byte("arbitraryData"), &address)
+
+	t.Run("valid", func(t *testing.T) {
+		sig, err := signerClient.SignBlockPayload(context.Background(), args)
+		require.NoError(t, err)
+		signingHash, err := client.BlockPayloadSigningHash([32]byte{1}, big.NewInt(100), crypto.Keccak256Hash([]byte("arbitraryData")))
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(signingHash[:], sig[:])
+		require.NoError(t, err)
+		require.Equal(t, address, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("unexpected sender", func(t *testing.T) {
+		other := common.Address{0xaa}
+		_, err := signerClient.SignBlockPayload(context.Background(), client.NewBlockPayloadArgs([32]byte{1}, big.NewInt(100), []byte("arbitraryData"), &other))
+		require.ErrorContains(t, err, "unexpected sender address")
+	})
+
+	t.Run("missing chain ID", func(t *testing.T) {
+		_, err := signerClient.SignBlockPayload(context.Background(), &client.BlockPayloadArgs{SenderAddress: &address})
+		require.ErrorContains(t, err, "chainId not specified")
+	})
+}
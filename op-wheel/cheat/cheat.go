@@ -3,6 +3,7 @@ package cheat
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -12,6 +13,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-node/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -186,6 +189,20 @@ func StorageGet(address common.Address, key common.Hash, w io.Writer) HeadFn {
 	}
 }
 
+// SentMessage reads whether a withdrawal with the given hash was initiated, from the
+// sentMessages mapping of the L2ToL1MessagePasser predeploy.
+func SentMessage(withdrawalHash common.Hash, w io.Writer) HeadFn {
+	return func(headState *state.StateDB) error {
+		reader := bindings.NewStateDBStorageReader(headState, predeploys.L2ToL1MessagePasserAddr)
+		sent, err := bindings.NewL2ToL1MessagePasserStorage(reader).SentMessages().Key(withdrawalHash).Get(context.Background())
+		if err != nil {
+			return fmt.Errorf("failed to read sent message %s: %w", withdrawalHash, err)
+		}
+		_, err = fmt.Fprintln(w, sent)
+		return err
+	}
+}
+
 // StorageReadAll reads all values of the given address, and writes it as a (+) diff to the given output writer.
 // Simply replace the (+) with (-) if you need to apply the diff as removal of values.
 // Combined with StoragePatch this allows for quick surgery of 1 account in one database,
@@ -330,6 +330,17 @@ var (
 			return ch.RunAndClose(cheat.OvmOwners(&conf))
 		}),
 	}
+	CheatWithdrawalSentCmd = &cli.Command{
+		Name:  "withdrawal-sent",
+		Usage: "Check if a withdrawal was initiated, by reading the L2ToL1MessagePasser storage",
+		Flags: []cli.Flag{
+			DataDirFlag,
+			hashFlag("withdrawal-hash", "Hash of the withdrawal to check"),
+		},
+		Action: CheatAction(true, func(ctx *cli.Context, ch *cheat.Cheater) error {
+			return ch.RunAndClose(cheat.SentMessage(hashFlagValue("withdrawal-hash", ctx), ctx.App.Writer))
+		}),
+	}
 	CheatPrintHeadBlock = &cli.Command{
 		Name:  "head-block",
 		Usage: "dump head block as JSON",
@@ -467,6 +478,7 @@ var CheatCmd = &cli.Command{
 		CheatSetCodeCmd,
 		CheatSetNonceCmd,
 		CheatOvmOwnersCmd,
+		CheatWithdrawalSentCmd,
 		CheatPrintHeadBlock,
 		CheatPrintHeadHeader,
 	},
@@ -0,0 +1,386 @@
+package proxyd
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/gorilla/mux"
+)
+
+// ConfigSource loads a fresh copy of the proxyd config, typically by re-reading
+// the config file proxyd was started with.
+type ConfigSource func() (*Config, error)
+
+var ErrNoConfigSource = errors.New("no config source set, cannot reload")
+
+type AdminBackendGroupStatus struct {
+	Name      string                `json:"name"`
+	Backends  []*AdminBackendStatus `json:"backends"`
+	Consensus *AdminConsensusStatus `json:"consensus,omitempty"`
+}
+
+type AdminConsensusStatus struct {
+	LatestBlockNumber    hexutil.Uint64 `json:"latest_block_number"`
+	SafeBlockNumber      hexutil.Uint64 `json:"safe_block_number"`
+	FinalizedBlockNumber hexutil.Uint64 `json:"finalized_block_number"`
+	ConsensusGroup       []string       `json:"consensus_group"`
+}
+
+type AdminBackendStatus struct {
+	Name         string                       `json:"name"`
+	Healthy      bool                         `json:"healthy"`
+	Degraded     bool                         `json:"degraded"`
+	ErrorRate    float64                      `json:"error_rate"`
+	AvgLatencyMs int64                        `json:"avg_latency_ms"`
+	Consensus    *AdminConsensusBackendStatus `json:"consensus,omitempty"`
+}
+
+type AdminConsensusBackendStatus struct {
+	Banned               bool           `json:"banned"`
+	BannedUntil          *time.Time     `json:"banned_until,omitempty"`
+	InConsensus          bool           `json:"in_consensus"`
+	PeerCount            uint64         `json:"peer_count"`
+	InSync               bool           `json:"in_sync"`
+	LatestBlockNumber    hexutil.Uint64 `json:"latest_block_number"`
+	LatestBlockHash      string         `json:"latest_block_hash"`
+	SafeBlockNumber      hexutil.Uint64 `json:"safe_block_number"`
+	FinalizedBlockNumber hexutil.Uint64 `json:"finalized_block_number"`
+	LastUpdate           time.Time      `json:"last_update"`
+}
+
+// SetConfigSource sets the source that ReloadFromSource and the admin reload
+// endpoint load the new config from.
+func (s *Server) SetConfigSource(src ConfigSource) {
+	s.routesMu.Lock()
+	defer s.routesMu.Unlock()
+	s.configSource = src
+}
+
+// ReloadFromSource loads the config from the config source and reloads it.
+func (s *Server) ReloadFromSource() error {
+	s.routesMu.RLock()
+	src := s.configSource
+	s.routesMu.RUnlock()
+	if src == nil {
+		return ErrNoConfigSource
+	}
+
+	config, err := src()
+	if err != nil {
+		RecordConfigReload(err)
+		return fmt.Errorf("error loading config: %w", err)
+	}
+	return s.Reload(config)
+}
+
+// Reload replaces the backends, backend groups, method mappings, authentication
+// and rate limits of a running server with the ones from the given config.
+// The server, redis, cache, metrics and admin sections are only read on startup,
+// changes to them require a restart.
+//
+// Requests that are in flight when the reload happens finish against the old
+// backends, whose consensus pollers are shut down once they have drained.
+// Established WebSocket connections are kept open.
+func (s *Server) Reload(config *Config) error {
+	err := s.reload(config)
+	RecordConfigReload(err)
+	return err
+}
+
+func (s *Server) reload(config *Config) error {
+	if err := validateRoutingConfig(config); err != nil {
+		return err
+	}
+	if config.RateLimit.UseRedis && s.redisClient == nil {
+		return errors.New("must specify a Redis URL if UseRedis is true in rate limit config")
+	}
+	if config.Quotas.UseRedis && s.redisClient == nil {
+		return errors.New("must specify a Redis URL if UseRedis is true in quotas config")
+	}
+
+	backendGroups, err := buildBackendGroups(config, s.rpcRequestSemaphore)
+	if err != nil {
+		return err
+	}
+
+	var wsBackendGroup *BackendGroup
+	if config.WSBackendGroup != "" {
+		wsBackendGroup = backendGroups[config.WSBackendGroup]
+		if wsBackendGroup == nil {
+			return fmt.Errorf("ws backend group %s does not exist", config.WSBackendGroup)
+		}
+	}
+	s.srvMu.Lock()
+	wsEnabled := s.wsServer != nil
+	s.srvMu.Unlock()
+	if wsBackendGroup == nil && wsEnabled {
+		return errors.New("the ws server is running, but no ws group was defined")
+	}
+
+	resolvedAuth, err := resolveAuthentication(config.Authentication)
+	if err != nil {
+		return err
+	}
+
+	s.routesMu.RLock()
+	prevKeyQuotas := s.routes.keyQuotas
+	s.routesMu.RUnlock()
+
+	routes, err := newServerRoutes(
+		backendGroups,
+		wsBackendGroup,
+		NewStringSetFromStrings(config.WSMethodWhitelist),
+		config.RPCMethodMappings,
+		resolvedAuth,
+		config.RateLimit,
+		config.SenderRateLimit,
+		config.Quotas,
+		config.TxPolicy,
+		s.redisClient,
+		prevKeyQuotas,
+	)
+	if err != nil {
+		return err
+	}
+
+	startConsensusPollers(config, backendGroups)
+
+	s.routesMu.Lock()
+	old := s.routes
+	s.routes = routes
+	s.routesMu.Unlock()
+
+	log.Info("reloaded config", "backend_groups", len(backendGroups), "backends", len(config.Backends))
+	go s.drainRoutes(old)
+	return nil
+}
+
+// drainRoutes waits for the requests that still use the given routes to finish,
+// and then shuts down their backend groups. Requests can't run for longer than
+// the server timeout, so draining never waits longer than that.
+func (s *Server) drainRoutes(rt *serverRoutes) {
+	drained := make(chan struct{})
+	go func() {
+		rt.inflight.Wait()
+		close(drained)
+	}()
+
+	timer := time.NewTimer(s.timeout)
+	defer timer.Stop()
+	select {
+	case <-drained:
+		log.Info("drained requests of previous config")
+	case <-timer.C:
+		log.Warn("timed out draining requests of previous config")
+	}
+
+	for _, bg := range rt.backendGroups {
+		bg.Shutdown()
+	}
+}
+
+func (s *Server) AdminListenAndServe(host string, port int, token string) error {
+	s.srvMu.Lock()
+	hdlr := mux.NewRouter()
+	hdlr.HandleFunc("/backend_groups", s.HandleAdminBackendGroups).Methods("GET")
+	hdlr.HandleFunc("/backend_groups/{group}", s.HandleAdminBackendGroup).Methods("GET")
+	hdlr.HandleFunc("/backend_groups/{group}/backends/{backend}/ban", s.HandleAdminBan).Methods("POST")
+	hdlr.HandleFunc("/backend_groups/{group}/backends/{backend}/unban", s.HandleAdminUnban).Methods("POST")
+	hdlr.HandleFunc("/reload", s.HandleAdminReload).Methods("POST")
+	addr := fmt.Sprintf("%s:%d", host, port)
+	s.adminServer = &http.Server{
+		Handler: adminAuthHdlr(token, hdlr),
+		Addr:    addr,
+	}
+	log.Info("starting admin server", "addr", addr)
+	s.srvMu.Unlock()
+	return s.adminServer.ListenAndServe()
+}
+
+func adminAuthHdlr(token string, h http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			log.Info("blocked unauthorized admin request", "path", r.URL.Path)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	}
+}
+
+// BackendGroups returns the backend groups of the current config.
+func (s *Server) BackendGroups() map[string]*BackendGroup {
+	s.routesMu.RLock()
+	defer s.routesMu.RUnlock()
+	return s.routes.backendGroups
+}
+
+func (s *Server) HandleAdminBackendGroups(w http.ResponseWriter, r *http.Request) {
+	rt := s.acquireRoutes()
+	defer rt.release()
+
+	names := make([]string, 0, len(rt.backendGroups))
+	for name := range rt.backendGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]*AdminBackendGroupStatus, 0, len(names))
+	for _, name := range names {
+		res = append(res, backendGroupStatus(rt.backendGroups[name]))
+	}
+	writeAdminRes(w, http.StatusOK, res)
+}
+
+func (s *Server) HandleAdminBackendGroup(w http.ResponseWriter, r *http.Request) {
+	rt := s.acquireRoutes()
+	defer rt.release()
+
+	bg := rt.backendGroups[mux.Vars(r)["group"]]
+	if bg == nil {
+		writeAdminError(w, http.StatusNotFound, "backend group not found")
+		return
+	}
+	writeAdminRes(w, http.StatusOK, backendGroupStatus(bg))
+}
+
+func (s *Server) HandleAdminBan(w http.ResponseWriter, r *http.Request) {
+	s.handleAdminBanUpdate(w, r, true)
+}
+
+func (s *Server) HandleAdminUnban(w http.ResponseWriter, r *http.Request) {
+	s.handleAdminBanUpdate(w, r, false)
+}
+
+func (s *Server) handleAdminBanUpdate(w http.ResponseWriter, r *http.Request, ban bool) {
+	rt := s.acquireRoutes()
+	defer rt.release()
+
+	vars := mux.Vars(r)
+	bg := rt.backendGroups[vars["group"]]
+	if bg == nil {
+		writeAdminError(w, http.StatusNotFound, "backend group not found")
+		return
+	}
+	if bg.Consensus == nil {
+		writeAdminError(w, http.StatusBadRequest, "backend group is not consensus aware")
+		return
+	}
+	var be *Backend
+	for _, candidate := range bg.Backends {
+		if candidate.Name == vars["backend"] {
+			be = candidate
+		}
+	}
+	if be == nil {
+		writeAdminError(w, http.StatusNotFound, "backend not found in backend group")
+		return
+	}
+
+	if ban {
+		log.Warn("manually banning backend", "backend_group", bg.Name, "backend", be.Name)
+		bg.Consensus.Ban(be)
+	} else {
+		log.Warn("manually unbanning backend", "backend_group", bg.Name, "backend", be.Name)
+		bg.Consensus.Unban(be)
+	}
+	RecordConsensusBackendBanned(be, ban)
+	writeAdminRes(w, http.StatusOK, backendStatus(bg, be, nil))
+}
+
+func (s *Server) HandleAdminReload(w http.ResponseWriter, r *http.Request) {
+	if err := s.ReloadFromSource(); err != nil {
+		log.Error("error reloading config", "err", err)
+		writeAdminError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeAdminRes(w, http.StatusOK, map[string]bool{"reloaded": true})
+}
+
+func backendGroupStatus(bg *BackendGroup) *AdminBackendGroupStatus {
+	res := &AdminBackendGroupStatus{
+		Name:     bg.Name,
+		Backends: make([]*AdminBackendStatus, 0, len(bg.Backends)),
+	}
+
+	var inConsensus map[*Backend]bool
+	if bg.Consensus != nil {
+		consensusGroup := bg.Consensus.GetConsensusGroup()
+		inConsensus = make(map[*Backend]bool, len(consensusGroup))
+		names := make([]string, 0, len(consensusGroup))
+		for _, be := range consensusGroup {
+			inConsensus[be] = true
+			names = append(names, be.Name)
+		}
+		res.Consensus = &AdminConsensusStatus{
+			LatestBlockNumber:    bg.Consensus.GetLatestBlockNumber(),
+			SafeBlockNumber:      bg.Consensus.GetSafeBlockNumber(),
+			FinalizedBlockNumber: bg.Consensus.GetFinalizedBlockNumber(),
+			ConsensusGroup:       names,
+		}
+	}
+
+	for _, be := range bg.Backends {
+		res.Backends = append(res.Backends, backendStatus(bg, be, inConsensus))
+	}
+	return res
+}
+
+func backendStatus(bg *BackendGroup, be *Backend, inConsensus map[*Backend]bool) *AdminBackendStatus {
+	res := &AdminBackendStatus{
+		Name:         be.Name,
+		Healthy:      be.IsHealthy(),
+		Degraded:     be.IsDegraded(),
+		ErrorRate:    be.ErrorRate(),
+		AvgLatencyMs: time.Duration(be.latencySlidingWindow.Avg()).Milliseconds(),
+	}
+	if bg.Consensus == nil {
+		return res
+	}
+
+	if inConsensus == nil {
+		inConsensus = make(map[*Backend]bool)
+		for _, member := range bg.Consensus.GetConsensusGroup() {
+			inConsensus[member] = true
+		}
+	}
+
+	bs := bg.Consensus.getBackendState(be)
+	res.Consensus = &AdminConsensusBackendStatus{
+		Banned:               bs.IsBanned(),
+		InConsensus:          inConsensus[be],
+		PeerCount:            bs.peerCount,
+		InSync:               bs.inSync,
+		LatestBlockNumber:    bs.latestBlockNumber,
+		LatestBlockHash:      bs.latestBlockHash,
+		SafeBlockNumber:      bs.safeBlockNumber,
+		FinalizedBlockNumber: bs.finalizedBlockNumber,
+		LastUpdate:           bs.lastUpdate,
+	}
+	if bs.IsBanned() {
+		bannedUntil := bs.bannedUntil
+		res.Consensus.BannedUntil = &bannedUntil
+	}
+	return res
+}
+
+func writeAdminRes(w http.ResponseWriter, statusCode int, res any) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.Error("error writing admin response", "err", err)
+	}
+}
+
+func writeAdminError(w http.ResponseWriter, statusCode int, msg string) {
+	writeAdminRes(w, statusCode, map[string]string{"error": msg})
+}
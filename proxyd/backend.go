@@ -97,6 +97,21 @@ var (
 		Message:       "block is out of range",
 		HTTPErrorCode: 400,
 	}
+	ErrOverComputeUnitRateLimit = &RPCErr{
+		Code:          JSONRPCErrorInternal - 20,
+		Message:       "over compute unit rate limit",
+		HTTPErrorCode: 429,
+	}
+	ErrOverDailyQuota = &RPCErr{
+		Code:          JSONRPCErrorInternal - 21,
+		Message:       "over daily compute unit quota",
+		HTTPErrorCode: 429,
+	}
+	ErrTxBroadcastFailed = &RPCErr{
+		Code:          JSONRPCErrorInternal - 23,
+		Message:       "transaction could not be broadcast to any backend",
+		HTTPErrorCode: 503,
+	}
 
 	ErrBackendUnexpectedJSONRPC = errors.New("backend returned an unexpected JSON-RPC response")
 
@@ -112,6 +127,17 @@ func ErrInvalidRequest(msg string) *RPCErr {
 	}
 }
 
+// ErrTxRejected is returned when a raw transaction is rejected by a TxPolicy.
+// The reason is set in the data field, so that clients can tell policies apart.
+func ErrTxRejected(reason string, msg string) *RPCErr {
+	return &RPCErr{
+		Code:          JSONRPCErrorInternal - 22,
+		Message:       "transaction rejected: " + msg,
+		Data:          reason,
+		HTTPErrorCode: 400,
+	}
+}
+
 func ErrInvalidParams(msg string) *RPCErr {
 	return &RPCErr{
 		Code:          -32602,
@@ -653,9 +679,10 @@ func sortBatchRPCResponse(req []*RPCReq, res []*RPCRes) {
 }
 
 type BackendGroup struct {
-	Name      string
-	Backends  []*Backend
-	Consensus *ConsensusPoller
+	Name        string
+	Backends    []*Backend
+	Consensus   *ConsensusPoller
+	TxBroadcast bool
 }
 
 func (bg *BackendGroup) Forward(ctx context.Context, rpcReqs []*RPCReq, isBatch bool) ([]*RPCRes, error) {
@@ -768,6 +795,103 @@ func (bg *BackendGroup) Forward(ctx context.Context, rpcReqs []*RPCReq, isBatch
 	return nil, ErrNoBackends
 }
 
+// BroadcastRawTransaction sends an eth_sendRawTransaction request to every healthy
+// backend of the group in parallel, and returns the first successful response.
+// If no backend accepts the transaction, the first error response is returned.
+// The broadcast to the remaining backends is given up after the timeout.
+func (bg *BackendGroup) BroadcastRawTransaction(ctx context.Context, req *RPCReq, timeout time.Duration) (*RPCRes, error) {
+	var backends []*Backend
+	if bg.Consensus != nil {
+		backends = bg.loadBalancedConsensusGroup()
+	} else {
+		for _, be := range bg.Backends {
+			if be.IsHealthy() {
+				backends = append(backends, be)
+			}
+		}
+	}
+	if len(backends) == 0 {
+		RecordUnserviceableRequest(ctx, RPCRequestSourceHTTP)
+		return nil, ErrNoBackends
+	}
+
+	type broadcastResult struct {
+		backend *Backend
+		res     *RPCRes
+		err     error
+	}
+
+	// The broadcast keeps going after the first success is returned to the
+	// client, so it must not be cancelled together with the request.
+	bctx, cancel := context.WithTimeout(detachedContext{ctx}, timeout)
+	results := make(chan broadcastResult, len(backends))
+	var wg sync.WaitGroup
+	for _, be := range backends {
+		wg.Add(1)
+		go func(be *Backend) {
+			defer wg.Done()
+			res, err := be.Forward(bctx, []*RPCReq{req}, false)
+			if err == nil && len(res) != 1 {
+				err = ErrBackendBadResponse
+			}
+			result := broadcastResult{backend: be, err: err}
+			if err == nil {
+				result.res = res[0]
+			}
+			results <- result
+		}(be)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	var firstErrRes *RPCRes
+	for range backends {
+		result := <-results
+		RecordTxBroadcast(result.backend, result.err == nil && !result.res.IsError())
+		if result.err != nil {
+			log.Warn(
+				"error broadcasting raw transaction to backend",
+				"name", result.backend.Name,
+				"req_id", GetReqID(ctx),
+				"err", result.err,
+			)
+			continue
+		}
+		if result.res.IsError() {
+			if firstErrRes == nil {
+				firstErrRes = result.res
+			}
+			continue
+		}
+		return result.res, nil
+	}
+
+	if firstErrRes != nil {
+		return firstErrRes, nil
+	}
+	return nil, ErrTxBroadcastFailed
+}
+
+// detachedContext keeps the values of its parent context, but not its
+// deadline or cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
 func (bg *BackendGroup) ProxyWS(ctx context.Context, clientConn *websocket.Conn, methodWhitelist *StringSet) (*WSProxier, error) {
 	for _, back := range bg.Backends {
 		proxier, err := back.ProxyWS(clientConn, methodWhitelist)
@@ -857,6 +981,8 @@ type WSProxier struct {
 	backendConn     *websocket.Conn
 	methodWhitelist *StringSet
 	clientConnMu    sync.Mutex
+	// quota is the plan that the client's calls are charged to, if any.
+	quota *quotaPlan
 }
 
 func NewWSProxier(backend *Backend, clientConn, backendConn *websocket.Conn, methodWhitelist *StringSet) *WSProxier {
@@ -944,6 +1070,25 @@ func (w *WSProxier) clientPump(ctx context.Context, errC chan error) {
 			continue
 		}
 
+		if w.quota != nil {
+			if err := w.quota.Take(ctx, GetAuthCtx(ctx), req.Method); err != nil {
+				log.Info(
+					"rejected RPC over quota",
+					"source", "ws",
+					"req_id", GetReqID(ctx),
+					"auth", GetAuthCtx(ctx),
+					"method", req.Method,
+				)
+				RecordRPCError(ctx, BackendProxyd, req.Method, err)
+				err = w.writeClientConn(msgType, mustMarshalJSON(NewRPCErrorRes(req.ID, err)))
+				if err != nil {
+					errC <- err
+					return
+				}
+				continue
+			}
+		}
+
 		RecordRPCForward(ctx, w.backend.Name, req.Method, RPCRequestSourceWS)
 		log.Info(
 			"forwarded WS message to backend",
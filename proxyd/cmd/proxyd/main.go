@@ -32,8 +32,8 @@ func main() {
 		log.Crit("must specify a config file on the command line")
 	}
 
-	config := new(proxyd.Config)
-	if _, err := toml.DecodeFile(os.Args[1], config); err != nil {
+	config, err := readConfig(os.Args[1])
+	if err != nil {
 		log.Crit("error reading config file", "err", err)
 	}
 
@@ -52,14 +52,34 @@ func main() {
 		),
 	)
 
-	_, shutdown, err := proxyd.Start(config)
+	srv, shutdown, err := proxyd.Start(config)
 	if err != nil {
 		log.Crit("error starting proxyd", "err", err)
 	}
+	srv.SetConfigSource(func() (*proxyd.Config, error) {
+		return readConfig(os.Args[1])
+	})
 
 	sig := make(chan os.Signal, 1)
-	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
-	recvSig := <-sig
-	log.Info("caught signal, shutting down", "signal", recvSig)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for recvSig := range sig {
+		if recvSig == syscall.SIGHUP {
+			log.Info("caught signal, reloading config", "signal", recvSig)
+			if err := srv.ReloadFromSource(); err != nil {
+				log.Error("error reloading config, keeping previous config", "err", err)
+			}
+			continue
+		}
+		log.Info("caught signal, shutting down", "signal", recvSig)
+		break
+	}
 	shutdown()
 }
+
+func readConfig(path string) (*proxyd.Config, error) {
+	config := new(proxyd.Config)
+	if _, err := toml.DecodeFile(path, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -40,6 +40,15 @@ type MetricsConfig struct {
 	Port    int    `toml:"port"`
 }
 
+type AdminConfig struct {
+	Enabled bool   `toml:"enabled"`
+	Host    string `toml:"host"`
+	Port    int    `toml:"port"`
+	// Token is the bearer token required on every admin request. Will be read
+	// from the environment if prefixed with $.
+	Token string `toml:"token"`
+}
+
 type RateLimitConfig struct {
 	UseRedis         bool                                `toml:"use_redis"`
 	BaseRate         int                                 `toml:"base_rate"`
@@ -56,6 +65,26 @@ type RateLimitMethodOverride struct {
 	Global   bool         `toml:"global"`
 }
 
+// QuotaConfig configures compute unit metering of authenticated keys.
+// Every method call costs a number of compute units, and the keys are
+// assigned plans that limit how many compute units they can use.
+// Methods without a configured weight cost DefaultMethodWeight, 1 if unset.
+type QuotaConfig struct {
+	UseRedis            bool                        `toml:"use_redis"`
+	DefaultMethodWeight *int                        `toml:"default_method_weight"`
+	MethodWeights       map[string]int              `toml:"method_weights"`
+	Plans               map[string]*QuotaPlanConfig `toml:"plans"`
+	// KeyPlans maps authentication aliases to the name of their plan.
+	KeyPlans map[string]string `toml:"key_plans"`
+}
+
+type QuotaPlanConfig struct {
+	ComputeUnitsPerSecond int `toml:"compute_units_per_second"`
+	DailyComputeUnits     int `toml:"daily_compute_units"`
+	// MethodWeights overrides the global method weights for this plan.
+	MethodWeights map[string]int `toml:"method_weights"`
+}
+
 type TOMLDuration time.Duration
 
 func (t *TOMLDuration) UnmarshalText(b []byte) error {
@@ -107,6 +136,9 @@ type BackendGroupConfig struct {
 	ConsensusMaxUpdateThreshold TOMLDuration `toml:"consensus_max_update_threshold"`
 	ConsensusMaxBlockLag        uint64       `toml:"consensus_max_block_lag"`
 	ConsensusMinPeerCount       int          `toml:"consensus_min_peer_count"`
+
+	// TxBroadcast sends eth_sendRawTransaction to every healthy backend of the group.
+	TxBroadcast bool `toml:"tx_broadcast"`
 }
 
 type BackendGroupsConfig map[string]*BackendGroupConfig
@@ -126,12 +158,25 @@ type SenderRateLimitConfig struct {
 	Limit    int
 }
 
+// TxPolicyConfig configures the policies that raw transactions must pass
+// before they are forwarded. Empty values disable the respective policy.
+type TxPolicyConfig struct {
+	ChainIDs []uint64 `toml:"chain_ids"`
+	// AllowedDestinations rejects transactions to any other address, and contract creations.
+	AllowedDestinations []string `toml:"allowed_destinations"`
+	DeniedDestinations  []string `toml:"denied_destinations"`
+	MinTipWei           uint64   `toml:"min_tip_wei"`
+	MaxCalldataSize     int      `toml:"max_calldata_size"`
+	BlockedSenders      []string `toml:"blocked_senders"`
+}
+
 type Config struct {
 	WSBackendGroup        string                `toml:"ws_backend_group"`
 	Server                ServerConfig          `toml:"server"`
 	Cache                 CacheConfig           `toml:"cache"`
 	Redis                 RedisConfig           `toml:"redis"`
 	Metrics               MetricsConfig         `toml:"metrics"`
+	Admin                 AdminConfig           `toml:"admin"`
 	RateLimit             RateLimitConfig       `toml:"rate_limit"`
 	BackendOptions        BackendOptions        `toml:"backend"`
 	Backends              BackendsConfig        `toml:"backends"`
@@ -142,6 +187,8 @@ type Config struct {
 	WSMethodWhitelist     []string              `toml:"ws_method_whitelist"`
 	WhitelistErrorMessage string                `toml:"whitelist_error_message"`
 	SenderRateLimit       SenderRateLimitConfig `toml:"sender_rate_limit"`
+	Quotas                QuotaConfig           `toml:"quotas"`
+	TxPolicy              TxPolicyConfig        `toml:"tx_policy"`
 }
 
 func ReadFromEnvOrConfig(value string) (string, error) {
@@ -18,6 +18,15 @@ type FrontendRateLimiter interface {
 	// No error will be returned if the limit could not be taken
 	// as a result of the requestor being over the limit.
 	Take(ctx context.Context, key string) (bool, error)
+
+	// TakeN consumes n units of a key at once. Unlike Take,
+	// the units are only consumed if they fit within the limit,
+	// so that requests that are rejected are not counted.
+	TakeN(ctx context.Context, key string, n int) (bool, error)
+
+	// ReturnN gives back n units of a key that were consumed
+	// by TakeN in the current time interval.
+	ReturnN(ctx context.Context, key string, n int) error
 }
 
 // limitedKeys is a wrapper around a map that stores a truncated
@@ -48,6 +57,28 @@ func (l *limitedKeys) Take(key string, max int) bool {
 	return val < max
 }
 
+func (l *limitedKeys) TakeN(key string, n int, max int) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	val := l.keys[key]
+	if val+n > max {
+		return false
+	}
+	l.keys[key] = val + n
+	return true
+}
+
+func (l *limitedKeys) ReturnN(key string, n int) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	// The units may have been taken in a previous generation
+	if val := l.keys[key]; val > n {
+		l.keys[key] = val - n
+	} else {
+		delete(l.keys, key)
+	}
+}
+
 // MemoryFrontendRateLimiter is a rate limiter that stores
 // all rate limiting information in local memory. It works
 // by storing a limitedKeys struct that references the
@@ -70,7 +101,21 @@ func NewMemoryFrontendRateLimit(dur time.Duration, max int) FrontendRateLimiter
 }
 
 func (m *MemoryFrontendRateLimiter) Take(ctx context.Context, key string) (bool, error) {
+	return m.generation().Take(key, m.max), nil
+}
+
+func (m *MemoryFrontendRateLimiter) TakeN(ctx context.Context, key string, n int) (bool, error) {
+	return m.generation().TakeN(key, n, m.max), nil
+}
+
+func (m *MemoryFrontendRateLimiter) ReturnN(ctx context.Context, key string, n int) error {
+	m.generation().ReturnN(key, n)
+	return nil
+}
+
+func (m *MemoryFrontendRateLimiter) generation() *limitedKeys {
 	m.mtx.Lock()
+	defer m.mtx.Unlock()
 	// Create truncated timestamp
 	truncTS := truncateNow(m.dur)
 
@@ -80,12 +125,8 @@ func (m *MemoryFrontendRateLimiter) Take(ctx context.Context, key string) (bool,
 		m.currGeneration = newLimitedKeys(truncTS)
 	}
 
-	// Pull out the limiter so we can unlock before incrementing the limit.
-	limiter := m.currGeneration
-
-	m.mtx.Unlock()
-
-	return limiter.Take(key, m.max), nil
+	// Return the limiter so we can unlock before incrementing the limit.
+	return m.currGeneration
 }
 
 // RedisFrontendRateLimiter is a rate limiter that stores data in Redis.
@@ -124,6 +165,50 @@ func (r *RedisFrontendRateLimiter) Take(ctx context.Context, key string) (bool,
 	return incr.Val()-1 < int64(r.max), nil
 }
 
+func (r *RedisFrontendRateLimiter) TakeN(ctx context.Context, key string, n int) (bool, error) {
+	var incr *redis.IntCmd
+	truncTS := truncateNow(r.dur)
+	fullKey := fmt.Sprintf("rate_limit:%s:%s:%d", r.prefix, key, truncTS)
+	_, err := r.r.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.IncrBy(ctx, fullKey, int64(n))
+		pipe.PExpire(ctx, fullKey, r.dur-time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		frontendRateLimitTakeErrors.Inc()
+		return false, err
+	}
+
+	if incr.Val() <= int64(r.max) {
+		return true, nil
+	}
+
+	// Give the units back, so that rejected requests don't count against the limit.
+	if err := r.r.DecrBy(ctx, fullKey, int64(n)).Err(); err != nil {
+		frontendRateLimitTakeErrors.Inc()
+		return false, err
+	}
+	return false, nil
+}
+
+func (r *RedisFrontendRateLimiter) ReturnN(ctx context.Context, key string, n int) error {
+	truncTS := truncateNow(r.dur)
+	fullKey := fmt.Sprintf("rate_limit:%s:%s:%d", r.prefix, key, truncTS)
+	val, err := r.r.DecrBy(ctx, fullKey, int64(n)).Result()
+	if err != nil {
+		frontendRateLimitTakeErrors.Inc()
+		return err
+	}
+	// The units may have been taken in a previous interval, don't credit them to this one.
+	if val < 0 {
+		if err := r.r.IncrBy(ctx, fullKey, -val).Err(); err != nil {
+			frontendRateLimitTakeErrors.Inc()
+			return err
+		}
+	}
+	return nil
+}
+
 type noopFrontendRateLimiter struct{}
 
 var NoopFrontendRateLimiter = &noopFrontendRateLimiter{}
@@ -132,6 +217,14 @@ func (n *noopFrontendRateLimiter) Take(ctx context.Context, key string) (bool, e
 	return true, nil
 }
 
+func (n *noopFrontendRateLimiter) TakeN(ctx context.Context, key string, units int) (bool, error) {
+	return true, nil
+}
+
+func (n *noopFrontendRateLimiter) ReturnN(ctx context.Context, key string, units int) error {
+	return nil
+}
+
 // truncateNow truncates the current timestamp
 // to the specified duration.
 func truncateNow(dur time.Duration) int64 {
@@ -51,3 +51,63 @@ func TestFrontendRateLimiter(t *testing.T) {
 		})
 	}
 }
+
+func TestFrontendRateLimiterTakeN(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	defer redisServer.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf("127.0.0.1:%s", redisServer.Port()),
+	})
+
+	max := 10
+	lims := []struct {
+		name string
+		frl  FrontendRateLimiter
+	}{
+		{"memory", NewMemoryFrontendRateLimit(time.Hour, max)},
+		{"redis", NewRedisFrontendRateLimiter(redisClient, time.Hour, max, "")},
+	}
+
+	for _, cfg := range lims {
+		frl := cfg.frl
+		ctx := context.Background()
+		t.Run(cfg.name, func(t *testing.T) {
+			ok, err := frl.TakeN(ctx, "foo", 6)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			// rejected takes don't consume any units
+			ok, err = frl.TakeN(ctx, "foo", 6)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			ok, err = frl.TakeN(ctx, "foo", 4)
+			require.NoError(t, err)
+			require.True(t, ok)
+			ok, err = frl.TakeN(ctx, "foo", 1)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			ok, err = frl.TakeN(ctx, "bar", 10)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			// returned units can be taken again
+			require.NoError(t, frl.ReturnN(ctx, "foo", 3))
+			ok, err = frl.TakeN(ctx, "foo", 3)
+			require.NoError(t, err)
+			require.True(t, ok)
+			ok, err = frl.TakeN(ctx, "foo", 1)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			// units are never returned beyond what was taken
+			require.NoError(t, frl.ReturnN(ctx, "baz", 5))
+			ok, err = frl.TakeN(ctx, "baz", 11)
+			require.NoError(t, err)
+			require.False(t, ok)
+		})
+	}
+}
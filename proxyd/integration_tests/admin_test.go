@@ -0,0 +1,181 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/proxyd"
+	"github.com/stretchr/testify/require"
+)
+
+const adminToken = "admin-token"
+
+func sendAdminRequest(t *testing.T, method string, path string, token string) ([]byte, int) {
+	req, err := http.NewRequest(method, "http://127.0.0.1:8546"+path, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return body, res.StatusCode
+}
+
+func setupAdmin(t *testing.T) (*MockBackend, *MockBackend, *proxyd.Server, func()) {
+	firstBackend := NewMockBackend(BatchedResponseHandler(200, goodResponse))
+	secondBackend := NewMockBackend(BatchedResponseHandler(200, goodResponse))
+
+	require.NoError(t, os.Setenv("FIRST_BACKEND_RPC_URL", firstBackend.URL()))
+	require.NoError(t, os.Setenv("SECOND_BACKEND_RPC_URL", secondBackend.URL()))
+	require.NoError(t, os.Setenv("ADMIN_TOKEN", adminToken))
+
+	srv, shutdown, err := proxyd.Start(ReadConfig("admin"))
+	require.NoError(t, err)
+
+	return firstBackend, secondBackend, srv, func() {
+		shutdown()
+		firstBackend.Close()
+		secondBackend.Close()
+	}
+}
+
+func TestAdminReload(t *testing.T) {
+	firstBackend, secondBackend, srv, shutdown := setupAdmin(t)
+	defer shutdown()
+
+	client := NewProxydClient("http://127.0.0.1:8545")
+
+	_, code, err := client.SendRPC("eth_chainId", nil)
+	require.NoError(t, err)
+	require.Equal(t, 200, code)
+	require.Equal(t, 1, len(firstBackend.Requests()))
+	require.Equal(t, 0, len(secondBackend.Requests()))
+
+	// eth_blockNumber is only mapped in the reloaded config
+	_, code, err = client.SendRPC("eth_blockNumber", nil)
+	require.NoError(t, err)
+	require.Equal(t, 403, code)
+
+	t.Run("rejects unauthorized requests", func(t *testing.T) {
+		_, code := sendAdminRequest(t, "POST", "/reload", "wrong-token")
+		require.Equal(t, 401, code)
+	})
+
+	t.Run("fails without config source", func(t *testing.T) {
+		_, code := sendAdminRequest(t, "POST", "/reload", adminToken)
+		require.Equal(t, 500, code)
+	})
+
+	t.Run("keeps the previous config on error", func(t *testing.T) {
+		srv.SetConfigSource(func() (*proxyd.Config, error) {
+			return nil, errors.New("bad config")
+		})
+		_, code := sendAdminRequest(t, "POST", "/reload", adminToken)
+		require.Equal(t, 500, code)
+
+		firstBackend.Reset()
+		_, code, err := client.SendRPC("eth_chainId", nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, code)
+		require.Equal(t, 1, len(firstBackend.Requests()))
+	})
+
+	t.Run("reloads backends and method mappings", func(t *testing.T) {
+		srv.SetConfigSource(func() (*proxyd.Config, error) {
+			return ReadConfig("admin_reloaded"), nil
+		})
+		_, code := sendAdminRequest(t, "POST", "/reload", adminToken)
+		require.Equal(t, 200, code)
+
+		firstBackend.Reset()
+		secondBackend.Reset()
+		_, code, err := client.SendRPC("eth_chainId", nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, code)
+		_, code, err = client.SendRPC("eth_blockNumber", nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, code)
+		require.Equal(t, 0, len(firstBackend.Requests()))
+		require.Equal(t, 2, len(secondBackend.Requests()))
+		require.Equal(t, []string{"second"}, []string{srv.BackendGroups()["main"].Backends[0].Name})
+	})
+}
+
+func TestAdminBackendGroups(t *testing.T) {
+	_, _, _, shutdown := setupAdmin(t)
+	defer shutdown()
+
+	getGroup := func(t *testing.T) *proxyd.AdminBackendGroupStatus {
+		body, code := sendAdminRequest(t, "GET", "/backend_groups/consensus", adminToken)
+		require.Equal(t, 200, code)
+		var status proxyd.AdminBackendGroupStatus
+		require.NoError(t, json.Unmarshal(body, &status))
+		return &status
+	}
+
+	t.Run("lists backend groups", func(t *testing.T) {
+		body, code := sendAdminRequest(t, "GET", "/backend_groups", adminToken)
+		require.Equal(t, 200, code)
+		var groups []*proxyd.AdminBackendGroupStatus
+		require.NoError(t, json.Unmarshal(body, &groups))
+		require.Equal(t, 2, len(groups))
+		require.Equal(t, "consensus", groups[0].Name)
+		require.NotNil(t, groups[0].Consensus)
+		require.Equal(t, "main", groups[1].Name)
+		require.Nil(t, groups[1].Consensus)
+		require.Equal(t, "first", groups[1].Backends[0].Name)
+		require.True(t, groups[1].Backends[0].Healthy)
+	})
+
+	t.Run("bans and unbans a backend", func(t *testing.T) {
+		status := getGroup(t)
+		require.False(t, status.Backends[1].Consensus.Banned)
+
+		_, code := sendAdminRequest(t, "POST", "/backend_groups/consensus/backends/second/ban", adminToken)
+		require.Equal(t, 200, code)
+		status = getGroup(t)
+		require.False(t, status.Backends[0].Consensus.Banned)
+		require.True(t, status.Backends[1].Consensus.Banned)
+		require.NotNil(t, status.Backends[1].Consensus.BannedUntil)
+
+		_, code = sendAdminRequest(t, "POST", "/backend_groups/consensus/backends/second/unban", adminToken)
+		require.Equal(t, 200, code)
+		status = getGroup(t)
+		require.False(t, status.Backends[1].Consensus.Banned)
+	})
+
+	t.Run("rejects bans outside consensus groups", func(t *testing.T) {
+		_, code := sendAdminRequest(t, "POST", "/backend_groups/main/backends/first/ban", adminToken)
+		require.Equal(t, 400, code)
+		_, code = sendAdminRequest(t, "POST", "/backend_groups/consensus/backends/unknown/ban", adminToken)
+		require.Equal(t, 404, code)
+	})
+}
+
+func TestAdminMissingToken(t *testing.T) {
+	backend := NewMockBackend(BatchedResponseHandler(200, goodResponse))
+	defer backend.Close()
+
+	require.NoError(t, os.Setenv("FIRST_BACKEND_RPC_URL", backend.URL()))
+	require.NoError(t, os.Setenv("SECOND_BACKEND_RPC_URL", backend.URL()))
+	require.NoError(t, os.Setenv("ADMIN_TOKEN", adminToken))
+
+	config := ReadConfig("admin")
+	config.Admin.Token = ""
+	_, _, err := proxyd.Start(config)
+	require.ErrorContains(t, err, "must define a token for the admin server")
+
+	// no server was left listening on the ports of the failed start
+	_, shutdown, err := proxyd.Start(ReadConfig("admin"))
+	require.NoError(t, err)
+	defer shutdown()
+	client := NewProxydClient("http://127.0.0.1:8545")
+	_, code, err := client.SendRPC(ethChainID, nil)
+	require.NoError(t, err)
+	require.Equal(t, 200, code)
+}
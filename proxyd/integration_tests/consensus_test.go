@@ -59,7 +59,7 @@ func setup(t *testing.T) (map[string]nodeContext, *proxyd.BackendGroup, *ProxydH
 	client := NewProxydClient("http://127.0.0.1:8545")
 
 	// expose the backend group
-	bg := svr.BackendGroups["node"]
+	bg := svr.BackendGroups()["node"]
 	require.NotNil(t, bg)
 	require.NotNil(t, bg.Consensus)
 	require.Equal(t, 2, len(bg.Backends)) // should match config
@@ -0,0 +1,155 @@
+package integration_tests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/proxyd"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	overDailyQuotaResponse      = `{"error":{"code":-32021,"message":"over daily compute unit quota"},"id":999,"jsonrpc":"2.0"}`
+	overComputeUnitRateResponse = `{"error":{"code":-32020,"message":"over compute unit rate limit"},"id":999,"jsonrpc":"2.0"}`
+)
+
+func TestQuotas(t *testing.T) {
+	goodBackend := NewMockBackend(BatchedResponseHandler(200, goodResponse))
+	defer goodBackend.Close()
+
+	require.NoError(t, os.Setenv("GOOD_BACKEND_RPC_URL", goodBackend.URL()))
+
+	config := ReadConfig("quotas")
+	_, shutdown, err := proxyd.Start(config)
+	require.NoError(t, err)
+	defer shutdown()
+
+	t.Run("charges method weights against the daily quota", func(t *testing.T) {
+		client := NewProxydClient("http://127.0.0.1:8545/secret_partner")
+
+		// 5 + 5 units fit in the quota of 10
+		for i := 0; i < 2; i++ {
+			res, code, err := client.SendRPC("eth_getLogs", nil)
+			require.NoError(t, err)
+			require.Equal(t, 200, code)
+			RequireEqualJSON(t, []byte(goodResponse), res)
+		}
+
+		res, code, err := client.SendRPC("eth_getLogs", nil)
+		require.NoError(t, err)
+		require.Equal(t, 429, code)
+		RequireEqualJSON(t, []byte(overDailyQuotaResponse), res)
+
+		res, code, err = client.SendRPC("eth_chainId", nil)
+		require.NoError(t, err)
+		require.Equal(t, 429, code)
+		RequireEqualJSON(t, []byte(overDailyQuotaResponse), res)
+	})
+
+	t.Run("limits compute units per second", func(t *testing.T) {
+		client := NewProxydClient("http://127.0.0.1:8545/secret_free")
+
+		// the default weight of 2 is charged for eth_call, so only one fits in 3 units per second
+		_, codes := spamReqs(t, client, "eth_call", 429, 2)
+		require.Equal(t, 1, codes[200])
+		require.Equal(t, 1, codes[429])
+
+		// eth_chainId is free for this plan
+		_, codes = spamReqs(t, client, ethChainID, 429, 5)
+		require.Equal(t, 5, codes[200])
+	})
+
+	t.Run("rejects batch elements over quota", func(t *testing.T) {
+		client := NewProxydClient("http://127.0.0.1:8545/secret_free")
+		// wait for the units used by the previous test to be available again
+		time.Sleep(time.Second)
+		// eth_getLogs costs all 3 units per second of the plan, so the second call is rejected
+		res, code, err := client.SendBatchRPC(
+			NewRPCReq("999", "eth_getLogs", nil),
+			NewRPCReq("999", "eth_getLogs", nil),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 200, code)
+		RequireEqualJSON(t, []byte(asArray(goodResponse, overComputeUnitRateResponse)), res)
+	})
+
+	t.Run("does not charge batch elements rejected by tx policies", func(t *testing.T) {
+		client := NewProxydClient("http://127.0.0.1:8545/secret_sender")
+
+		// txHex2 is signed for chain ID 10, so every tx is rejected before it is charged
+		txs := make([]*proxyd.RPCReq, 0, 5)
+		expected := make([]string, 0, 5)
+		for i := 0; i < 5; i++ {
+			txs = append(txs, NewRPCReq("1", "eth_sendRawTransaction", []interface{}{txHex2}))
+			expected = append(expected, txChainIDError)
+		}
+		res, code, err := client.SendBatchRPC(txs...)
+		require.NoError(t, err)
+		require.Equal(t, 200, code)
+		RequireEqualJSON(t, []byte(asArray(expected...)), res)
+
+		// the quota of 10 still fits 5 + 5 units
+		for i := 0; i < 2; i++ {
+			res, code, err := client.SendRPC("eth_getLogs", nil)
+			require.NoError(t, err)
+			require.Equal(t, 200, code)
+			RequireEqualJSON(t, []byte(goodResponse), res)
+		}
+	})
+
+	t.Run("keys without a plan are not metered", func(t *testing.T) {
+		client := NewProxydClient("http://127.0.0.1:8545/secret_unmetered")
+		_, codes := spamReqs(t, client, "eth_getLogs", 429, 5)
+		require.Equal(t, 5, codes[200])
+	})
+}
+
+func TestQuotasWS(t *testing.T) {
+	backend := NewMockWSBackend(nil, func(conn *websocket.Conn, msgType int, data []byte) {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(goodResponse))
+	}, nil)
+	defer backend.Close()
+
+	require.NoError(t, os.Setenv("GOOD_BACKEND_RPC_URL", backend.URL()))
+
+	config := ReadConfig("quotas")
+	_, shutdown, err := proxyd.Start(config)
+	require.NoError(t, err)
+	defer shutdown()
+
+	msgs := make(chan string, 3)
+	client, err := NewProxydWSClient("ws://127.0.0.1:8546/secret_partner", func(msgType int, data []byte) {
+		msgs <- string(data)
+	}, nil)
+	require.NoError(t, err)
+	defer client.HardClose()
+
+	// 5 + 5 units fit in the quota of 10, the third call is rejected
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "method": "eth_getLogs", "id": 999}`)))
+		select {
+		case msg := <-msgs:
+			if i < 2 {
+				RequireEqualJSON(t, []byte(goodResponse), []byte(msg))
+			} else {
+				RequireEqualJSON(t, []byte(overDailyQuotaResponse), []byte(msg))
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for response")
+		}
+	}
+}
+
+func TestQuotasInvalidConfig(t *testing.T) {
+	config := ReadConfig("quotas")
+	config.Quotas.KeyPlans["partner"] = "unknown"
+	_, _, err := proxyd.Start(config)
+	require.ErrorContains(t, err, "undefined quota plan")
+
+	config = ReadConfig("quotas")
+	config.Quotas.KeyPlans["nobody"] = "partner"
+	_, _, err = proxyd.Start(config)
+	require.ErrorContains(t, err, "undefined authentication alias")
+}
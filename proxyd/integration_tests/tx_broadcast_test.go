@@ -0,0 +1,96 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/proxyd"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	txAcceptedRes  = `{"jsonrpc": "2.0", "result": "0xb1b8ffa1a1b0d4a8a0e2b3f4b02a2e44fbd67a0c4a4bcdb8e4c0e4a4b5a5a1a1", "id": 1}`
+	txNonceLowRes  = `{"jsonrpc": "2.0", "error": {"code": -32000, "message": "nonce too low"}, "id": 1}`
+	txChainIDError = `{"jsonrpc":"2.0","error":{"code":-32022,"message":"transaction rejected: chain ID 10 is not allowed","data":"invalid_chain_id"},"id":1}`
+)
+
+func TestTxBroadcast(t *testing.T) {
+	firstBackend := NewMockBackend(nil)
+	defer firstBackend.Close()
+	secondBackend := NewMockBackend(nil)
+	defer secondBackend.Close()
+
+	require.NoError(t, os.Setenv("FIRST_BACKEND_RPC_URL", firstBackend.URL()))
+	require.NoError(t, os.Setenv("SECOND_BACKEND_RPC_URL", secondBackend.URL()))
+
+	config := ReadConfig("tx_broadcast")
+	client := NewProxydClient("http://127.0.0.1:8545")
+	_, shutdown, err := proxyd.Start(config)
+	require.NoError(t, err)
+	defer shutdown()
+
+	sendTx := func(txHex string) ([]byte, int) {
+		req := NewRPCReq("1", "eth_sendRawTransaction", []interface{}{txHex})
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		res, code, err := client.SendRequest(body)
+		require.NoError(t, err)
+		return res, code
+	}
+
+	t.Run("returns the first success", func(t *testing.T) {
+		firstBackend.Reset()
+		secondBackend.Reset()
+		firstBackend.SetHandler(SingleResponseHandler(200, txNonceLowRes))
+		secondBackend.SetHandler(SingleResponseHandler(200, txAcceptedRes))
+
+		res, code := sendTx(txHex1)
+		require.Equal(t, 200, code)
+		RequireEqualJSON(t, []byte(txAcceptedRes), res)
+		require.Equal(t, 1, len(firstBackend.Requests()))
+		require.Equal(t, 1, len(secondBackend.Requests()))
+	})
+
+	t.Run("returns an error when every backend rejects the tx", func(t *testing.T) {
+		firstBackend.Reset()
+		secondBackend.Reset()
+		firstBackend.SetHandler(SingleResponseHandler(200, txNonceLowRes))
+		secondBackend.SetHandler(SingleResponseHandler(200, txNonceLowRes))
+
+		res, code := sendTx(txHex1)
+		require.Equal(t, 200, code)
+		RequireEqualJSON(t, []byte(txNonceLowRes), res)
+	})
+
+	t.Run("rejects txs by policy", func(t *testing.T) {
+		firstBackend.Reset()
+		secondBackend.Reset()
+		firstBackend.SetHandler(SingleResponseHandler(200, txAcceptedRes))
+		secondBackend.SetHandler(SingleResponseHandler(200, txAcceptedRes))
+
+		// txHex2 is signed for chain ID 10
+		res, code := sendTx(txHex2)
+		require.Equal(t, 400, code)
+		RequireEqualJSON(t, []byte(txChainIDError), res)
+		require.Equal(t, 0, len(firstBackend.Requests()))
+		require.Equal(t, 0, len(secondBackend.Requests()))
+	})
+
+	t.Run("broadcasts txs in batches", func(t *testing.T) {
+		firstBackend.Reset()
+		secondBackend.Reset()
+		firstBackend.SetHandler(SingleResponseHandler(200, txAcceptedRes))
+		secondBackend.SetHandler(SingleResponseHandler(200, txAcceptedRes))
+
+		res, code, err := client.SendBatchRPC(
+			NewRPCReq("1", "eth_sendRawTransaction", []interface{}{txHex1}),
+			NewRPCReq("1", "eth_sendRawTransaction", []interface{}{txHex2}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 200, code)
+		RequireEqualJSON(t, []byte(asArray(txAcceptedRes, txChainIDError)), res)
+		require.Equal(t, 1, len(firstBackend.Requests()))
+		require.Equal(t, 1, len(secondBackend.Requests()))
+	})
+}
@@ -373,6 +373,51 @@ var (
 	}, []string{
 		"backend_name",
 	})
+
+	apiKeyComputeUnitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "api_key_compute_units_total",
+		Help:      "Count of compute units charged to each API key, by method.",
+	}, []string{
+		"auth",
+		"plan",
+		"method_name",
+	})
+
+	apiKeyQuotaExceededTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "api_key_quota_exceeded_total",
+		Help:      "Count of requests rejected because the API key was over its quota.",
+	}, []string{
+		"auth",
+		"plan",
+		"window",
+	})
+
+	txPolicyRejectionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "tx_policy_rejections_total",
+		Help:      "Count of raw transactions rejected by the transaction policies, by reason.",
+	}, []string{
+		"reason",
+	})
+
+	txBroadcastsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "tx_broadcasts_total",
+		Help:      "Count of raw transactions broadcast to each backend, by result.",
+	}, []string{
+		"backend_name",
+		"success",
+	})
+
+	configReloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "config_reloads_total",
+		Help:      "Count of config reloads, by result.",
+	}, []string{
+		"result",
+	})
 )
 
 func RecordRedisError(source string) {
@@ -508,6 +553,30 @@ func RecordBackendNetworkErrorRateSlidingWindow(b *Backend, rate float64) {
 	networkErrorRateBackend.WithLabelValues(b.Name).Set(rate)
 }
 
+func RecordComputeUnits(auth, plan, method string, units int) {
+	apiKeyComputeUnitsTotal.WithLabelValues(auth, plan, method).Add(float64(units))
+}
+
+func RecordQuotaExceeded(auth, plan, window string) {
+	apiKeyQuotaExceededTotal.WithLabelValues(auth, plan, window).Inc()
+}
+
+func RecordTxPolicyRejection(reason string) {
+	txPolicyRejectionsTotal.WithLabelValues(reason).Inc()
+}
+
+func RecordTxBroadcast(b *Backend, success bool) {
+	txBroadcastsTotal.WithLabelValues(b.Name, strconv.FormatBool(success)).Inc()
+}
+
+func RecordConfigReload(err error) {
+	if err != nil {
+		configReloadsTotal.WithLabelValues("error").Inc()
+		return
+	}
+	configReloadsTotal.WithLabelValues("success").Inc()
+}
+
 func boolToFloat64(b bool) float64 {
 	if b {
 		return 1
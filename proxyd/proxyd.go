@@ -16,20 +16,8 @@ import (
 )
 
 func Start(config *Config) (*Server, func(), error) {
-	if len(config.Backends) == 0 {
-		return nil, nil, errors.New("must define at least one backend")
-	}
-	if len(config.BackendGroups) == 0 {
-		return nil, nil, errors.New("must define at least one backend group")
-	}
-	if len(config.RPCMethodMappings) == 0 {
-		return nil, nil, errors.New("must define at least one RPC method mapping")
-	}
-
-	for authKey := range config.Authentication {
-		if authKey == "none" {
-			return nil, nil, errors.New("cannot use none as an auth key")
-		}
+	if err := validateRoutingConfig(config); err != nil {
+		return nil, nil, err
 	}
 
 	var redisClient *redis.Client
@@ -47,6 +35,9 @@ func Start(config *Config) (*Server, func(), error) {
 	if redisClient == nil && config.RateLimit.UseRedis {
 		return nil, nil, errors.New("must specify a Redis URL if UseRedis is true in rate limit config")
 	}
+	if redisClient == nil && config.Quotas.UseRedis {
+		return nil, nil, errors.New("must specify a Redis URL if UseRedis is true in quotas config")
+	}
 
 	// While modifying shared globals is a bad practice, the alternative
 	// is to clone these errors on every invocation. This is inefficient.
@@ -62,21 +53,198 @@ func Start(config *Config) (*Server, func(), error) {
 		ErrTooManyBatchRequests.Message = config.BatchConfig.ErrorMessage
 	}
 
+	maxConcurrentRPCs := config.Server.MaxConcurrentRPCs
+	if maxConcurrentRPCs == 0 {
+		maxConcurrentRPCs = math.MaxInt64
+	}
+	rpcRequestSemaphore := semaphore.NewWeighted(maxConcurrentRPCs)
+
+	backendGroups, err := buildBackendGroups(config, rpcRequestSemaphore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wsBackendGroup *BackendGroup
+	if config.WSBackendGroup != "" {
+		wsBackendGroup = backendGroups[config.WSBackendGroup]
+		if wsBackendGroup == nil {
+			return nil, nil, fmt.Errorf("ws backend group %s does not exist", config.WSBackendGroup)
+		}
+	}
+
+	if wsBackendGroup == nil && config.Server.WSPort != 0 {
+		return nil, nil, fmt.Errorf("a ws port was defined, but no ws group was defined")
+	}
+
+	resolvedAuth, err := resolveAuthentication(config.Authentication)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var adminToken string
+	if config.Admin.Enabled {
+		adminToken, err = ReadFromEnvOrConfig(config.Admin.Token)
+		if err != nil {
+			return nil, nil, err
+		}
+		if adminToken == "" {
+			return nil, nil, errors.New("must define a token for the admin server")
+		}
+	}
+
+	var (
+		cache    Cache
+		rpcCache RPCCache
+	)
+	if config.Cache.Enabled {
+		if redisClient == nil {
+			log.Warn("redis is not configured, using in-memory cache")
+			cache = newMemoryCache()
+		} else {
+			cache = newRedisCache(redisClient, config.Redis.Namespace)
+		}
+		rpcCache = newRPCCache(newCacheWithCompression(cache))
+	}
+
+	srv, err := NewServer(
+		backendGroups,
+		wsBackendGroup,
+		NewStringSetFromStrings(config.WSMethodWhitelist),
+		config.RPCMethodMappings,
+		config.Server.MaxBodySizeBytes,
+		resolvedAuth,
+		secondsToDuration(config.Server.TimeoutSeconds),
+		config.Server.MaxUpstreamBatchSize,
+		rpcCache,
+		config.RateLimit,
+		config.SenderRateLimit,
+		config.Quotas,
+		config.TxPolicy,
+		config.Server.EnableRequestLog,
+		config.Server.MaxRequestBodyLogLen,
+		config.BatchConfig.MaxSize,
+		redisClient,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating server: %w", err)
+	}
+	srv.rpcRequestSemaphore = rpcRequestSemaphore
+
+	if config.Metrics.Enabled {
+		addr := fmt.Sprintf("%s:%d", config.Metrics.Host, config.Metrics.Port)
+		log.Info("starting metrics server", "addr", addr)
+		go func() {
+			if err := http.ListenAndServe(addr, promhttp.Handler()); err != nil {
+				log.Error("error starting metrics server", "err", err)
+			}
+		}()
+	}
+
+	// To allow integration tests to cleanly come up, wait
+	// 10ms to give the below goroutines enough time to
+	// encounter an error creating their servers
+	errTimer := time.NewTimer(10 * time.Millisecond)
+
+	if config.Server.RPCPort != 0 {
+		go func() {
+			if err := srv.RPCListenAndServe(config.Server.RPCHost, config.Server.RPCPort); err != nil {
+				if errors.Is(err, http.ErrServerClosed) {
+					log.Info("RPC server shut down")
+					return
+				}
+				log.Crit("error starting RPC server", "err", err)
+			}
+		}()
+	}
+
+	if config.Server.WSPort != 0 {
+		go func() {
+			if err := srv.WSListenAndServe(config.Server.WSHost, config.Server.WSPort); err != nil {
+				if errors.Is(err, http.ErrServerClosed) {
+					log.Info("WS server shut down")
+					return
+				}
+				log.Crit("error starting WS server", "err", err)
+			}
+		}()
+	} else {
+		log.Info("WS server not enabled (ws_port is set to 0)")
+	}
+
+	if config.Admin.Enabled {
+		go func() {
+			if err := srv.AdminListenAndServe(config.Admin.Host, config.Admin.Port, adminToken); err != nil {
+				if errors.Is(err, http.ErrServerClosed) {
+					log.Info("admin server shut down")
+					return
+				}
+				log.Crit("error starting admin server", "err", err)
+			}
+		}()
+	}
+
+	startConsensusPollers(config, backendGroups)
+
+	<-errTimer.C
+	log.Info("started proxyd")
+
+	shutdownFunc := func() {
+		log.Info("shutting down proxyd")
+		srv.Shutdown()
+		log.Info("goodbye")
+	}
+
+	return srv, shutdownFunc, nil
+}
+
+// validateRoutingConfig checks the sections of the config that can be
+// changed by a reload.
+func validateRoutingConfig(config *Config) error {
+	if len(config.Backends) == 0 {
+		return errors.New("must define at least one backend")
+	}
+	if len(config.BackendGroups) == 0 {
+		return errors.New("must define at least one backend group")
+	}
+	if len(config.RPCMethodMappings) == 0 {
+		return errors.New("must define at least one RPC method mapping")
+	}
+
+	for authKey := range config.Authentication {
+		if authKey == "none" {
+			return errors.New("cannot use none as an auth key")
+		}
+	}
+
 	if config.SenderRateLimit.Enabled {
 		if config.SenderRateLimit.Limit <= 0 {
-			return nil, nil, errors.New("limit in sender_rate_limit must be > 0")
+			return errors.New("limit in sender_rate_limit must be > 0")
 		}
 		if time.Duration(config.SenderRateLimit.Interval) < time.Second {
-			return nil, nil, errors.New("interval in sender_rate_limit must be >= 1s")
+			return errors.New("interval in sender_rate_limit must be >= 1s")
 		}
 	}
 
-	maxConcurrentRPCs := config.Server.MaxConcurrentRPCs
-	if maxConcurrentRPCs == 0 {
-		maxConcurrentRPCs = math.MaxInt64
+	for _, bg := range config.BackendGroups {
+		for _, bName := range bg.Backends {
+			if config.Backends[bName] == nil {
+				return fmt.Errorf("backend %s is not defined", bName)
+			}
+		}
+	}
+
+	for _, bg := range config.RPCMethodMappings {
+		if config.BackendGroups[bg] == nil {
+			return fmt.Errorf("undefined backend group %s", bg)
+		}
 	}
-	rpcRequestSemaphore := semaphore.NewWeighted(maxConcurrentRPCs)
 
+	return validateQuotaConfig(config.Quotas, config.Authentication)
+}
+
+// buildBackendGroups creates the backends and backend groups defined in the config.
+// Consensus pollers are not started, see startConsensusPollers.
+func buildBackendGroups(config *Config, rpcRequestSemaphore *semaphore.Weighted) (map[string]*BackendGroup, error) {
 	backendNames := make([]string, 0)
 	backendsByName := make(map[string]*Backend)
 	for name, cfg := range config.Backends {
@@ -84,14 +252,14 @@ func Start(config *Config) (*Server, func(), error) {
 
 		rpcURL, err := ReadFromEnvOrConfig(cfg.RPCURL)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		wsURL, err := ReadFromEnvOrConfig(cfg.WSURL)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		if rpcURL == "" {
-			return nil, nil, fmt.Errorf("must define an RPC URL for backend %s", name)
+			return nil, fmt.Errorf("must define an RPC URL for backend %s", name)
 		}
 
 		if config.BackendOptions.ResponseTimeoutSeconds != 0 {
@@ -125,13 +293,13 @@ func Start(config *Config) (*Server, func(), error) {
 		if cfg.Password != "" {
 			passwordVal, err := ReadFromEnvOrConfig(cfg.Password)
 			if err != nil {
-				return nil, nil, err
+				return nil, err
 			}
 			opts = append(opts, WithBasicAuth(cfg.Username, passwordVal))
 		}
 		tlsConfig, err := configureBackendTLS(cfg)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		if tlsConfig != nil {
 			log.Info("using custom TLS config for backend", "name", name)
@@ -145,11 +313,11 @@ func Start(config *Config) (*Server, func(), error) {
 
 		receiptsTarget, err := ReadFromEnvOrConfig(cfg.ConsensusReceiptsTarget)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		receiptsTarget, err = validateReceiptsTarget(receiptsTarget)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		opts = append(opts, WithConsensusReceiptTarget(receiptsTarget))
 
@@ -168,124 +336,22 @@ func Start(config *Config) (*Server, func(), error) {
 		backends := make([]*Backend, 0)
 		for _, bName := range bg.Backends {
 			if backendsByName[bName] == nil {
-				return nil, nil, fmt.Errorf("backend %s is not defined", bName)
+				return nil, fmt.Errorf("backend %s is not defined", bName)
 			}
 			backends = append(backends, backendsByName[bName])
 		}
 		group := &BackendGroup{
-			Name:     bgName,
-			Backends: backends,
+			Name:        bgName,
+			Backends:    backends,
+			TxBroadcast: bg.TxBroadcast,
 		}
 		backendGroups[bgName] = group
 	}
 
-	var wsBackendGroup *BackendGroup
-	if config.WSBackendGroup != "" {
-		wsBackendGroup = backendGroups[config.WSBackendGroup]
-		if wsBackendGroup == nil {
-			return nil, nil, fmt.Errorf("ws backend group %s does not exist", config.WSBackendGroup)
-		}
-	}
-
-	if wsBackendGroup == nil && config.Server.WSPort != 0 {
-		return nil, nil, fmt.Errorf("a ws port was defined, but no ws group was defined")
-	}
-
-	for _, bg := range config.RPCMethodMappings {
-		if backendGroups[bg] == nil {
-			return nil, nil, fmt.Errorf("undefined backend group %s", bg)
-		}
-	}
-
-	var resolvedAuth map[string]string
-
-	if config.Authentication != nil {
-		resolvedAuth = make(map[string]string)
-		for secret, alias := range config.Authentication {
-			resolvedSecret, err := ReadFromEnvOrConfig(secret)
-			if err != nil {
-				return nil, nil, err
-			}
-			resolvedAuth[resolvedSecret] = alias
-		}
-	}
-
-	var (
-		cache    Cache
-		rpcCache RPCCache
-	)
-	if config.Cache.Enabled {
-		if redisClient == nil {
-			log.Warn("redis is not configured, using in-memory cache")
-			cache = newMemoryCache()
-		} else {
-			cache = newRedisCache(redisClient, config.Redis.Namespace)
-		}
-		rpcCache = newRPCCache(newCacheWithCompression(cache))
-	}
-
-	srv, err := NewServer(
-		backendGroups,
-		wsBackendGroup,
-		NewStringSetFromStrings(config.WSMethodWhitelist),
-		config.RPCMethodMappings,
-		config.Server.MaxBodySizeBytes,
-		resolvedAuth,
-		secondsToDuration(config.Server.TimeoutSeconds),
-		config.Server.MaxUpstreamBatchSize,
-		rpcCache,
-		config.RateLimit,
-		config.SenderRateLimit,
-		config.Server.EnableRequestLog,
-		config.Server.MaxRequestBodyLogLen,
-		config.BatchConfig.MaxSize,
-		redisClient,
-	)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error creating server: %w", err)
-	}
-
-	if config.Metrics.Enabled {
-		addr := fmt.Sprintf("%s:%d", config.Metrics.Host, config.Metrics.Port)
-		log.Info("starting metrics server", "addr", addr)
-		go func() {
-			if err := http.ListenAndServe(addr, promhttp.Handler()); err != nil {
-				log.Error("error starting metrics server", "err", err)
-			}
-		}()
-	}
-
-	// To allow integration tests to cleanly come up, wait
-	// 10ms to give the below goroutines enough time to
-	// encounter an error creating their servers
-	errTimer := time.NewTimer(10 * time.Millisecond)
-
-	if config.Server.RPCPort != 0 {
-		go func() {
-			if err := srv.RPCListenAndServe(config.Server.RPCHost, config.Server.RPCPort); err != nil {
-				if errors.Is(err, http.ErrServerClosed) {
-					log.Info("RPC server shut down")
-					return
-				}
-				log.Crit("error starting RPC server", "err", err)
-			}
-		}()
-	}
-
-	if config.Server.WSPort != 0 {
-		go func() {
-			if err := srv.WSListenAndServe(config.Server.WSHost, config.Server.WSPort); err != nil {
-				if errors.Is(err, http.ErrServerClosed) {
-					log.Info("WS server shut down")
-					return
-				}
-				log.Crit("error starting WS server", "err", err)
-			}
-		}()
-	} else {
-		log.Info("WS server not enabled (ws_port is set to 0)")
-	}
+	return backendGroups, nil
+}
 
+func startConsensusPollers(config *Config, backendGroups map[string]*BackendGroup) {
 	for bgName, bg := range backendGroups {
 		bgcfg := config.BackendGroups[bgName]
 		if bgcfg.ConsensusAware {
@@ -313,17 +379,22 @@ func Start(config *Config) (*Server, func(), error) {
 			bg.Consensus = cp
 		}
 	}
+}
 
-	<-errTimer.C
-	log.Info("started proxyd")
-
-	shutdownFunc := func() {
-		log.Info("shutting down proxyd")
-		srv.Shutdown()
-		log.Info("goodbye")
+func resolveAuthentication(authentication map[string]string) (map[string]string, error) {
+	if authentication == nil {
+		return nil, nil
 	}
 
-	return srv, shutdownFunc, nil
+	resolvedAuth := make(map[string]string)
+	for secret, alias := range authentication {
+		resolvedSecret, err := ReadFromEnvOrConfig(secret)
+		if err != nil {
+			return nil, err
+		}
+		resolvedAuth[resolvedSecret] = alias
+	}
+	return resolvedAuth, nil
 }
 
 func validateReceiptsTarget(val string) (string, error) {
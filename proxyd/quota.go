@@ -0,0 +1,199 @@
+package proxyd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	defaultMethodWeight = 1
+
+	quotaWindowSecond = "second"
+	quotaWindowDaily  = "daily"
+)
+
+// quotaPlan meters the compute units used by the keys assigned to a plan.
+// Method weights default to the global weights in the quota config.
+type quotaPlan struct {
+	name          string
+	methodWeights map[string]int
+	defaultWeight int
+	useRedis      bool
+	perSecondMax  int
+	dailyMax      int
+	perSecondLim  FrontendRateLimiter
+	dailyLim      FrontendRateLimiter
+}
+
+// newKeyQuotas creates the plans defined in the config, and returns them
+// indexed by the authentication alias of the keys they apply to.
+// The limiters of the previous plans are carried over when their limits
+// did not change, so that a config reload doesn't reset the usage of keys
+// that is metered in memory.
+func newKeyQuotas(config QuotaConfig, redisClient *redis.Client, prev map[string]*quotaPlan) map[string]*quotaPlan {
+	limiterFactory := func(dur time.Duration, max int, prefix string) FrontendRateLimiter {
+		if max <= 0 {
+			return NoopFrontendRateLimiter
+		}
+		if config.UseRedis {
+			return NewRedisFrontendRateLimiter(redisClient, dur, max, prefix)
+		}
+		return NewMemoryFrontendRateLimit(dur, max)
+	}
+
+	prevPlans := make(map[string]*quotaPlan)
+	for _, plan := range prev {
+		prevPlans[plan.name] = plan
+	}
+
+	defaultWeight := defaultMethodWeight
+	if config.DefaultMethodWeight != nil {
+		defaultWeight = *config.DefaultMethodWeight
+	}
+
+	plans := make(map[string]*quotaPlan)
+	for name, planCfg := range config.Plans {
+		weights := make(map[string]int)
+		for method, weight := range config.MethodWeights {
+			weights[method] = weight
+		}
+		for method, weight := range planCfg.MethodWeights {
+			weights[method] = weight
+		}
+		plan := &quotaPlan{
+			name:          name,
+			methodWeights: weights,
+			defaultWeight: defaultWeight,
+			useRedis:      config.UseRedis,
+			perSecondMax:  planCfg.ComputeUnitsPerSecond,
+			dailyMax:      planCfg.DailyComputeUnits,
+		}
+		prevPlan := prevPlans[name]
+		if prevPlan != nil && prevPlan.useRedis == plan.useRedis && prevPlan.perSecondMax == plan.perSecondMax {
+			plan.perSecondLim = prevPlan.perSecondLim
+		} else {
+			plan.perSecondLim = limiterFactory(time.Second, plan.perSecondMax, fmt.Sprintf("quota:%s:%s", name, quotaWindowSecond))
+		}
+		if prevPlan != nil && prevPlan.useRedis == plan.useRedis && prevPlan.dailyMax == plan.dailyMax {
+			plan.dailyLim = prevPlan.dailyLim
+		} else {
+			plan.dailyLim = limiterFactory(24*time.Hour, plan.dailyMax, fmt.Sprintf("quota:%s:%s", name, quotaWindowDaily))
+		}
+		plans[name] = plan
+	}
+
+	keyPlans := make(map[string]*quotaPlan)
+	for alias, planName := range config.KeyPlans {
+		keyPlans[alias] = plans[planName]
+	}
+	return keyPlans
+}
+
+func validateQuotaConfig(config QuotaConfig, authentication map[string]string) error {
+	if config.DefaultMethodWeight != nil && *config.DefaultMethodWeight < 0 {
+		return fmt.Errorf("default_method_weight in quotas must be >= 0")
+	}
+	for method, weight := range config.MethodWeights {
+		if weight < 0 {
+			return fmt.Errorf("weight of method %s in quotas must be >= 0", method)
+		}
+	}
+	for name, plan := range config.Plans {
+		if plan.ComputeUnitsPerSecond < 0 || plan.DailyComputeUnits < 0 {
+			return fmt.Errorf("compute unit limits of quota plan %s must be >= 0", name)
+		}
+		for method, weight := range plan.MethodWeights {
+			if weight < 0 {
+				return fmt.Errorf("weight of method %s in quota plan %s must be >= 0", method, name)
+			}
+		}
+		if err := validatePlanWeights(config, name, plan); err != nil {
+			return err
+		}
+	}
+
+	aliases := make(map[string]bool)
+	for _, alias := range authentication {
+		aliases[alias] = true
+	}
+	for alias, planName := range config.KeyPlans {
+		if config.Plans[planName] == nil {
+			return fmt.Errorf("undefined quota plan %s for key %s", planName, alias)
+		}
+		if !aliases[alias] {
+			return fmt.Errorf("quota plan assigned to undefined authentication alias %s", alias)
+		}
+	}
+	return nil
+}
+
+// validatePlanWeights checks that a single call to any method fits in the limits of the plan,
+// as calls that cost more compute units than a limit would always be rejected.
+func validatePlanWeights(config QuotaConfig, name string, plan *QuotaPlanConfig) error {
+	weights := map[string]int{"default_method_weight": defaultMethodWeight}
+	if config.DefaultMethodWeight != nil {
+		weights["default_method_weight"] = *config.DefaultMethodWeight
+	}
+	for method, weight := range config.MethodWeights {
+		weights[method] = weight
+	}
+	for method, weight := range plan.MethodWeights {
+		weights[method] = weight
+	}
+	for method, weight := range weights {
+		if plan.ComputeUnitsPerSecond > 0 && weight > plan.ComputeUnitsPerSecond {
+			return fmt.Errorf("weight %d of %s exceeds the compute_units_per_second of quota plan %s", weight, method, name)
+		}
+		if plan.DailyComputeUnits > 0 && weight > plan.DailyComputeUnits {
+			return fmt.Errorf("weight %d of %s exceeds the daily_compute_units of quota plan %s", weight, method, name)
+		}
+	}
+	return nil
+}
+
+func (p *quotaPlan) weight(method string) int {
+	if weight, ok := p.methodWeights[method]; ok {
+		return weight
+	}
+	return p.defaultWeight
+}
+
+// Take charges the compute units of a method call to the key. It returns
+// an RPC error if the key is over its per-second rate or its daily quota.
+// Rejected calls are not charged against either limit.
+func (p *quotaPlan) Take(ctx context.Context, key string, method string) error {
+	units := p.weight(method)
+	if units == 0 {
+		return nil
+	}
+
+	ok, err := p.perSecondLim.TakeN(ctx, key, units)
+	if err != nil {
+		log.Warn("error taking compute unit rate limit", "err", err, "req_id", GetReqID(ctx))
+		return ErrInternal
+	}
+	if !ok {
+		RecordQuotaExceeded(key, p.name, quotaWindowSecond)
+		return ErrOverComputeUnitRateLimit
+	}
+
+	ok, err = p.dailyLim.TakeN(ctx, key, units)
+	if err != nil {
+		log.Warn("error taking daily compute unit quota", "err", err, "req_id", GetReqID(ctx))
+		return ErrInternal
+	}
+	if !ok {
+		if err := p.perSecondLim.ReturnN(ctx, key, units); err != nil {
+			log.Warn("error returning compute unit rate limit", "err", err, "req_id", GetReqID(ctx))
+		}
+		RecordQuotaExceeded(key, p.name, quotaWindowDaily)
+		return ErrOverDailyQuota
+	}
+
+	RecordComputeUnits(key, p.name, method, units)
+	return nil
+}
@@ -0,0 +1,100 @@
+package proxyd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestQuotaPlanTake(t *testing.T) {
+	config := QuotaConfig{
+		DefaultMethodWeight: intPtr(0),
+		MethodWeights:       map[string]int{"eth_getLogs": 2},
+		Plans: map[string]*QuotaPlanConfig{
+			"basic": {ComputeUnitsPerSecond: 4, DailyComputeUnits: 3},
+		},
+		KeyPlans: map[string]string{"alice": "basic"},
+	}
+	plan := newKeyQuotas(config, nil, nil)["alice"]
+	require.NotNil(t, plan)
+	ctx := context.Background()
+
+	// a default weight of 0 leaves methods without a weight unmetered
+	for i := 0; i < 10; i++ {
+		require.NoError(t, plan.Take(ctx, "alice", "eth_chainId"))
+	}
+
+	require.NoError(t, plan.Take(ctx, "alice", "eth_getLogs"))
+	require.Equal(t, ErrOverDailyQuota, plan.Take(ctx, "alice", "eth_getLogs"))
+
+	// the call rejected by the daily quota did not use up the per-second limit
+	ok, err := plan.perSecondLim.TakeN(ctx, "alice", 2)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestQuotaPlanDefaultWeight(t *testing.T) {
+	config := QuotaConfig{
+		Plans:    map[string]*QuotaPlanConfig{"basic": {DailyComputeUnits: 10}},
+		KeyPlans: map[string]string{"alice": "basic"},
+	}
+	require.Equal(t, defaultMethodWeight, newKeyQuotas(config, nil, nil)["alice"].weight("eth_chainId"))
+
+	config.DefaultMethodWeight = intPtr(-1)
+	require.ErrorContains(t, validateQuotaConfig(config, map[string]string{"secret": "alice"}), "default_method_weight")
+}
+
+func TestQuotaPlanReload(t *testing.T) {
+	config := QuotaConfig{
+		Plans: map[string]*QuotaPlanConfig{
+			"basic": {ComputeUnitsPerSecond: 100, DailyComputeUnits: 2},
+		},
+		KeyPlans: map[string]string{"alice": "basic"},
+	}
+	ctx := context.Background()
+	prev := newKeyQuotas(config, nil, nil)
+	require.NoError(t, prev["alice"].Take(ctx, "alice", "eth_chainId"))
+	require.NoError(t, prev["alice"].Take(ctx, "alice", "eth_chainId"))
+
+	// usage is kept when the plan is reloaded with the same limits, also for newly assigned keys
+	config.KeyPlans["bob"] = "basic"
+	reloaded := newKeyQuotas(config, nil, prev)
+	require.Equal(t, ErrOverDailyQuota, reloaded["alice"].Take(ctx, "alice", "eth_chainId"))
+	require.Same(t, reloaded["alice"], reloaded["bob"])
+
+	// changed limits start over
+	config.Plans["basic"].DailyComputeUnits = 3
+	reloaded = newKeyQuotas(config, nil, reloaded)
+	require.NoError(t, reloaded["alice"].Take(ctx, "alice", "eth_chainId"))
+}
+
+func TestQuotaPlanWeightsWithinLimits(t *testing.T) {
+	auth := map[string]string{"secret": "alice"}
+	config := QuotaConfig{
+		MethodWeights: map[string]int{"eth_getLogs": 5},
+		Plans: map[string]*QuotaPlanConfig{
+			"basic": {ComputeUnitsPerSecond: 5, DailyComputeUnits: 100},
+		},
+		KeyPlans: map[string]string{"alice": "basic"},
+	}
+	require.NoError(t, validateQuotaConfig(config, auth))
+
+	config.Plans["basic"].ComputeUnitsPerSecond = 4
+	require.ErrorContains(t, validateQuotaConfig(config, auth), "weight 5 of eth_getLogs exceeds the compute_units_per_second")
+
+	// the weights of the plan override the global weights
+	config.Plans["basic"].MethodWeights = map[string]int{"eth_getLogs": 4}
+	require.NoError(t, validateQuotaConfig(config, auth))
+
+	config.Plans["basic"].DailyComputeUnits = 1
+	require.ErrorContains(t, validateQuotaConfig(config, auth), "exceeds the daily_compute_units")
+
+	config.Plans["basic"].DailyComputeUnits = 100
+	config.DefaultMethodWeight = intPtr(10)
+	require.ErrorContains(t, validateQuotaConfig(config, auth), "weight 10 of default_method_weight")
+}
@@ -16,8 +16,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/go-redis/redis/v8"
@@ -25,6 +24,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
+	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -41,28 +41,49 @@ const (
 var emptyArrayResponse = json.RawMessage("[]")
 
 type Server struct {
-	BackendGroups          map[string]*BackendGroup
+	maxBodySize          int64
+	enableRequestLog     bool
+	maxRequestBodyLogLen int
+	timeout              time.Duration
+	maxUpstreamBatchSize int
+	maxBatchSize         int
+	upgrader             *websocket.Upgrader
+	redisClient          *redis.Client
+	rpcRequestSemaphore  *semaphore.Weighted
+	rpcServer            *http.Server
+	wsServer             *http.Server
+	adminServer          *http.Server
+	cache                RPCCache
+	srvMu                sync.Mutex
+
+	routesMu     sync.RWMutex
+	routes       *serverRoutes
+	configSource ConfigSource
+
+	customTxPolicies []TxPolicy
+}
+
+// serverRoutes holds the parts of the Server that are derived from the
+// reloadable sections of the config. Requests take a snapshot of the routes
+// when they arrive, so that a reload never changes the routing of a request
+// that is already in flight.
+type serverRoutes struct {
+	backendGroups          map[string]*BackendGroup
 	wsBackendGroup         *BackendGroup
 	wsMethodWhitelist      *StringSet
 	rpcMethodMappings      map[string]string
-	maxBodySize            int64
-	enableRequestLog       bool
-	maxRequestBodyLogLen   int
 	authenticatedPaths     map[string]string
-	timeout                time.Duration
-	maxUpstreamBatchSize   int
-	maxBatchSize           int
-	upgrader               *websocket.Upgrader
 	mainLim                FrontendRateLimiter
 	overrideLims           map[string]FrontendRateLimiter
 	senderLim              FrontendRateLimiter
 	limExemptOrigins       []*regexp.Regexp
 	limExemptUserAgents    []*regexp.Regexp
 	globallyLimitedMethods map[string]bool
-	rpcServer              *http.Server
-	wsServer               *http.Server
-	cache                  RPCCache
-	srvMu                  sync.Mutex
+	keyQuotas              map[string]*quotaPlan
+	txPolicies             []TxPolicy
+
+	// inflight tracks the requests still being served with these routes.
+	inflight sync.WaitGroup
 }
 
 type limiterFunc func(method string) bool
@@ -79,6 +100,8 @@ func NewServer(
 	cache RPCCache,
 	rateLimitConfig RateLimitConfig,
 	senderRateLimitConfig SenderRateLimitConfig,
+	quotaConfig QuotaConfig,
+	txPolicyConfig TxPolicyConfig,
 	enableRequestLog bool,
 	maxRequestBodyLogLen int,
 	maxBatchSize int,
@@ -104,6 +127,52 @@ func NewServer(
 		maxBatchSize = MaxBatchRPCCallsHardLimit
 	}
 
+	routes, err := newServerRoutes(
+		backendGroups,
+		wsBackendGroup,
+		wsMethodWhitelist,
+		rpcMethodMappings,
+		authenticatedPaths,
+		rateLimitConfig,
+		senderRateLimitConfig,
+		quotaConfig,
+		txPolicyConfig,
+		redisClient,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		maxBodySize:          maxBodySize,
+		timeout:              timeout,
+		maxUpstreamBatchSize: maxUpstreamBatchSize,
+		cache:                cache,
+		enableRequestLog:     enableRequestLog,
+		maxRequestBodyLogLen: maxRequestBodyLogLen,
+		maxBatchSize:         maxBatchSize,
+		upgrader: &websocket.Upgrader{
+			HandshakeTimeout: 5 * time.Second,
+		},
+		redisClient: redisClient,
+		routes:      routes,
+	}, nil
+}
+
+func newServerRoutes(
+	backendGroups map[string]*BackendGroup,
+	wsBackendGroup *BackendGroup,
+	wsMethodWhitelist *StringSet,
+	rpcMethodMappings map[string]string,
+	authenticatedPaths map[string]string,
+	rateLimitConfig RateLimitConfig,
+	senderRateLimitConfig SenderRateLimitConfig,
+	quotaConfig QuotaConfig,
+	txPolicyConfig TxPolicyConfig,
+	redisClient *redis.Client,
+	prevKeyQuotas map[string]*quotaPlan,
+) (*serverRoutes, error) {
 	limiterFactory := func(dur time.Duration, max int, prefix string) FrontendRateLimiter {
 		if rateLimitConfig.UseRedis {
 			return NewRedisFrontendRateLimiter(redisClient, dur, max, prefix)
@@ -138,11 +207,7 @@ func NewServer(
 	overrideLims := make(map[string]FrontendRateLimiter)
 	globalMethodLims := make(map[string]bool)
 	for method, override := range rateLimitConfig.MethodOverrides {
-		var err error
 		overrideLims[method] = limiterFactory(time.Duration(override.Interval), override.Limit, method)
-		if err != nil {
-			return nil, err
-		}
 
 		if override.Global {
 			globalMethodLims[method] = true
@@ -153,31 +218,41 @@ func NewServer(
 		senderLim = limiterFactory(time.Duration(senderRateLimitConfig.Interval), senderRateLimitConfig.Limit, "senders")
 	}
 
-	return &Server{
-		BackendGroups:        backendGroups,
-		wsBackendGroup:       wsBackendGroup,
-		wsMethodWhitelist:    wsMethodWhitelist,
-		rpcMethodMappings:    rpcMethodMappings,
-		maxBodySize:          maxBodySize,
-		authenticatedPaths:   authenticatedPaths,
-		timeout:              timeout,
-		maxUpstreamBatchSize: maxUpstreamBatchSize,
-		cache:                cache,
-		enableRequestLog:     enableRequestLog,
-		maxRequestBodyLogLen: maxRequestBodyLogLen,
-		maxBatchSize:         maxBatchSize,
-		upgrader: &websocket.Upgrader{
-			HandshakeTimeout: 5 * time.Second,
-		},
+	txPolicies, err := NewTxPolicies(txPolicyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverRoutes{
+		backendGroups:          backendGroups,
+		wsBackendGroup:         wsBackendGroup,
+		wsMethodWhitelist:      wsMethodWhitelist,
+		rpcMethodMappings:      rpcMethodMappings,
+		authenticatedPaths:     authenticatedPaths,
 		mainLim:                mainLim,
 		overrideLims:           overrideLims,
 		globallyLimitedMethods: globalMethodLims,
 		senderLim:              senderLim,
 		limExemptOrigins:       limExemptOrigins,
 		limExemptUserAgents:    limExemptUserAgents,
+		keyQuotas:              newKeyQuotas(quotaConfig, redisClient, prevKeyQuotas),
+		txPolicies:             txPolicies,
 	}, nil
 }
 
+// acquireRoutes returns the current routes and registers the caller as in-flight
+// on them. Callers must call release on the returned routes once they are done.
+func (s *Server) acquireRoutes() *serverRoutes {
+	s.routesMu.RLock()
+	defer s.routesMu.RUnlock()
+	s.routes.inflight.Add(1)
+	return s.routes
+}
+
+func (rt *serverRoutes) release() {
+	rt.inflight.Done()
+}
+
 func (s *Server) RPCListenAndServe(host string, port int) error {
 	s.srvMu.Lock()
 	hdlr := mux.NewRouter()
@@ -224,7 +299,12 @@ func (s *Server) Shutdown() {
 	if s.wsServer != nil {
 		_ = s.wsServer.Shutdown(context.Background())
 	}
-	for _, bg := range s.BackendGroups {
+	if s.adminServer != nil {
+		_ = s.adminServer.Shutdown(context.Background())
+	}
+	s.routesMu.RLock()
+	defer s.routesMu.RUnlock()
+	for _, bg := range s.routes.backendGroups {
 		bg.Shutdown()
 	}
 }
@@ -234,7 +314,10 @@ func (s *Server) HandleHealthz(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) HandleRPC(w http.ResponseWriter, r *http.Request) {
-	ctx := s.populateContext(w, r)
+	rt := s.acquireRoutes()
+	defer rt.release()
+
+	ctx := s.populateContext(w, r, rt)
 	if ctx == nil {
 		return
 	}
@@ -246,8 +329,8 @@ func (s *Server) HandleRPC(w http.ResponseWriter, r *http.Request) {
 	userAgent := r.Header.Get("User-Agent")
 	// Use XFF in context since it will automatically be replaced by the remote IP
 	xff := stripXFF(GetXForwardedFor(ctx))
-	isUnlimitedOrigin := s.isUnlimitedOrigin(origin)
-	isUnlimitedUserAgent := s.isUnlimitedUserAgent(userAgent)
+	isUnlimitedOrigin := rt.isUnlimitedOrigin(origin)
+	isUnlimitedUserAgent := rt.isUnlimitedUserAgent(userAgent)
 
 	if xff == "" {
 		writeRPCError(ctx, w, nil, ErrInvalidRequest("request does not include a remote IP"))
@@ -255,16 +338,16 @@ func (s *Server) HandleRPC(w http.ResponseWriter, r *http.Request) {
 	}
 
 	isLimited := func(method string) bool {
-		isGloballyLimitedMethod := s.isGlobalLimit(method)
+		isGloballyLimitedMethod := rt.isGlobalLimit(method)
 		if !isGloballyLimitedMethod && (isUnlimitedOrigin || isUnlimitedUserAgent) {
 			return false
 		}
 
 		var lim FrontendRateLimiter
 		if method == "" {
-			lim = s.mainLim
+			lim = rt.mainLim
 		} else {
-			lim = s.overrideLims[method]
+			lim = rt.overrideLims[method]
 		}
 
 		if lim == nil {
@@ -340,7 +423,7 @@ func (s *Server) HandleRPC(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		batchRes, batchContainsCached, err := s.handleBatchRPC(ctx, reqs, isLimited, true)
+		batchRes, batchContainsCached, err := s.handleBatchRPC(ctx, rt, reqs, isLimited, true)
 		if err == context.DeadlineExceeded {
 			writeRPCError(ctx, w, nil, ErrGatewayTimeout)
 			return
@@ -361,7 +444,7 @@ func (s *Server) HandleRPC(w http.ResponseWriter, r *http.Request) {
 	}
 
 	rawBody := json.RawMessage(body)
-	backendRes, cached, err := s.handleBatchRPC(ctx, []json.RawMessage{rawBody}, isLimited, false)
+	backendRes, cached, err := s.handleBatchRPC(ctx, rt, []json.RawMessage{rawBody}, isLimited, false)
 	if err != nil {
 		if errors.Is(err, ErrConsensusGetReceiptsCantBeBatched) ||
 			errors.Is(err, ErrConsensusGetReceiptsInvalidTarget) {
@@ -375,7 +458,7 @@ func (s *Server) HandleRPC(w http.ResponseWriter, r *http.Request) {
 	writeRPCRes(ctx, w, backendRes[0])
 }
 
-func (s *Server) handleBatchRPC(ctx context.Context, reqs []json.RawMessage, isLimited limiterFunc, isBatch bool) ([]*RPCRes, bool, error) {
+func (s *Server) handleBatchRPC(ctx context.Context, rt *serverRoutes, reqs []json.RawMessage, isLimited limiterFunc, isBatch bool) ([]*RPCRes, bool, error) {
 	// A request set is transformed into groups of batches.
 	// Each batch group maps to a forwarded JSON-RPC batch request (subject to maxUpstreamBatchSize constraints)
 	// A groupID is used to decouple Requests that have duplicate ID so they're not part of the same batch that's
@@ -411,7 +494,7 @@ func (s *Server) handleBatchRPC(ctx context.Context, reqs []json.RawMessage, isL
 			continue
 		}
 
-		group := s.rpcMethodMappings[parsedReq.Method]
+		group := rt.rpcMethodMappings[parsedReq.Method]
 		if group == "" {
 			// use unknown below to prevent DOS vector that fills up memory
 			// with arbitrary method names.
@@ -430,7 +513,7 @@ func (s *Server) handleBatchRPC(ctx context.Context, reqs []json.RawMessage, isL
 		// NOTE: eventually, this should apply to all batch requests. However,
 		// since we don't have data right now on the size of each batch, we
 		// only apply this to the methods that have an additional rate limit.
-		if _, ok := s.overrideLims[parsedReq.Method]; ok && isLimited(parsedReq.Method) {
+		if _, ok := rt.overrideLims[parsedReq.Method]; ok && isLimited(parsedReq.Method) {
 			log.Info(
 				"rate limited specific RPC",
 				"source", "rpc",
@@ -442,17 +525,48 @@ func (s *Server) handleBatchRPC(ctx context.Context, reqs []json.RawMessage, isL
 			continue
 		}
 
-		// Apply a sender-based rate limit if it is enabled. Note that sender-based rate
-		// limits apply regardless of origin or user-agent. As such, they don't use the
-		// isLimited method.
-		if parsedReq.Method == "eth_sendRawTransaction" && s.senderLim != nil {
-			if err := s.rateLimitSender(ctx, parsedReq); err != nil {
+		if parsedReq.Method == "eth_sendRawTransaction" {
+			if err := s.checkRawTx(ctx, rt, parsedReq); err != nil {
 				RecordRPCError(ctx, BackendProxyd, parsedReq.Method, err)
 				responses[i] = NewRPCErrorRes(parsedReq.ID, err)
 				continue
 			}
 		}
 
+		// Charge the compute units of the method to the key's plan, if it has one.
+		// This is done after the other checks, so that rejected calls are not charged.
+		if plan := rt.keyQuotas[GetAuthCtx(ctx)]; plan != nil {
+			if err := plan.Take(ctx, GetAuthCtx(ctx), parsedReq.Method); err != nil {
+				log.Info(
+					"rejected RPC over quota",
+					"source", "rpc",
+					"req_id", GetReqID(ctx),
+					"auth", GetAuthCtx(ctx),
+					"method", parsedReq.Method,
+				)
+				RecordRPCError(ctx, BackendProxyd, parsedReq.Method, err)
+				responses[i] = NewRPCErrorRes(parsedReq.ID, err)
+				continue
+			}
+		}
+
+		// Transactions sent to broadcasting groups are not batched, since they
+		// go to every backend of the group.
+		if bg := rt.backendGroups[group]; parsedReq.Method == "eth_sendRawTransaction" && bg.TxBroadcast {
+			res, err := bg.BroadcastRawTransaction(ctx, parsedReq, s.timeout)
+			if err != nil {
+				log.Error(
+					"error broadcasting raw transaction",
+					"backend_group", group,
+					"req_id", GetReqID(ctx),
+					"err", err,
+				)
+				res = NewRPCErrorRes(parsedReq.ID, err)
+			}
+			responses[i] = res
+			continue
+		}
+
 		id := string(parsedReq.ID)
 		// If this is a duplicate Request ID, move the Request to a new batchGroup
 		ids[id]++
@@ -491,7 +605,7 @@ func (s *Server) handleBatchRPC(ctx context.Context, reqs []json.RawMessage, isL
 			start := i * s.maxUpstreamBatchSize
 			end := int(math.Min(float64(start+s.maxUpstreamBatchSize), float64(len(cacheMisses))))
 			elems := cacheMisses[start:end]
-			res, err := s.BackendGroups[group.backendGroup].Forward(ctx, createBatchRequest(elems), isBatch)
+			res, err := rt.backendGroups[group.backendGroup].Forward(ctx, createBatchRequest(elems), isBatch)
 			if err != nil {
 				if errors.Is(err, ErrConsensusGetReceiptsCantBeBatched) ||
 					errors.Is(err, ErrConsensusGetReceiptsInvalidTarget) {
@@ -531,7 +645,10 @@ func (s *Server) handleBatchRPC(ctx context.Context, reqs []json.RawMessage, isL
 }
 
 func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
-	ctx := s.populateContext(w, r)
+	rt := s.acquireRoutes()
+	defer rt.release()
+
+	ctx := s.populateContext(w, r, rt)
 	if ctx == nil {
 		return
 	}
@@ -544,7 +661,7 @@ func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	proxier, err := s.wsBackendGroup.ProxyWS(ctx, clientConn, s.wsMethodWhitelist)
+	proxier, err := rt.wsBackendGroup.ProxyWS(ctx, clientConn, rt.wsMethodWhitelist)
 	if err != nil {
 		if errors.Is(err, ErrNoBackends) {
 			RecordUnserviceableRequest(ctx, RPCRequestSourceWS)
@@ -554,6 +671,9 @@ func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Calls over the connection are charged to the key's plan, if it has one.
+	proxier.quota = rt.keyQuotas[GetAuthCtx(ctx)]
+
 	activeClientWsConnsGauge.WithLabelValues(GetAuthCtx(ctx)).Inc()
 	go func() {
 		// Below call blocks so run it in a goroutine.
@@ -566,7 +686,7 @@ func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
 	log.Info("accepted WS connection", "auth", GetAuthCtx(ctx), "req_id", GetReqID(ctx))
 }
 
-func (s *Server) populateContext(w http.ResponseWriter, r *http.Request) context.Context {
+func (s *Server) populateContext(w http.ResponseWriter, r *http.Request, rt *serverRoutes) context.Context {
 	vars := mux.Vars(r)
 	authorization := vars["authorization"]
 	xff := r.Header.Get("X-Forwarded-For")
@@ -578,15 +698,15 @@ func (s *Server) populateContext(w http.ResponseWriter, r *http.Request) context
 	}
 	ctx := context.WithValue(r.Context(), ContextKeyXForwardedFor, xff) // nolint:staticcheck
 
-	if len(s.authenticatedPaths) > 0 {
-		if authorization == "" || s.authenticatedPaths[authorization] == "" {
+	if len(rt.authenticatedPaths) > 0 {
+		if authorization == "" || rt.authenticatedPaths[authorization] == "" {
 			log.Info("blocked unauthorized request", "authorization", authorization)
 			httpResponseCodesTotal.WithLabelValues("401").Inc()
 			w.WriteHeader(401)
 			return nil
 		}
 
-		ctx = context.WithValue(ctx, ContextKeyAuth, s.authenticatedPaths[authorization]) // nolint:staticcheck
+		ctx = context.WithValue(ctx, ContextKeyAuth, rt.authenticatedPaths[authorization]) // nolint:staticcheck
 	}
 
 	return context.WithValue(
@@ -604,8 +724,8 @@ func randStr(l int) string {
 	return hex.EncodeToString(b)
 }
 
-func (s *Server) isUnlimitedOrigin(origin string) bool {
-	for _, pat := range s.limExemptOrigins {
+func (rt *serverRoutes) isUnlimitedOrigin(origin string) bool {
+	for _, pat := range rt.limExemptOrigins {
 		if pat.MatchString(origin) {
 			return true
 		}
@@ -614,8 +734,8 @@ func (s *Server) isUnlimitedOrigin(origin string) bool {
 	return false
 }
 
-func (s *Server) isUnlimitedUserAgent(origin string) bool {
-	for _, pat := range s.limExemptUserAgents {
+func (rt *serverRoutes) isUnlimitedUserAgent(origin string) bool {
+	for _, pat := range rt.limExemptUserAgents {
 		if pat.MatchString(origin) {
 			return true
 		}
@@ -623,57 +743,61 @@ func (s *Server) isUnlimitedUserAgent(origin string) bool {
 	return false
 }
 
-func (s *Server) isGlobalLimit(method string) bool {
-	return s.globallyLimitedMethods[method]
+func (rt *serverRoutes) isGlobalLimit(method string) bool {
+	return rt.globallyLimitedMethods[method]
 }
 
-func (s *Server) rateLimitSender(ctx context.Context, req *RPCReq) error {
-	var params []string
-	if err := json.Unmarshal(req.Params, &params); err != nil {
-		log.Debug("error unmarshaling raw transaction params", "err", err, "req_Id", GetReqID(ctx))
-		return ErrParseErr
+func (rt *serverRoutes) rateLimitSender(ctx context.Context, tx *types.Transaction, from common.Address) error {
+	ok, err := rt.senderLim.Take(ctx, fmt.Sprintf("%s:%d", from.Hex(), tx.Nonce()))
+	if err != nil {
+		log.Error("error taking from sender limiter", "err", err, "req_id", GetReqID(ctx))
+		return ErrInternal
 	}
-
-	if len(params) != 1 {
-		log.Debug("raw transaction request has invalid number of params", "req_id", GetReqID(ctx))
-		// The error below is identical to the one Geth responds with.
-		return ErrInvalidParams("missing value for required argument 0")
+	if !ok {
+		log.Debug("sender rate limit exceeded", "sender", from.Hex(), "req_id", GetReqID(ctx))
+		return ErrOverSenderRateLimit
 	}
 
-	var data hexutil.Bytes
-	if err := data.UnmarshalText([]byte(params[0])); err != nil {
-		log.Debug("error decoding raw tx data", "err", err, "req_id", GetReqID(ctx))
-		// Geth returns the raw error from UnmarshalText.
-		return ErrInvalidParams(err.Error())
-	}
+	return nil
+}
 
-	// Inflates a types.Transaction object from the transaction's raw bytes.
-	tx := new(types.Transaction)
-	if err := tx.UnmarshalBinary(data); err != nil {
-		log.Debug("could not unmarshal transaction", "err", err, "req_id", GetReqID(ctx))
-		return ErrInvalidParams(err.Error())
+// checkRawTx decodes the transaction of an eth_sendRawTransaction request, runs it
+// through the transaction policies and applies the sender-based rate limit.
+func (s *Server) checkRawTx(ctx context.Context, rt *serverRoutes, req *RPCReq) error {
+	s.routesMu.RLock()
+	policies := make([]TxPolicy, 0, len(rt.txPolicies)+len(s.customTxPolicies))
+	policies = append(policies, rt.txPolicies...)
+	policies = append(policies, s.customTxPolicies...)
+	s.routesMu.RUnlock()
+	if rt.senderLim == nil && len(policies) == 0 {
+		return nil
 	}
 
-	// Convert the transaction into a Message object so that we can get the
-	// sender. This method performs an ecrecover, which can be expensive.
-	msg, err := core.TransactionToMessage(tx, types.LatestSignerForChainID(tx.ChainId()), nil)
-	if err != nil {
-		log.Debug("could not get message from transaction", "err", err, "req_id", GetReqID(ctx))
-		return ErrInvalidParams(err.Error())
-	}
-	ok, err := s.senderLim.Take(ctx, fmt.Sprintf("%s:%d", msg.From.Hex(), tx.Nonce()))
+	tx, from, err := decodeRawTx(ctx, req)
 	if err != nil {
-		log.Error("error taking from sender limiter", "err", err, "req_id", GetReqID(ctx))
-		return ErrInternal
+		return err
 	}
-	if !ok {
-		log.Debug("sender rate limit exceeded", "sender", msg.From.Hex(), "req_id", GetReqID(ctx))
-		return ErrOverSenderRateLimit
+
+	if err := checkTxPolicies(ctx, policies, tx, from); err != nil {
+		return err
 	}
 
+	// Sender-based rate limits apply regardless of origin or user-agent.
+	// As such, they don't use the isLimited method.
+	if rt.senderLim != nil {
+		return rt.rateLimitSender(ctx, tx, from)
+	}
 	return nil
 }
 
+// AddTxPolicy registers a transaction policy in addition to the ones in
+// the config. Custom policies are kept across config reloads.
+func (s *Server) AddTxPolicy(policy TxPolicy) {
+	s.routesMu.Lock()
+	defer s.routesMu.Unlock()
+	s.customTxPolicies = append(s.customTxPolicies, policy)
+}
+
 func setCacheHeader(w http.ResponseWriter, cached bool) {
 	if cached {
 		w.Header().Set(cacheStatusHdr, "HIT")
@@ -0,0 +1,193 @@
+package proxyd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Reasons reported in the data field of ErrTxRejected errors.
+const (
+	TxRejectInvalidChainID        = "invalid_chain_id"
+	TxRejectDestinationNotAllowed = "destination_not_allowed"
+	TxRejectDestinationDenied     = "destination_denied"
+	TxRejectTipTooLow             = "tip_too_low"
+	TxRejectCalldataTooLarge      = "calldata_too_large"
+	TxRejectSenderBlocked         = "sender_blocked"
+)
+
+// TxPolicy decides whether a raw transaction sent through eth_sendRawTransaction
+// may be forwarded to the backends. Check returns an error, usually created with
+// ErrTxRejected, if the transaction must be rejected.
+type TxPolicy interface {
+	Check(ctx context.Context, tx *types.Transaction, from common.Address) error
+}
+
+// TxPolicyFunc adapts a function to the TxPolicy interface.
+type TxPolicyFunc func(ctx context.Context, tx *types.Transaction, from common.Address) error
+
+func (f TxPolicyFunc) Check(ctx context.Context, tx *types.Transaction, from common.Address) error {
+	return f(ctx, tx, from)
+}
+
+// NewTxPolicies creates the transaction policies enabled in the config.
+func NewTxPolicies(config TxPolicyConfig) ([]TxPolicy, error) {
+	policies := make([]TxPolicy, 0)
+
+	if len(config.ChainIDs) > 0 {
+		chainIDs := make(map[uint64]bool)
+		for _, id := range config.ChainIDs {
+			chainIDs[id] = true
+		}
+		policies = append(policies, TxPolicyFunc(func(ctx context.Context, tx *types.Transaction, from common.Address) error {
+			if !tx.ChainId().IsUint64() || !chainIDs[tx.ChainId().Uint64()] {
+				return ErrTxRejected(TxRejectInvalidChainID, fmt.Sprintf("chain ID %s is not allowed", tx.ChainId()))
+			}
+			return nil
+		}))
+	}
+
+	if len(config.AllowedDestinations) > 0 {
+		allowed, err := parseAddressSet(config.AllowedDestinations)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed_destinations in tx_policy: %w", err)
+		}
+		policies = append(policies, TxPolicyFunc(func(ctx context.Context, tx *types.Transaction, from common.Address) error {
+			if tx.To() == nil {
+				return ErrTxRejected(TxRejectDestinationNotAllowed, "contract creation is not allowed")
+			}
+			if !allowed[*tx.To()] {
+				return ErrTxRejected(TxRejectDestinationNotAllowed, fmt.Sprintf("destination %s is not allowed", tx.To()))
+			}
+			return nil
+		}))
+	}
+
+	if len(config.DeniedDestinations) > 0 {
+		denied, err := parseAddressSet(config.DeniedDestinations)
+		if err != nil {
+			return nil, fmt.Errorf("invalid denied_destinations in tx_policy: %w", err)
+		}
+		policies = append(policies, TxPolicyFunc(func(ctx context.Context, tx *types.Transaction, from common.Address) error {
+			if tx.To() != nil && denied[*tx.To()] {
+				return ErrTxRejected(TxRejectDestinationDenied, fmt.Sprintf("destination %s is denied", tx.To()))
+			}
+			return nil
+		}))
+	}
+
+	if config.MinTipWei > 0 {
+		minTip := new(big.Int).SetUint64(config.MinTipWei)
+		policies = append(policies, TxPolicyFunc(func(ctx context.Context, tx *types.Transaction, from common.Address) error {
+			// For legacy transactions the tip cap is the gas price.
+			if tx.GasTipCap().Cmp(minTip) < 0 {
+				return ErrTxRejected(TxRejectTipTooLow, fmt.Sprintf("tip %s is below the minimum of %s wei", tx.GasTipCap(), minTip))
+			}
+			return nil
+		}))
+	}
+
+	if config.MaxCalldataSize > 0 {
+		policies = append(policies, TxPolicyFunc(func(ctx context.Context, tx *types.Transaction, from common.Address) error {
+			if len(tx.Data()) > config.MaxCalldataSize {
+				return ErrTxRejected(TxRejectCalldataTooLarge, fmt.Sprintf("calldata of %d bytes exceeds the maximum of %d bytes", len(tx.Data()), config.MaxCalldataSize))
+			}
+			return nil
+		}))
+	}
+
+	if len(config.BlockedSenders) > 0 {
+		blocked, err := parseAddressSet(config.BlockedSenders)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked_senders in tx_policy: %w", err)
+		}
+		policies = append(policies, TxPolicyFunc(func(ctx context.Context, tx *types.Transaction, from common.Address) error {
+			if blocked[from] {
+				return ErrTxRejected(TxRejectSenderBlocked, fmt.Sprintf("sender %s is blocked", from))
+			}
+			return nil
+		}))
+	}
+
+	return policies, nil
+}
+
+func parseAddressSet(addrs []string) (map[common.Address]bool, error) {
+	set := make(map[common.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid address %s", addr)
+		}
+		set[common.HexToAddress(addr)] = true
+	}
+	return set, nil
+}
+
+// checkTxPolicies runs the transaction through every policy, and returns the
+// error of the first policy that rejects it.
+func checkTxPolicies(ctx context.Context, policies []TxPolicy, tx *types.Transaction, from common.Address) error {
+	for _, policy := range policies {
+		if err := policy.Check(ctx, tx, from); err != nil {
+			reason := "unknown"
+			if rpcErr, ok := err.(*RPCErr); ok && rpcErr.Data != "" {
+				reason = rpcErr.Data
+			}
+			log.Info(
+				"transaction rejected by policy",
+				"req_id", GetReqID(ctx),
+				"tx_hash", tx.Hash(),
+				"sender", from,
+				"reason", reason,
+			)
+			RecordTxPolicyRejection(reason)
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeRawTx decodes the transaction sent in an eth_sendRawTransaction request,
+// and recovers its sender. This performs an ecrecover, which can be expensive.
+func decodeRawTx(ctx context.Context, req *RPCReq) (*types.Transaction, common.Address, error) {
+	var params []string
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		log.Debug("error unmarshaling raw transaction params", "err", err, "req_Id", GetReqID(ctx))
+		return nil, common.Address{}, ErrParseErr
+	}
+
+	if len(params) != 1 {
+		log.Debug("raw transaction request has invalid number of params", "req_id", GetReqID(ctx))
+		// The error below is identical to the one Geth responds with.
+		return nil, common.Address{}, ErrInvalidParams("missing value for required argument 0")
+	}
+
+	var data hexutil.Bytes
+	if err := data.UnmarshalText([]byte(params[0])); err != nil {
+		log.Debug("error decoding raw tx data", "err", err, "req_id", GetReqID(ctx))
+		// Geth returns the raw error from UnmarshalText.
+		return nil, common.Address{}, ErrInvalidParams(err.Error())
+	}
+
+	// Inflates a types.Transaction object from the transaction's raw bytes.
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		log.Debug("could not unmarshal transaction", "err", err, "req_id", GetReqID(ctx))
+		return nil, common.Address{}, ErrInvalidParams(err.Error())
+	}
+
+	// Convert the transaction into a Message object so that we can get the
+	// sender.
+	msg, err := core.TransactionToMessage(tx, types.LatestSignerForChainID(tx.ChainId()), nil)
+	if err != nil {
+		log.Debug("could not get message from transaction", "err", err, "req_id", GetReqID(ctx))
+		return nil, common.Address{}, ErrInvalidParams(err.Error())
+	}
+	return tx, msg.From, nil
+}
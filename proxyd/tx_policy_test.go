@@ -0,0 +1,153 @@
+package proxyd
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxPolicies(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	allowed := common.HexToAddress("0x4200000000000000000000000000000000000010")
+	denied := common.HexToAddress("0x4200000000000000000000000000000000000007")
+	other := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	signTx := func(chainID int64, to *common.Address, tip int64, data []byte) *types.Transaction {
+		tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(chainID)), &types.DynamicFeeTx{
+			ChainID:   big.NewInt(chainID),
+			Nonce:     1,
+			GasTipCap: big.NewInt(tip),
+			GasFeeCap: big.NewInt(1000),
+			Gas:       21000,
+			To:        to,
+			Data:      data,
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	tests := []struct {
+		name   string
+		config TxPolicyConfig
+		tx     *types.Transaction
+		reason string
+	}{
+		{
+			name:   "no policies",
+			config: TxPolicyConfig{},
+			tx:     signTx(1, nil, 0, nil),
+		},
+		{
+			name:   "allowed chain ID",
+			config: TxPolicyConfig{ChainIDs: []uint64{10, 420}},
+			tx:     signTx(10, &other, 0, nil),
+		},
+		{
+			name:   "invalid chain ID",
+			config: TxPolicyConfig{ChainIDs: []uint64{10}},
+			tx:     signTx(1, &other, 0, nil),
+			reason: TxRejectInvalidChainID,
+		},
+		{
+			name:   "allowed destination",
+			config: TxPolicyConfig{AllowedDestinations: []string{allowed.Hex()}},
+			tx:     signTx(10, &allowed, 0, nil),
+		},
+		{
+			name:   "destination not allowed",
+			config: TxPolicyConfig{AllowedDestinations: []string{allowed.Hex()}},
+			tx:     signTx(10, &other, 0, nil),
+			reason: TxRejectDestinationNotAllowed,
+		},
+		{
+			name:   "contract creation with allowed destinations",
+			config: TxPolicyConfig{AllowedDestinations: []string{allowed.Hex()}},
+			tx:     signTx(10, nil, 0, nil),
+			reason: TxRejectDestinationNotAllowed,
+		},
+		{
+			name:   "denied destination",
+			config: TxPolicyConfig{DeniedDestinations: []string{denied.Hex()}},
+			tx:     signTx(10, &denied, 0, nil),
+			reason: TxRejectDestinationDenied,
+		},
+		{
+			name:   "contract creation with denied destinations",
+			config: TxPolicyConfig{DeniedDestinations: []string{denied.Hex()}},
+			tx:     signTx(10, nil, 0, nil),
+		},
+		{
+			name:   "tip too low",
+			config: TxPolicyConfig{MinTipWei: 100},
+			tx:     signTx(10, &other, 99, nil),
+			reason: TxRejectTipTooLow,
+		},
+		{
+			name:   "minimum tip",
+			config: TxPolicyConfig{MinTipWei: 100},
+			tx:     signTx(10, &other, 100, nil),
+		},
+		{
+			name:   "calldata too large",
+			config: TxPolicyConfig{MaxCalldataSize: 4},
+			tx:     signTx(10, &other, 0, make([]byte, 5)),
+			reason: TxRejectCalldataTooLarge,
+		},
+		{
+			name:   "maximum calldata",
+			config: TxPolicyConfig{MaxCalldataSize: 4},
+			tx:     signTx(10, &other, 0, make([]byte, 4)),
+		},
+		{
+			name:   "blocked sender",
+			config: TxPolicyConfig{BlockedSenders: []string{sender.Hex()}},
+			tx:     signTx(10, &other, 0, nil),
+			reason: TxRejectSenderBlocked,
+		},
+		{
+			name:   "other blocked sender",
+			config: TxPolicyConfig{BlockedSenders: []string{other.Hex()}},
+			tx:     signTx(10, &other, 0, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			policies, err := NewTxPolicies(tt.config)
+			require.NoError(t, err)
+
+			data, err := tt.tx.MarshalBinary()
+			require.NoError(t, err)
+			params, err := json.Marshal([]string{hexutil.Encode(data)})
+			require.NoError(t, err)
+			tx, from, err := decodeRawTx(ctx, &RPCReq{Params: params})
+			require.NoError(t, err)
+			require.Equal(t, sender, from)
+
+			err = checkTxPolicies(ctx, policies, tx, from)
+			if tt.reason == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			rpcErr, ok := err.(*RPCErr)
+			require.True(t, ok)
+			require.Equal(t, tt.reason, rpcErr.Data)
+		})
+	}
+}
+
+func TestTxPoliciesInvalidConfig(t *testing.T) {
+	_, err := NewTxPolicies(TxPolicyConfig{BlockedSenders: []string{"0xnotanaddress"}})
+	require.ErrorContains(t, err, "blocked_senders")
+}